@@ -0,0 +1,8 @@
+# The most recently started run is used when `number` is not set
+data "artifactory_build_info" "my-app" {
+  name = "my-app"
+}
+
+output "my_app_artifacts" {
+  value = flatten([for m in data.artifactory_build_info.my-app.modules : [for a in m.artifacts : a.name]])
+}
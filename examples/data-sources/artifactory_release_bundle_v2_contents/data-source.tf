@@ -0,0 +1,8 @@
+data "artifactory_release_bundle_v2_contents" "my-app" {
+  name    = "my-app"
+  version = "1.0.0"
+}
+
+output "my_app_checksums" {
+  value = { for a in data.artifactory_release_bundle_v2_contents.my-app.artifacts : a.path => a.sha256 }
+}
@@ -0,0 +1,8 @@
+data "artifactory_release_bundle_v2_promotions" "my-app" {
+  name        = "my-app"
+  environment = "PROD"
+}
+
+output "my_app_version_in_prod" {
+  value = data.artifactory_release_bundle_v2_promotions.my-app.latest_version_by_environment["PROD"]
+}
@@ -0,0 +1,12 @@
+data "artifactory_release_bundle_v2_signature" "my-app" {
+  name       = "my-app"
+  version    = "1.0.0"
+  public_key = artifactory_keypair.my-keypair.public_key
+
+  # refuse to continue with an unverified bundle
+  fail_if_invalid = true
+}
+
+output "my_app_signer_fingerprint" {
+  value = data.artifactory_release_bundle_v2_signature.my-app.signer
+}
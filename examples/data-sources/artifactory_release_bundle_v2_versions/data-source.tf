@@ -0,0 +1,10 @@
+data "artifactory_release_bundle_v2_versions" "my-app" {
+  name          = "my-app"
+  project_key   = "myproj"
+  status        = "COMPLETED"
+  created_after = "2026-01-01T00:00:00Z"
+}
+
+output "my_app_latest_version" {
+  value = data.artifactory_release_bundle_v2_versions.my-app.versions[0].version
+}
@@ -28,7 +28,7 @@ resource "project_repository" "myproj-my-docker-local" {
 }
 
 resource "artifactory_archive_policy" "my-archive-policy" {
-  key = "my-archive-policy"
+  key = "myproj-archive-policy"
   description = "My archive policy"
   cron_expression = "0 0 2 ? * MON-SAT *"
   duration_in_minutes = 60
@@ -0,0 +1,9 @@
+resource "artifactory_build_promotion" "my-app-release" {
+  build_name   = "my-app"
+  build_number = "42"
+  source_repo  = "libs-snapshot-local"
+  target_repo  = "libs-release-local"
+  status       = "released"
+  comment      = "Promoted by Terraform"
+  copy         = true
+}
@@ -0,0 +1,9 @@
+resource "artifactory_build_retention_policy" "my-app" {
+  build_name             = "my-app"
+  max_builds             = 20
+  delete_artifacts       = true
+  excluded_build_numbers = ["1"]
+
+  # change to discard the old build runs again
+  run_trigger = "2025-01-01"
+}
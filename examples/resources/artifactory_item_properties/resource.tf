@@ -15,4 +15,12 @@ resource "artifactory_item_properties" "my-folder-properties" {
     "key2": ["value2", "value3"]
   }
   is_recursive = true
-}
\ No newline at end of file
+}
+resource "artifactory_item_properties" "my-additive-properties" {
+  repo_key = "my-generic-local"
+  item_path = "folder/subfolder"
+  properties = {
+    "team": ["platform"]
+  }
+  mode = "additive"
+}
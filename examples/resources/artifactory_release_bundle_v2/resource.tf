@@ -54,4 +54,36 @@ resource "artifactory_release_bundle_v2" "my-release-bundle-v2-rb" {
       version = "1.0.0"
     }]
   }
-}
\ No newline at end of file
+}
+
+resource "artifactory_release_bundle_v2" "my-release-bundle-v2-packages" {
+  name = "my-release-bundle-v2-packages"
+  version = "1.0.0"
+  keypair_name = "my-keypair-name"
+  source_type = "packages"
+
+  source = {
+    packages = [{
+      name = "my-docker-image"
+      version = "1.2.3"
+      type = "docker"
+      repository_key = "my-docker-local"
+    }]
+  }
+}
+
+# The name and number of the latest released build of each selector are resolved during plan and shown in `resolved_builds`,
+# and the artifacts of these builds in `resolved_artifacts`
+resource "artifactory_release_bundle_v2" "my-release-bundle-v2-build-selectors" {
+  name = "my-release-bundle-v2-build-selectors"
+  version = "1.0.0"
+  keypair_name = "my-keypair-name"
+  source_type = "build_selectors"
+
+  source = {
+    build_selectors = [{
+      name = "my-app-*"
+      status = "released"
+    }]
+  }
+}
@@ -0,0 +1,24 @@
+resource "artifactory_release_bundle_v2_distribution" "my-release-bundle-v2-distribution" {
+  name = "my-release-bundle-v2-artifacts"
+  version = "1.0.0"
+  auto_create_missing_repos = true
+
+  distribution_rules = [
+    {
+      site_name = "edge-*"
+      country_codes = ["US", "DE"]
+    },
+    {
+      city_name = "London"
+    }
+  ]
+
+  path_mappings = [{
+    input = "commons-qa-maven-local/(.*)"
+    output = "commons-edge-maven-local/$1"
+  }]
+
+  timeouts {
+    create = "1h"
+  }
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 	provider "github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/provider"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 )
 
 // Run the docs generation tool, check its repository for more information on how it works and how docs
@@ -71,6 +72,11 @@ func main() {
 		serveOpts...,
 	)
 
+	// Report the usage collected while serving, bounded so Terraform doesn't kill the provider first.
+	flushCtx, cancel := context.WithTimeout(ctx, usage.FlushTimeout)
+	usage.Flush(flushCtx)
+	cancel()
+
 	if err != nil {
 		log.Fatal(err)
 	}
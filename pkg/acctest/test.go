@@ -22,6 +22,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/go-version"
@@ -32,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	terraform2 "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/provider"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/configuration"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
@@ -258,6 +260,103 @@ func DeleteRepo(t *testing.T, repo string) {
 }
 
 // GetValidRandomDefaultRepoLayoutRef Usage of the function is strictly restricted to Test Cases
+// CreateBuild publishes a build run with one module, artifact and dependency, and the given properties.
+func CreateBuild(t *testing.T, name, number, projectKey string, properties map[string]string) {
+	restyClient := GetTestResty(t)
+
+	type Checksums struct {
+		Type string `json:"type,omitempty"`
+		ID   string `json:"id,omitempty"`
+		Name string `json:"name,omitempty"`
+		SHA1 string `json:"sha1"`
+	}
+
+	type Module struct {
+		ID           string      `json:"id"`
+		Artifacts    []Checksums `json:"artifacts"`
+		Dependencies []Checksums `json:"dependencies"`
+	}
+
+	type Build struct {
+		Version    string            `json:"version"`
+		Name       string            `json:"name"`
+		Number     string            `json:"number"`
+		Started    string            `json:"started"`
+		Properties map[string]string `json:"properties,omitempty"`
+		Modules    []Module          `json:"modules"`
+	}
+
+	build := Build{
+		Version:    "1.0.1",
+		Name:       name,
+		Number:     number,
+		Started:    time.Now().Format("2006-01-02T15:04:05.000-0700"),
+		Properties: properties,
+		Modules: []Module{
+			{
+				ID: "org.jfrog.test:multi1:3.7-SNAPSHOT",
+				Artifacts: []Checksums{
+					{
+						Type: "jar",
+						Name: "multi1-3.7-SNAPSHOT.jar",
+						SHA1: "f142780623aed30ba41d15a8db1ec24da8fd67e8",
+					},
+				},
+				Dependencies: []Checksums{
+					{
+						Type: "jar",
+						ID:   "junit:junit:4.13.2",
+						SHA1: "8ac9e16d933b6fb43bc7f576336b8f4d7eb5ba12",
+					},
+				},
+			},
+		},
+	}
+
+	request := restyClient.R()
+	if projectKey != "" {
+		request.SetQueryParam("project", projectKey)
+	}
+
+	response, err := request.
+		SetBody(build).
+		Put("artifactory/api/build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.IsError() {
+		t.Fatalf("failed to create build %s number %s: %s", name, number, response.String())
+	}
+}
+
+// DeleteBuild deletes all runs of a build, along with their artifacts.
+func DeleteBuild(t *testing.T, name, projectKey string) {
+	restyClient := GetTestResty(t)
+
+	type DeleteRequest struct {
+		BuildName       string `json:"buildName"`
+		BuildRepo       string `json:"buildRepo,omitempty"`
+		DeleteAll       bool   `json:"deleteAll"`
+		DeleteArtifacts bool   `json:"deleteArtifacts"`
+	}
+
+	body := DeleteRequest{
+		BuildName:       name,
+		DeleteAll:       true,
+		DeleteArtifacts: true,
+	}
+	if projectKey != "" {
+		body.BuildRepo = fmt.Sprintf("%s-build-info", projectKey)
+	}
+
+	response, err := restyClient.R().
+		SetBody(body).
+		Post("artifactory/api/build/delete")
+	if err != nil || response.IsError() {
+		t.Logf("The build %s doesn't exist", name)
+	}
+}
+
 func GetValidRandomDefaultRepoLayoutRef() string {
 	return testutil.RandSelect("simple-default", "bower-default", "composer-default", "conan-default", "go-default", "maven-2-default", "ivy-default", "npm-default", "nuget-default", "puppet-default", "sbt-default").(string)
 }
@@ -442,3 +541,20 @@ func SkipIfNotSupportedVersion(t *testing.T, supportedVersion string) error {
 	}
 	return nil
 }
+
+// SkipIfNotSupported skips the test when the server doesn't support the capability.
+func SkipIfNotSupported(t *testing.T, c capability.Capability) {
+	getVersion := util.GetArtifactoryVersion
+	if c.Product == capability.Access {
+		getVersion = util.GetAccessVersion
+	}
+
+	serverVersion, err := getVersion(GetTestResty(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !c.SupportedBy(serverVersion) {
+		t.Skipf("Test skip because: %s requires %s %s or later, server is %s", c.Feature, c.Product, c.MinVersion, serverVersion)
+	}
+}
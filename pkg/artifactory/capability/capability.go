@@ -0,0 +1,179 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package capability lists the features that are only available from a given Artifactory or Access
+// version, so that resources check them the same way and report unsupported configurations during
+// plan instead of failing on apply.
+package capability
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+type Product string
+
+const (
+	Artifactory Product = "Artifactory"
+	Access      Product = "Access"
+)
+
+// Capability is a feature available from MinVersion of Product.
+type Capability struct {
+	// Feature names the feature in error messages, e.g. the attribute or resource that requires it.
+	Feature    string
+	Product    Product
+	MinVersion string
+}
+
+var (
+	FederationStatus = Capability{
+		Feature:    "`wait_for_healthy_members`",
+		Product:    Artifactory,
+		MinVersion: "7.49.3",
+	}
+	CustomProjectEnvironments = Capability{
+		Feature:    "Custom `project_environments`",
+		Product:    Artifactory,
+		MinVersion: "7.53.1",
+	}
+	MultipleProjectEnvironments = Capability{
+		Feature:    "Multiple `project_environments`",
+		Product:    Artifactory,
+		MinVersion: "7.107.1",
+	}
+	AccessUsersAPI = Capability{
+		Feature:    "Access users API",
+		Product:    Artifactory,
+		MinVersion: "7.84.3",
+	}
+	ArchivePolicy = Capability{
+		Feature:    "`artifactory_archive_policy`",
+		Product:    Artifactory,
+		MinVersion: "7.102.0",
+	}
+	HelmChartEnforcement = Capability{
+		Feature:    "`force_non_duplicate_chart` and `force_metadata_name_version`",
+		Product:    Artifactory,
+		MinVersion: "7.104.0",
+	}
+	ReleaseBundleCleanupPolicyV2 = Capability{
+		Feature:    "`artifactory_release_bundle_v2_cleanup_policy`",
+		Product:    Artifactory,
+		MinVersion: "7.104.2",
+	}
+	PolicyConditionsInDays = Capability{
+		Feature:    "`created_before_in_days` and `last_downloaded_before_in_days`",
+		Product:    Artifactory,
+		MinVersion: "7.111.2",
+	}
+	WizRegistryHeadRequestsBypass = Capability{
+		Feature:    "Enforced `bypass_head_requests` for tf.app.wiz.io",
+		Product:    Artifactory,
+		MinVersion: "7.122.0",
+	}
+	PolicyTimeAndPropertiesConditions = Capability{
+		Feature:    "Combining time-based conditions with `included_properties`",
+		Product:    Artifactory,
+		MinVersion: "7.129.0",
+	}
+	RemoteCustomHTTPHeaders = Capability{
+		Feature:    "`custom_http_headers`",
+		Product:    Artifactory,
+		MinVersion: "7.146.0",
+	}
+)
+
+// All returns every registered capability.
+func All() []Capability {
+	return []Capability{
+		FederationStatus,
+		CustomProjectEnvironments,
+		MultipleProjectEnvironments,
+		AccessUsersAPI,
+		ArchivePolicy,
+		HelmChartEnforcement,
+		ReleaseBundleCleanupPolicyV2,
+		PolicyConditionsInDays,
+		WizRegistryHeadRequestsBypass,
+		PolicyTimeAndPropertiesConditions,
+		RemoteCustomHTTPHeaders,
+	}
+}
+
+// ServerVersion returns the version of the product the capability depends on, empty if unknown.
+func (c Capability) ServerVersion(meta util.ProviderMetadata) string {
+	if c.Product == Access {
+		return meta.AccessVersion
+	}
+
+	return meta.ArtifactoryVersion
+}
+
+// SupportedBy reports whether the given product version supports the capability. An unknown or
+// invalid version is treated as not supported.
+func (c Capability) SupportedBy(serverVersion string) bool {
+	current, err := version.NewVersion(serverVersion)
+	if err != nil {
+		return false
+	}
+
+	return current.GreaterThanOrEqual(version.Must(version.NewVersion(c.MinVersion)))
+}
+
+// Supported reports whether the server of the provider configuration supports the capability.
+func (c Capability) Supported(meta util.ProviderMetadata) bool {
+	return c.SupportedBy(c.ServerVersion(meta))
+}
+
+// Check returns an error naming the feature, the required version and the server version when the
+// server doesn't support the capability. The check is skipped when the server version is unknown,
+// e.g. during `terraform validate` without a configured provider.
+func (c Capability) Check(meta util.ProviderMetadata) error {
+	serverVersion := c.ServerVersion(meta)
+	if serverVersion == "" {
+		return nil
+	}
+
+	if _, err := version.NewVersion(serverVersion); err != nil {
+		return fmt.Errorf("could not parse %s version %s: %w", c.Product, serverVersion, err)
+	}
+
+	if !c.SupportedBy(serverVersion) {
+		return fmt.Errorf("%s requires %s %s or later, server is %s %s", c.Feature, c.Product, c.MinVersion, c.Product, serverVersion)
+	}
+
+	return nil
+}
+
+// Validate adds an error to diags when the server doesn't support the capability. The error is
+// reported on attrPath, or on the resource if attrPath is empty.
+func (c Capability) Validate(meta util.ProviderMetadata, attrPath path.Path, diags *diag.Diagnostics) {
+	err := c.Check(meta)
+	if err == nil {
+		return
+	}
+
+	summary := fmt.Sprintf("Unsupported %s version", c.Product)
+	if attrPath.Equal(path.Empty()) {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	diags.AddAttributeError(attrPath, summary, err.Error())
+}
@@ -0,0 +1,108 @@
+package capability
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAllMinVersionsAreValid(t *testing.T) {
+	for _, c := range All() {
+		if _, err := version.NewVersion(c.MinVersion); err != nil {
+			t.Fatalf("invalid min version for %s: %v", c.Feature, err)
+		}
+		if c.Product != Artifactory && c.Product != Access {
+			t.Fatalf("unexpected product for %s: %s", c.Feature, c.Product)
+		}
+	}
+}
+
+func TestSupportedBy(t *testing.T) {
+	testCases := []struct {
+		version  string
+		expected bool
+	}{
+		{"7.146.0", true},
+		{"7.146.1", true},
+		{"8.0.0", true},
+		{"7.145.9", false},
+		{"", false},
+		{"invalid", false},
+	}
+
+	for _, tc := range testCases {
+		if supported := RemoteCustomHTTPHeaders.SupportedBy(tc.version); supported != tc.expected {
+			t.Fatalf("expected SupportedBy(%q) to be %v", tc.version, tc.expected)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	err := RemoteCustomHTTPHeaders.Check(util.ProviderMetadata{ArtifactoryVersion: "7.120.0"})
+	if err == nil {
+		t.Fatalf("expected an error for an unsupported version")
+	}
+	if err.Error() != "`custom_http_headers` requires Artifactory 7.146.0 or later, server is Artifactory 7.120.0" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := RemoteCustomHTTPHeaders.Check(util.ProviderMetadata{ArtifactoryVersion: "7.146.0"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := RemoteCustomHTTPHeaders.Check(util.ProviderMetadata{}); err != nil {
+		t.Fatalf("expected the check to be skipped when the version is unknown, got: %v", err)
+	}
+
+	if err := RemoteCustomHTTPHeaders.Check(util.ProviderMetadata{ArtifactoryVersion: "invalid"}); err == nil {
+		t.Fatalf("expected an error for an invalid version")
+	}
+}
+
+func TestCheckAccessVersion(t *testing.T) {
+	c := Capability{
+		Feature:    "`test`",
+		Product:    Access,
+		MinVersion: "7.100.0",
+	}
+
+	meta := util.ProviderMetadata{
+		ArtifactoryVersion: "7.120.0",
+		AccessVersion:      "7.90.0",
+	}
+	err := c.Check(meta)
+	if err == nil || !strings.Contains(err.Error(), "server is Access 7.90.0") {
+		t.Fatalf("expected the Access version to be checked, got: %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	meta := util.ProviderMetadata{ArtifactoryVersion: "7.100.0"}
+
+	var diags diag.Diagnostics
+	ArchivePolicy.Validate(meta, path.Empty(), &diags)
+	if len(diags) != 1 || diags[0].Summary() != "Unsupported Artifactory version" {
+		t.Fatalf("expected an error, got: %v", diags)
+	}
+
+	diags = nil
+	attrPath := path.Root("search_criteria").AtName("created_before_in_days")
+	PolicyConditionsInDays.Validate(meta, attrPath, &diags)
+	if len(diags) != 1 {
+		t.Fatalf("expected an error, got: %v", diags)
+	}
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(attrPath) {
+		t.Fatalf("expected the error on %s, got: %v", attrPath, diags[0])
+	}
+
+	diags = nil
+	ArchivePolicy.Validate(util.ProviderMetadata{ArtifactoryVersion: "7.102.0"}, path.Empty(), &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+}
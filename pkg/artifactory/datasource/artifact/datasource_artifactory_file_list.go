@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
 	"github.com/jfrog/terraform-provider-shared/util"
 )
 
@@ -235,7 +236,7 @@ func (d *FileListDataSource) Configure(ctx context.Context, req datasource.Confi
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), d.TypeName)
 }
 
 func bool2String(v bool) string {
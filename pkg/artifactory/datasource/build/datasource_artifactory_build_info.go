@@ -0,0 +1,277 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/build"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+func NewBuildInfoDataSource() datasource.DataSource {
+	return &BuildInfoDataSource{
+		TypeName: "artifactory_build_info",
+	}
+}
+
+type BuildInfoDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type BuildInfoDataSourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Number     types.String `tfsdk:"number"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Started    types.String `tfsdk:"started"`
+	URL        types.String `tfsdk:"url"`
+	Modules    types.List   `tfsdk:"modules"`
+	EnvVars    types.Map    `tfsdk:"env_vars"`
+	Properties types.Map    `tfsdk:"properties"`
+}
+
+var buildArtifactAttrType = map[string]attr.Type{
+	"name":   types.StringType,
+	"type":   types.StringType,
+	"path":   types.StringType,
+	"sha1":   types.StringType,
+	"sha256": types.StringType,
+	"md5":    types.StringType,
+}
+
+var buildDependencyAttrType = map[string]attr.Type{
+	"id":     types.StringType,
+	"type":   types.StringType,
+	"sha1":   types.StringType,
+	"sha256": types.StringType,
+	"md5":    types.StringType,
+	"scopes": types.ListType{ElemType: types.StringType},
+}
+
+var buildModuleAttrType = map[string]attr.Type{
+	"id":           types.StringType,
+	"type":         types.StringType,
+	"artifacts":    types.ListType{ElemType: types.ObjectType{AttrTypes: buildArtifactAttrType}},
+	"dependencies": types.ListType{ElemType: types.ObjectType{AttrTypes: buildDependencyAttrType}},
+}
+
+func (m *BuildInfoDataSourceModel) fromAPIModel(ctx context.Context, buildInfo build.BuildInfoAPIModel) (diags diag.Diagnostics) {
+	modules := lo.Map(buildInfo.Modules, func(module build.BuildModuleAPIModel, _ int) attr.Value {
+		artifacts := lo.Map(module.Artifacts, func(a build.BuildArtifactAPIModel, _ int) attr.Value {
+			return types.ObjectValueMust(
+				buildArtifactAttrType,
+				map[string]attr.Value{
+					"name":   types.StringValue(a.Name),
+					"type":   types.StringValue(a.Type),
+					"path":   types.StringValue(a.Path),
+					"sha1":   types.StringValue(a.SHA1),
+					"sha256": types.StringValue(a.SHA256),
+					"md5":    types.StringValue(a.MD5),
+				},
+			)
+		})
+
+		dependencies := lo.Map(module.Dependencies, func(d build.BuildDependencyAPIModel, _ int) attr.Value {
+			scopes, ds := types.ListValueFrom(ctx, types.StringType, lo.Ternary(d.Scopes == nil, []string{}, d.Scopes))
+			diags.Append(ds...)
+
+			return types.ObjectValueMust(
+				buildDependencyAttrType,
+				map[string]attr.Value{
+					"id":     types.StringValue(d.ID),
+					"type":   types.StringValue(d.Type),
+					"sha1":   types.StringValue(d.SHA1),
+					"sha256": types.StringValue(d.SHA256),
+					"md5":    types.StringValue(d.MD5),
+					"scopes": scopes,
+				},
+			)
+		})
+
+		return types.ObjectValueMust(
+			buildModuleAttrType,
+			map[string]attr.Value{
+				"id":           types.StringValue(module.ID),
+				"type":         types.StringValue(module.Type),
+				"artifacts":    types.ListValueMust(types.ObjectType{AttrTypes: buildArtifactAttrType}, artifacts),
+				"dependencies": types.ListValueMust(types.ObjectType{AttrTypes: buildDependencyAttrType}, dependencies),
+			},
+		)
+	})
+
+	modulesList, ds := types.ListValue(types.ObjectType{AttrTypes: buildModuleAttrType}, modules)
+	diags.Append(ds...)
+
+	envVars, ds := types.MapValueFrom(ctx, types.StringType, buildInfo.EnvVars())
+	diags.Append(ds...)
+
+	properties, ds := types.MapValueFrom(ctx, types.StringType, buildInfo.CustomProperties())
+	diags.Append(ds...)
+
+	m.Number = types.StringValue(buildInfo.Number)
+	m.Started = types.StringValue(buildInfo.Started)
+	m.URL = types.StringValue(buildInfo.URL)
+	m.Modules = modulesList
+	m.EnvVars = envVars
+	m.Properties = properties
+
+	return
+}
+
+func (d *BuildInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *BuildInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	checksumAttributes := map[string]schema.Attribute{
+		"sha1":   schema.StringAttribute{Computed: true},
+		"sha256": schema.StringAttribute{Computed: true},
+		"md5":    schema.StringAttribute{Computed: true},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Name of the build.",
+			},
+			"number": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Number of the build run. When not set, the most recently started run is used.",
+			},
+			"project_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				MarkdownDescription: "Project key the build belongs to.",
+			},
+			"started": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Start time of the build run.",
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URL of the build run in the CI server.",
+			},
+			"modules": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Modules produced by the build run.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":   schema.StringAttribute{Computed: true},
+						"type": schema.StringAttribute{Computed: true},
+						"artifacts": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Artifacts produced by the module.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: lo.Assign(checksumAttributes, map[string]schema.Attribute{
+									"name": schema.StringAttribute{Computed: true},
+									"type": schema.StringAttribute{Computed: true},
+									"path": schema.StringAttribute{Computed: true},
+								}),
+							},
+						},
+						"dependencies": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Dependencies consumed by the module.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: lo.Assign(checksumAttributes, map[string]schema.Attribute{
+									"id":     schema.StringAttribute{Computed: true},
+									"type":   schema.StringAttribute{Computed: true},
+									"scopes": schema.ListAttribute{ElementType: types.StringType, Computed: true},
+								}),
+							},
+						},
+					},
+				},
+			},
+			"env_vars": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Environment variables captured by the build tool, without the `buildInfo.env.` prefix.",
+			},
+			"properties": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Other properties recorded on the build run.",
+			},
+		},
+		MarkdownDescription: "Provides the build-info of a build run: its modules, artifacts, dependencies, environment variables and properties.",
+	}
+}
+
+func (d *BuildInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), d.TypeName)
+}
+
+func (d *BuildInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BuildInfoDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	number := data.Number.ValueString()
+	if number == "" {
+		latestNumber, err := build.GetLatestBuildNumber(d.ProviderData.Client, data.Name.ValueString(), data.ProjectKey.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Data Source", err.Error())
+			return
+		}
+		number = latestNumber
+	}
+
+	buildInfo, err := build.GetBuildInfo(d.ProviderData.Client, data.Name.ValueString(), number, data.ProjectKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Data Source", err.Error())
+		return
+	}
+
+	if buildInfo == nil {
+		resp.Diagnostics.AddError("Unable to Read Data Source", fmt.Sprintf("build %s number %s not found", data.Name.ValueString(), number))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromAPIModel(ctx, *buildInfo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccDataSourceBuildInfo(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("build-info-", "data.artifactory_build_info")
+	_, latestFqrn, latestName := testutil.MkNames("build-info-latest-", "data.artifactory_build_info")
+
+	buildName := fmt.Sprintf("test-build-%d", testutil.RandomInt())
+
+	config := util.ExecuteTemplate("TestAccDataSourceBuildInfo", `
+	data "artifactory_build_info" "{{ .name }}" {
+		name   = "{{ .build_name }}"
+		number = "1"
+	}
+
+	data "artifactory_build_info" "{{ .latest_name }}" {
+		name = "{{ .build_name }}"
+	}`, map[string]string{
+		"name":        name,
+		"latest_name": latestName,
+		"build_name":  buildName,
+	})
+
+	properties := map[string]string{
+		"buildInfo.env.CI": "true",
+		"team":             "platform",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			for _, number := range []string{"1", "2"} {
+				acctest.CreateBuild(t, buildName, number, "", properties)
+			}
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			acctest.DeleteBuild(t, buildName, "")
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "number", "1"),
+					resource.TestCheckResourceAttrSet(fqrn, "started"),
+					resource.TestCheckResourceAttr(fqrn, "modules.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "modules.0.id", "org.jfrog.test:multi1:3.7-SNAPSHOT"),
+					resource.TestCheckResourceAttr(fqrn, "modules.0.artifacts.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "modules.0.artifacts.0.name", "multi1-3.7-SNAPSHOT.jar"),
+					resource.TestCheckResourceAttr(fqrn, "modules.0.dependencies.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "modules.0.dependencies.0.id", "junit:junit:4.13.2"),
+					resource.TestCheckResourceAttr(fqrn, "env_vars.CI", "true"),
+					resource.TestCheckResourceAttr(fqrn, "properties.team", "platform"),
+					resource.TestCheckNoResourceAttr(fqrn, "properties.buildInfo.env.CI"),
+					resource.TestCheckResourceAttr(latestFqrn, "number", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/configuration"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+)
+
+const cleanupPolicyExecutionsDefaultLimit = 10
+
+func NewCleanupPolicyExecutionsDataSource() datasource.DataSource {
+	return &CleanupPolicyExecutionsDataSource{
+		TypeName: "artifactory_cleanup_policy_executions",
+	}
+}
+
+type CleanupPolicyExecutionsDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type CleanupPolicyExecutionsDataSourceModel struct {
+	PolicyType types.String `tfsdk:"policy_type"`
+	PolicyKey  types.String `tfsdk:"policy_key"`
+	Limit      types.Int64  `tfsdk:"limit"`
+	Executions types.List   `tfsdk:"executions"`
+}
+
+var policyExecutionAttrType = map[string]attr.Type{
+	"execution_id":     types.StringType,
+	"status":           types.StringType,
+	"start_time":       types.StringType,
+	"end_time":         types.StringType,
+	"items_count":      types.Int64Type,
+	"total_size_bytes": types.Int64Type,
+}
+
+func (m *CleanupPolicyExecutionsDataSourceModel) fromExecutions(executions []configuration.PolicyExecution) diag.Diagnostics {
+	values := lo.Map(executions, func(e configuration.PolicyExecution, _ int) attr.Value {
+		return types.ObjectValueMust(
+			policyExecutionAttrType,
+			map[string]attr.Value{
+				"execution_id":     types.StringValue(e.ExecutionID),
+				"status":           types.StringValue(e.Status),
+				"start_time":       types.StringValue(e.StartTime),
+				"end_time":         types.StringValue(e.EndTime),
+				"items_count":      types.Int64Value(e.ItemsCount),
+				"total_size_bytes": types.Int64Value(e.TotalSize),
+			},
+		)
+	})
+
+	executionsList, diags := types.ListValue(types.ObjectType{AttrTypes: policyExecutionAttrType}, values)
+	m.Executions = executionsList
+
+	return diags
+}
+
+func (d *CleanupPolicyExecutionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *CleanupPolicyExecutionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"policy_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(configuration.PolicyTypes...),
+				},
+				MarkdownDescription: fmt.Sprintf("Type of the policy. One of: %s. Default value is `%s`.", strings.Join(configuration.PolicyTypes, ", "), configuration.PolicyTypeCleanup),
+			},
+			"policy_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Key of the `artifactory_package_cleanup_policy` (or `artifactory_archive_policy` with `policy_type = \"archive\"`).",
+			},
+			"limit": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+				MarkdownDescription: fmt.Sprintf("Maximum number of executions returned. Default value is `%d`.", cleanupPolicyExecutionsDefaultLimit),
+			},
+			"executions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Recent executions of the policy, the latest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"execution_id": schema.StringAttribute{Computed: true},
+						"status":       schema.StringAttribute{Computed: true, MarkdownDescription: "Status of the execution, e.g. `RUNNING`, `COMPLETED` or `FAILED`."},
+						"start_time":   schema.StringAttribute{Computed: true},
+						"end_time":     schema.StringAttribute{Computed: true, MarkdownDescription: "End time of the execution, empty while it is running."},
+						"items_count":  schema.Int64Attribute{Computed: true, MarkdownDescription: "Number of items deleted (cleanup policy) or archived (archive policy)."},
+						"total_size_bytes": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Total size, in bytes, of the items deleted or archived.",
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Provides the recent executions of a package cleanup or archive policy: scheduled runs and runs triggered with `run_trigger`.",
+	}
+}
+
+func (d *CleanupPolicyExecutionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), d.TypeName)
+}
+
+func (d *CleanupPolicyExecutionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CleanupPolicyExecutionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyType := configuration.PolicyTypeCleanup
+	if !data.PolicyType.IsNull() {
+		policyType = data.PolicyType.ValueString()
+	}
+
+	limit := cleanupPolicyExecutionsDefaultLimit
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	executions, err := configuration.GetPolicyExecutions(d.ProviderData.Client, policyType, data.PolicyKey.ValueString(), limit)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Data Source", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromExecutions(executions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccDataSourceCleanupPolicyExecutions(t *testing.T) {
+	_, _, repoName := testutil.MkNames("generic-local-", "artifactory_local_generic_repository")
+	_, policyFqrn, policyName := testutil.MkNames("test-cleanup-policy-", "artifactory_package_cleanup_policy")
+	_, fqrn, name := testutil.MkNames("executions-", "data.artifactory_cleanup_policy_executions")
+
+	config := util.ExecuteTemplate("TestAccCleanupPolicyExecutions", `
+		resource "artifactory_local_generic_repository" "{{ .repoName }}" {
+			key = "{{ .repoName }}"
+		}
+
+		resource "artifactory_package_cleanup_policy" "{{ .policyName }}" {
+			key                 = "{{ .policyName }}"
+			cron_expression     = "0 0 2 ? * MON-SAT *"
+			duration_in_minutes = 60
+			enabled             = false
+			run_trigger         = "1"
+
+			search_criteria = {
+				package_types          = ["generic"]
+				repos                  = [artifactory_local_generic_repository.{{ .repoName }}.key]
+				included_packages      = ["**"]
+				created_before_in_days = 30
+			}
+		}
+
+		data "artifactory_cleanup_policy_executions" "{{ .name }}" {
+			policy_key = artifactory_package_cleanup_policy.{{ .policyName }}.key
+			limit      = 5
+		}
+	`, map[string]string{
+		"repoName":   repoName,
+		"policyName": policyName,
+		"name":       name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6MuxProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(policyFqrn, "run_trigger", "1"),
+					resource.TestCheckResourceAttr(fqrn, "policy_key", policyName),
+					resource.TestCheckResourceAttrSet(fqrn, "executions.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,301 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/configuration"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+)
+
+func NewCleanupPolicyPreviewDataSource() datasource.DataSource {
+	return &CleanupPolicyPreviewDataSource{
+		TypeName: "artifactory_cleanup_policy_preview",
+	}
+}
+
+var _ datasource.DataSourceWithConfigValidators = (*CleanupPolicyPreviewDataSource)(nil)
+
+type CleanupPolicyPreviewDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type CleanupPolicyPreviewDataSourceModel struct {
+	PolicyType     types.String `tfsdk:"policy_type"`
+	PolicyKey      types.String `tfsdk:"policy_key"`
+	SearchCriteria types.Object `tfsdk:"search_criteria"`
+	SampleSize     types.Int64  `tfsdk:"sample_size"`
+	Repos          types.Set    `tfsdk:"repos"`
+	PackageCount   types.Int64  `tfsdk:"package_count"`
+	ArtifactCount  types.Int64  `tfsdk:"artifact_count"`
+	TotalSizeBytes types.Int64  `tfsdk:"total_size_bytes"`
+	Truncated      types.Bool   `tfsdk:"truncated"`
+	Packages       types.List   `tfsdk:"packages"`
+}
+
+var previewPackageAttrType = map[string]attr.Type{
+	"repo":            types.StringType,
+	"path":            types.StringType,
+	"artifact_count":  types.Int64Type,
+	"size_bytes":      types.Int64Type,
+	"created":         types.StringType,
+	"last_downloaded": types.StringType,
+}
+
+func (m *CleanupPolicyPreviewDataSourceModel) fromPreview(ctx context.Context, preview configuration.PolicyPreview) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	repos, d := types.SetValueFrom(ctx, types.StringType, preview.Repos)
+	diags.Append(d...)
+	m.Repos = repos
+
+	m.PackageCount = types.Int64Value(preview.PackageCount)
+	m.ArtifactCount = types.Int64Value(preview.ArtifactCount)
+	m.TotalSizeBytes = types.Int64Value(preview.SizeBytes)
+	m.Truncated = types.BoolValue(preview.Truncated)
+
+	packages := lo.Map(preview.Packages, func(p configuration.PolicyPreviewPackage, _ int) attr.Value {
+		return types.ObjectValueMust(
+			previewPackageAttrType,
+			map[string]attr.Value{
+				"repo":            types.StringValue(p.Repo),
+				"path":            types.StringValue(p.Path),
+				"artifact_count":  types.Int64Value(p.ArtifactCount),
+				"size_bytes":      types.Int64Value(p.SizeBytes),
+				"created":         types.StringValue(p.Created),
+				"last_downloaded": types.StringValue(p.LastDownloaded),
+			},
+		)
+	})
+
+	packagesList, d := types.ListValue(types.ObjectType{AttrTypes: previewPackageAttrType}, packages)
+	diags.Append(d...)
+	m.Packages = packagesList
+
+	return diags
+}
+
+func (d *CleanupPolicyPreviewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *CleanupPolicyPreviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	stringSet := func(description string) schema.SetAttribute {
+		return schema.SetAttribute{
+			ElementType:         types.StringType,
+			Optional:            true,
+			MarkdownDescription: description,
+		}
+	}
+
+	int64Condition := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			MarkdownDescription: description,
+		}
+	}
+
+	properties := func(description string) schema.MapAttribute {
+		return schema.MapAttribute{
+			ElementType:         types.ListType{ElemType: types.StringType},
+			Optional:            true,
+			MarkdownDescription: description,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"policy_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(configuration.PolicyTypes...),
+				},
+				MarkdownDescription: fmt.Sprintf("Type of the policy to preview. One of: %s. Default value is `%s`. Only used with `policy_key`.", strings.Join(configuration.PolicyTypes, ", "), configuration.PolicyTypeCleanup),
+			},
+			"policy_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Key of an existing `artifactory_package_cleanup_policy` (or `artifactory_archive_policy` with `policy_type = \"archive\"`) to preview. Conflicts with `search_criteria`.",
+			},
+			"search_criteria": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"package_types": schema.SetAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+						MarkdownDescription: "The package types of the policy.",
+					},
+					"repos": schema.SetAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+						MarkdownDescription: "Patterns for the repository names, or explicit repository names. For including all repos use `**`.",
+					},
+					"excluded_repos":                   stringSet("Explicit repository names excluded from the policy."),
+					"included_packages":                stringSet("Patterns for the package names. For including all packages use `**`."),
+					"excluded_packages":                stringSet("Patterns for the package names excluded from the policy."),
+					"include_all_projects":             schema.BoolAttribute{Optional: true},
+					"included_projects":                stringSet("Projects whose repositories are included in the policy."),
+					"created_before_in_months":         int64Condition("Packages created more than this number of months ago."),
+					"last_downloaded_before_in_months": int64Condition("Packages last downloaded more than this number of months ago."),
+					"created_before_in_days":           int64Condition("Packages created more than this number of days ago."),
+					"last_downloaded_before_in_days":   int64Condition("Packages last downloaded more than this number of days ago."),
+					"keep_last_n_versions":             int64Condition("Number of latest versions of each package to keep."),
+					"excluded_properties":              properties("Packages with one of these properties are excluded."),
+					"included_properties":              properties("Only packages with these properties are included."),
+				},
+				MarkdownDescription: "Search criteria to preview, with the same attributes as the `search_criteria` of `artifactory_package_cleanup_policy` and `artifactory_archive_policy`. Conflicts with `policy_key`.",
+			},
+			"sample_size": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 1000),
+				},
+				MarkdownDescription: fmt.Sprintf("Maximum number of package versions returned in `packages`. Default value is `%d`.", configuration.PolicyPreviewDefaultSampleSize),
+			},
+			"repos": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Local and federated repositories matching the repository patterns and package types.",
+			},
+			"package_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Estimated number of package versions affected by the policy.",
+			},
+			"artifact_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Estimated number of artifacts affected by the policy.",
+			},
+			"total_size_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Estimated total size, in bytes, of the artifacts affected by the policy.",
+			},
+			"truncated": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("`true` when the preview stopped at %d artifacts, the counts and size are then lower bounds.", configuration.PolicyPreviewMaxArtifacts),
+			},
+			"packages": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Sample of the affected package versions, the largest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"repo":            schema.StringAttribute{Computed: true},
+						"path":            schema.StringAttribute{Computed: true, MarkdownDescription: "Path of the package version folder."},
+						"artifact_count":  schema.Int64Attribute{Computed: true},
+						"size_bytes":      schema.Int64Attribute{Computed: true},
+						"created":         schema.StringAttribute{Computed: true, MarkdownDescription: "Creation time of the most recent artifact."},
+						"last_downloaded": schema.StringAttribute{Computed: true, MarkdownDescription: "Last download time of the package version, empty if never downloaded."},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Estimates which packages a package cleanup or archive policy would delete or archive, without running it. " +
+			"Artifactory has no dry-run API for these policies, so the search criteria is evaluated with AQL: a package version is the folder " +
+			"containing the matched artifacts, the package patterns are matched against the folder path and `keep_last_n_versions` keeps the most " +
+			"recently created folders of each parent folder. The actual policy run evaluates packages with their metadata and may differ.",
+	}
+}
+
+func (d CleanupPolicyPreviewDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("policy_key"),
+			path.MatchRoot("search_criteria"),
+		),
+	}
+}
+
+func (d *CleanupPolicyPreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), d.TypeName)
+}
+
+func (d *CleanupPolicyPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CleanupPolicyPreviewDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var criteria configuration.PolicySearchCriteriaAPIModel
+	if !data.PolicyKey.IsNull() {
+		policyType := configuration.PolicyTypeCleanup
+		if !data.PolicyType.IsNull() {
+			policyType = data.PolicyType.ValueString()
+		}
+
+		c, err := configuration.GetPolicySearchCriteria(d.ProviderData.Client, policyType, data.PolicyKey.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Data Source", err.Error())
+			return
+		}
+		criteria = c
+	} else {
+		c, diags := configuration.PolicySearchCriteriaFromObject(ctx, data.SearchCriteria)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		criteria = c
+	}
+
+	sampleSize := configuration.PolicyPreviewDefaultSampleSize
+	if !data.SampleSize.IsNull() {
+		sampleSize = int(data.SampleSize.ValueInt64())
+	}
+
+	preview, err := configuration.PreviewPolicy(ctx, d.ProviderData.Client, criteria, sampleSize)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Data Source", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromPreview(ctx, preview)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
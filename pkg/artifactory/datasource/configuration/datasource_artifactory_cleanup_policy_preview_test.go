@@ -0,0 +1,104 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccDataSourceCleanupPolicyPreview(t *testing.T) {
+	_, _, repoName := testutil.MkNames("generic-local-", "artifactory_local_generic_repository")
+	_, fqrn, name := testutil.MkNames("preview-", "data.artifactory_cleanup_policy_preview")
+
+	params := map[string]interface{}{
+		"repoName": repoName,
+		"name":     name,
+	}
+
+	repoConfig := util.ExecuteTemplate("TestAccCleanupPolicyPreview", `
+		resource "artifactory_local_generic_repository" "{{ .repoName }}" {
+			key = "{{ .repoName }}"
+		}
+	`, params)
+
+	config := util.ExecuteTemplate("TestAccCleanupPolicyPreview", `
+		resource "artifactory_local_generic_repository" "{{ .repoName }}" {
+			key = "{{ .repoName }}"
+		}
+
+		data "artifactory_cleanup_policy_preview" "{{ .name }}" {
+			search_criteria = {
+				package_types     = ["generic"]
+				repos             = [artifactory_local_generic_repository.{{ .repoName }}.key]
+				included_packages = ["**"]
+			}
+		}
+	`, params)
+
+	const invalidConfig = `
+		data "artifactory_cleanup_policy_preview" "invalid" {
+			policy_key = "my-policy"
+
+			search_criteria = {
+				package_types = ["generic"]
+				repos         = ["**"]
+			}
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      invalidConfig,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: repoConfig,
+				Check: func(*terraform.State) error {
+					for _, path := range []string{"app/1.0/app.txt", "app/2.0/app.txt"} {
+						_, err := acctest.GetTestResty(t).R().
+							SetRawPathParams(map[string]string{"key": repoName, "path": path}).
+							SetBody("test artifact").
+							Put("artifactory/{key}/{path}")
+						if err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "repos.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "repos.0", repoName),
+					resource.TestCheckResourceAttr(fqrn, "package_count", "2"),
+					resource.TestCheckResourceAttr(fqrn, "artifact_count", "2"),
+					resource.TestCheckResourceAttr(fqrn, "total_size_bytes", "26"),
+					resource.TestCheckResourceAttr(fqrn, "packages.#", "2"),
+				),
+			},
+		},
+	})
+}
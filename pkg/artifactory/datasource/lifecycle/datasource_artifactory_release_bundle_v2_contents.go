@@ -0,0 +1,156 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/lifecycle"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+func NewReleaseBundleV2ContentsDataSource() datasource.DataSource {
+	return &ReleaseBundleV2ContentsDataSource{
+		TypeName: "artifactory_release_bundle_v2_contents",
+	}
+}
+
+type ReleaseBundleV2ContentsDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ReleaseBundleV2ContentsDataSourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Version    types.String `tfsdk:"version"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Artifacts  types.List   `tfsdk:"artifacts"`
+}
+
+var releaseBundleV2ArtifactAttrType = map[string]attr.Type{
+	"path":                   types.StringType,
+	"sha256":                 types.StringType,
+	"size":                   types.Int64Type,
+	"package_type":           types.StringType,
+	"source_repository_key":  types.StringType,
+	"source_repository_path": types.StringType,
+}
+
+func (m *ReleaseBundleV2ContentsDataSourceModel) fromArtifacts(artifacts []lifecycle.ReleaseBundleV2ArtifactAPIModel) diag.Diagnostics {
+	values := lo.Map(artifacts, func(a lifecycle.ReleaseBundleV2ArtifactAPIModel, _ int) attr.Value {
+		return types.ObjectValueMust(
+			releaseBundleV2ArtifactAttrType,
+			map[string]attr.Value{
+				"path":                   types.StringValue(a.Path),
+				"sha256":                 types.StringValue(a.SHA256),
+				"size":                   types.Int64Value(a.Size),
+				"package_type":           types.StringValue(a.PackageType),
+				"source_repository_key":  types.StringValue(a.SourceRepositoryKey),
+				"source_repository_path": types.StringValue(a.SourceRepositoryPath),
+			},
+		)
+	})
+
+	artifactsList, diags := types.ListValue(types.ObjectType{AttrTypes: releaseBundleV2ArtifactAttrType}, values)
+	m.Artifacts = artifactsList
+
+	return diags
+}
+
+func (d *ReleaseBundleV2ContentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ReleaseBundleV2ContentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Name of the Release Bundle.",
+			},
+			"version": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Version of the Release Bundle.",
+			},
+			"project_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				MarkdownDescription: "Project key the Release Bundle belongs to.",
+			},
+			"artifacts": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Artifacts of the Release Bundle version.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path":                   schema.StringAttribute{Computed: true, MarkdownDescription: "Path of the artifact in the Release Bundle repository."},
+						"sha256":                 schema.StringAttribute{Computed: true},
+						"size":                   schema.Int64Attribute{Computed: true, MarkdownDescription: "Size of the artifact, in bytes."},
+						"package_type":           schema.StringAttribute{Computed: true},
+						"source_repository_key":  schema.StringAttribute{Computed: true, MarkdownDescription: "Key of the repository the artifact was collected from."},
+						"source_repository_path": schema.StringAttribute{Computed: true, MarkdownDescription: "Path of the artifact in the source repository."},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Provides the artifacts of a Release Bundle v2 version, with their checksums and source repositories.",
+	}
+}
+
+func (d *ReleaseBundleV2ContentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), d.TypeName)
+}
+
+func (d *ReleaseBundleV2ContentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ReleaseBundleV2ContentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	artifacts, err := lifecycle.GetReleaseBundleV2Artifacts(d.ProviderData.Client, data.Name.ValueString(), data.Version.ValueString(), data.ProjectKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Data Source", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromArtifacts(artifacts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
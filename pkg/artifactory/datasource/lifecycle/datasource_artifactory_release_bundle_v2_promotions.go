@@ -0,0 +1,221 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/lifecycle"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+func NewReleaseBundleV2PromotionsDataSource() datasource.DataSource {
+	return &ReleaseBundleV2PromotionsDataSource{
+		TypeName: "artifactory_release_bundle_v2_promotions",
+	}
+}
+
+type ReleaseBundleV2PromotionsDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ReleaseBundleV2PromotionsDataSourceModel struct {
+	Name                       types.String `tfsdk:"name"`
+	Version                    types.String `tfsdk:"version"`
+	ProjectKey                 types.String `tfsdk:"project_key"`
+	Environment                types.String `tfsdk:"environment"`
+	Promotions                 types.List   `tfsdk:"promotions"`
+	LatestVersionByEnvironment types.Map    `tfsdk:"latest_version_by_environment"`
+}
+
+var releaseBundleV2PromotionAttrType = map[string]attr.Type{
+	"version":        types.StringType,
+	"environment":    types.StringType,
+	"status":         types.StringType,
+	"repository_key": types.StringType,
+	"created":        types.StringType,
+	"created_millis": types.Int64Type,
+	"created_by":     types.StringType,
+	"service_id":     types.StringType,
+}
+
+// fromPromotions expects promotions sorted the latest first.
+func (m *ReleaseBundleV2PromotionsDataSourceModel) fromPromotions(promotions []lifecycle.ReleaseBundleV2PromotionRecordAPIModel) (diags diag.Diagnostics) {
+	values := lo.Map(promotions, func(p lifecycle.ReleaseBundleV2PromotionRecordAPIModel, _ int) attr.Value {
+		return types.ObjectValueMust(
+			releaseBundleV2PromotionAttrType,
+			map[string]attr.Value{
+				"version":        types.StringValue(p.Version),
+				"environment":    types.StringValue(p.Environment),
+				"status":         types.StringValue(p.Status),
+				"repository_key": types.StringValue(p.RepositoryKey),
+				"created":        types.StringValue(p.Created),
+				"created_millis": types.Int64Value(p.CreatedMillis),
+				"created_by":     types.StringValue(p.CreatedBy),
+				"service_id":     types.StringValue(p.ServiceID),
+			},
+		)
+	})
+
+	promotionsList, d := types.ListValue(types.ObjectType{AttrTypes: releaseBundleV2PromotionAttrType}, values)
+	diags.Append(d...)
+	m.Promotions = promotionsList
+
+	latest := map[string]attr.Value{}
+	for _, p := range promotions {
+		if _, ok := latest[p.Environment]; !ok && p.Status == "COMPLETED" {
+			latest[p.Environment] = types.StringValue(p.Version)
+		}
+	}
+
+	latestMap, d := types.MapValue(types.StringType, latest)
+	diags.Append(d...)
+	m.LatestVersionByEnvironment = latestMap
+
+	return
+}
+
+func (d *ReleaseBundleV2PromotionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ReleaseBundleV2PromotionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Name of the Release Bundle.",
+			},
+			"version": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Version of the Release Bundle. If omitted, the promotions of all the `COMPLETED` versions are returned.",
+			},
+			"project_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				MarkdownDescription: "Project key the Release Bundle belongs to.",
+			},
+			"environment": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Only return promotions to this environment.",
+			},
+			"promotions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Promotion records, the latest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version":        schema.StringAttribute{Computed: true},
+						"environment":    schema.StringAttribute{Computed: true},
+						"status":         schema.StringAttribute{Computed: true, MarkdownDescription: "Status of the promotion, e.g. `COMPLETED` or `FAILED`."},
+						"repository_key": schema.StringAttribute{Computed: true, MarkdownDescription: "Key of the Release Bundle repository."},
+						"created":        schema.StringAttribute{Computed: true, MarkdownDescription: "Timestamp when the promotion was created (ISO 8601 standard)."},
+						"created_millis": schema.Int64Attribute{Computed: true, MarkdownDescription: "Timestamp when the promotion was created (in milliseconds)."},
+						"created_by":     schema.StringAttribute{Computed: true},
+						"service_id":     schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+			"latest_version_by_environment": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Version of the latest `COMPLETED` promotion to each environment, e.g. `latest_version_by_environment[\"PROD\"]` is the version currently in `PROD`.",
+			},
+		},
+		MarkdownDescription: "Provides the promotion history of a Release Bundle v2, per environment.",
+	}
+}
+
+func (d *ReleaseBundleV2PromotionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), d.TypeName)
+}
+
+func (d *ReleaseBundleV2PromotionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ReleaseBundleV2PromotionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	projectKey := data.ProjectKey.ValueString()
+
+	versions := []string{data.Version.ValueString()}
+	if data.Version.IsNull() {
+		records, err := lifecycle.GetReleaseBundleV2Versions(d.ProviderData.Client, name, projectKey)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Data Source", err.Error())
+			return
+		}
+
+		versions = lo.FilterMap(records, func(v lifecycle.ReleaseBundleV2VersionAPIModel, _ int) (string, bool) {
+			return v.Version, v.Status == "COMPLETED"
+		})
+	}
+
+	var promotions []lifecycle.ReleaseBundleV2PromotionRecordAPIModel
+	for _, version := range versions {
+		records, err := lifecycle.GetReleaseBundleV2Promotions(d.ProviderData.Client, name, version, projectKey)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Data Source", err.Error())
+			return
+		}
+
+		promotions = append(promotions, records...)
+	}
+
+	if !data.Environment.IsNull() {
+		promotions = lo.Filter(promotions, func(p lifecycle.ReleaseBundleV2PromotionRecordAPIModel, _ int) bool {
+			return p.Environment == data.Environment.ValueString()
+		})
+	}
+
+	sort.SliceStable(promotions, func(i, j int) bool {
+		return promotions[i].CreatedMillis > promotions[j].CreatedMillis
+	})
+
+	resp.Diagnostics.Append(data.fromPromotions(promotions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,250 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+const (
+	releaseBundleV2EvidenceEndpoint = "artifactory/{repository_key}/{name}/{version}/release-bundle.json.evd"
+	releaseBundleV2ManifestEndpoint = "artifactory/{repository_key}/{name}/{version}/release-bundle.json"
+
+	defaultReleaseBundleV2RepositoryKey = "release-bundles-v2"
+)
+
+func NewReleaseBundleV2SignatureDataSource() datasource.DataSource {
+	return &ReleaseBundleV2SignatureDataSource{
+		TypeName: "artifactory_release_bundle_v2_signature",
+	}
+}
+
+type ReleaseBundleV2SignatureDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ReleaseBundleV2SignatureDataSourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	Version         types.String `tfsdk:"version"`
+	ProjectKey      types.String `tfsdk:"project_key"`
+	RepositoryKey   types.String `tfsdk:"repository_key"`
+	PublicKey       types.String `tfsdk:"public_key"`
+	FailIfInvalid   types.Bool   `tfsdk:"fail_if_invalid"`
+	Valid           types.Bool   `tfsdk:"valid"`
+	Signer          types.String `tfsdk:"signer"`
+	ValidationError types.String `tfsdk:"validation_error"`
+	PayloadType     types.String `tfsdk:"payload_type"`
+	Manifest        types.String `tfsdk:"manifest"`
+}
+
+func (d *ReleaseBundleV2SignatureDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ReleaseBundleV2SignatureDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Name of the Release Bundle.",
+			},
+			"version": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Version of the Release Bundle.",
+			},
+			"project_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				MarkdownDescription: "Project key the Release Bundle belongs to.",
+			},
+			"repository_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: fmt.Sprintf("Key of the Release Bundle repository. Default value is `%s`, or `<project_key>-%s` when `project_key` is set.", defaultReleaseBundleV2RepositoryKey, defaultReleaseBundleV2RepositoryKey),
+			},
+			"public_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "PEM encoded RSA, ECDSA or Ed25519 public key to verify the signature with, e.g. the `public_key` of the `artifactory_keypair` used as `keypair_name`, or of an `artifactory_distribution_public_key`.",
+			},
+			"fail_if_invalid": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Fail the read when the signature is not valid, instead of only setting `valid` to `false`. Default value is `false`.",
+			},
+			"valid": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "`true` when the Release Bundle version is signed with the private key matching `public_key`, and the subject of the signed in-toto statement is this Release Bundle version with the SHA-256 digest of its `manifest`. A subject without a SHA-256 digest, or a missing manifest, is not valid.",
+			},
+			"signer": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 fingerprint (hex) of the DER encoded `public_key` that verified the signature, empty when `valid` is `false`.",
+			},
+			"validation_error": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Reason why the signature is not valid, empty when `valid` is `true`.",
+			},
+			"payload_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Type of the signed payload, e.g. `application/vnd.in-toto+json`.",
+			},
+			"manifest": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Manifest of the Release Bundle version (`release-bundle.json`), whose digest is signed through the in-toto statement. Empty when it is not found. Only trust its content when `valid` is `true`.",
+			},
+		},
+		MarkdownDescription: "Fetches the signed manifest of a Release Bundle v2 version and verifies its signature locally against a public key.",
+	}
+}
+
+func (d *ReleaseBundleV2SignatureDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), d.TypeName)
+}
+
+func (d *ReleaseBundleV2SignatureDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ReleaseBundleV2SignatureDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repositoryKey := defaultReleaseBundleV2RepositoryKey
+	if !data.RepositoryKey.IsNull() {
+		repositoryKey = data.RepositoryKey.ValueString()
+	} else if !data.ProjectKey.IsNull() {
+		repositoryKey = fmt.Sprintf("%s-%s", data.ProjectKey.ValueString(), defaultReleaseBundleV2RepositoryKey)
+	}
+
+	var envelope dsseEnvelope
+	response, err := d.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"repository_key": repositoryKey,
+			"name":           data.Name.ValueString(),
+			"version":        data.Version.ValueString(),
+		}).
+		SetResult(&envelope).
+		ForceContentType("application/json").
+		Get(releaseBundleV2EvidenceEndpoint)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Data Source", err.Error())
+		return
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			fmt.Sprintf("signed manifest of release bundle %s version %s not found in repository %s", data.Name.ValueString(), data.Version.ValueString(), repositoryKey),
+		)
+		return
+	}
+
+	if response.IsError() {
+		resp.Diagnostics.AddError("Unable to Read Data Source", response.String())
+		return
+	}
+
+	manifest, err := d.getManifest(repositoryKey, data.Name.ValueString(), data.Version.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Data Source", err.Error())
+		return
+	}
+
+	payload, signer, err := verifyEnvelope(envelope, data.PublicKey.ValueString())
+	if err == nil {
+		if manifest == nil {
+			err = fmt.Errorf("manifest of release bundle %s version %s not found in repository %s", data.Name.ValueString(), data.Version.ValueString(), repositoryKey)
+		} else {
+			digest := sha256.Sum256(manifest)
+			err = verifyStatement(payload, data.Name.ValueString(), data.Version.ValueString(), hex.EncodeToString(digest[:]))
+		}
+
+		if err != nil {
+			signer = ""
+		}
+	}
+
+	data.Valid = types.BoolValue(err == nil)
+	data.Signer = types.StringValue(signer)
+	data.ValidationError = types.StringValue("")
+	data.PayloadType = types.StringValue(envelope.PayloadType)
+	data.Manifest = types.StringValue(string(manifest))
+
+	if err != nil {
+		data.ValidationError = types.StringValue(err.Error())
+
+		if data.FailIfInvalid.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Invalid Release Bundle Signature",
+				fmt.Sprintf("Release bundle %s version %s: %s", data.Name.ValueString(), data.Version.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getManifest returns the manifest of the Release Bundle version, nil if it is not found.
+func (d *ReleaseBundleV2SignatureDataSource) getManifest(repositoryKey, name, version string) ([]byte, error) {
+	response, err := d.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"repository_key": repositoryKey,
+			"name":           name,
+			"version":        version,
+		}).
+		Get(releaseBundleV2ManifestEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if response.IsError() {
+		return nil, fmt.Errorf("failed to get manifest of release bundle %s version %s: %s", name, version, response.String())
+	}
+
+	return response.Body(), nil
+}
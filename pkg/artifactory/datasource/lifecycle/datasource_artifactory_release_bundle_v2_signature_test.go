@@ -0,0 +1,61 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccDataSourceReleaseBundleV2Signature(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("signature-", "data.artifactory_release_bundle_v2_signature")
+
+	releaseBundleConfig, testData := releaseBundleTestConfig(t)
+	testData["name"] = name
+
+	config := releaseBundleConfig + util.ExecuteTemplate("TestAccDataSourceReleaseBundleV2Signature", `
+	data "artifactory_release_bundle_v2_signature" "{{ .name }}" {
+		name            = artifactory_release_bundle_v2.{{ .release_bundle_name }}.name
+		version         = artifactory_release_bundle_v2.{{ .release_bundle_name }}.version
+		public_key      = artifactory_keypair.{{ .keypair_name }}.public_key
+		fail_if_invalid = true
+	}`, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			acctest.DeleteRepo(t, testData["repo_name"])
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "valid", "true"),
+					resource.TestCheckResourceAttr(fqrn, "validation_error", ""),
+					resource.TestCheckResourceAttrSet(fqrn, "signer"),
+					resource.TestCheckResourceAttrSet(fqrn, "manifest"),
+				),
+			},
+		},
+	})
+}
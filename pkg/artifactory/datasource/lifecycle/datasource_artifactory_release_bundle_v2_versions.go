@@ -0,0 +1,210 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/lifecycle"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+func NewReleaseBundleV2VersionsDataSource() datasource.DataSource {
+	return &ReleaseBundleV2VersionsDataSource{
+		TypeName: "artifactory_release_bundle_v2_versions",
+	}
+}
+
+type ReleaseBundleV2VersionsDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ReleaseBundleV2VersionsDataSourceModel struct {
+	Name          types.String `tfsdk:"name"`
+	ProjectKey    types.String `tfsdk:"project_key"`
+	Status        types.String `tfsdk:"status"`
+	CreatedAfter  types.String `tfsdk:"created_after"`
+	CreatedBefore types.String `tfsdk:"created_before"`
+	Versions      types.List   `tfsdk:"versions"`
+}
+
+var releaseBundleV2VersionAttrType = map[string]attr.Type{
+	"version":        types.StringType,
+	"status":         types.StringType,
+	"release_status": types.StringType,
+	"repository_key": types.StringType,
+	"created":        types.StringType,
+	"created_by":     types.StringType,
+	"service_id":     types.StringType,
+}
+
+func (m *ReleaseBundleV2VersionsDataSourceModel) fromVersions(versions []lifecycle.ReleaseBundleV2VersionAPIModel) diag.Diagnostics {
+	values := lo.Map(versions, func(v lifecycle.ReleaseBundleV2VersionAPIModel, _ int) attr.Value {
+		return types.ObjectValueMust(
+			releaseBundleV2VersionAttrType,
+			map[string]attr.Value{
+				"version":        types.StringValue(v.Version),
+				"status":         types.StringValue(v.Status),
+				"release_status": types.StringValue(v.ReleaseStatus),
+				"repository_key": types.StringValue(v.RepositoryKey),
+				"created":        types.StringValue(v.Created),
+				"created_by":     types.StringValue(v.CreatedBy),
+				"service_id":     types.StringValue(v.ServiceID),
+			},
+		)
+	})
+
+	versionsList, diags := types.ListValue(types.ObjectType{AttrTypes: releaseBundleV2VersionAttrType}, values)
+	m.Versions = versionsList
+
+	return diags
+}
+
+// parseTimeFilter parses an optional ISO 8601 attribute, returning the zero time when it is not set.
+func parseTimeFilter(value types.String, attrPath path.Path, diags *diag.Diagnostics) time.Time {
+	if value.IsNull() {
+		return time.Time{}
+	}
+
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attrPath, "Invalid Attribute Value", fmt.Sprintf("Expected an ISO 8601 timestamp, e.g. 2024-01-31T00:00:00Z: %s", err))
+	}
+
+	return t
+}
+
+func (d *ReleaseBundleV2VersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ReleaseBundleV2VersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Name of the Release Bundle.",
+			},
+			"project_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				MarkdownDescription: "Project key the Release Bundle belongs to.",
+			},
+			"status": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("PENDING", "PROCESSING", "COMPLETED", "FAILED"),
+				},
+				MarkdownDescription: "Only return versions with this creation status. One of: `PENDING`, `PROCESSING`, `COMPLETED`, `FAILED`.",
+			},
+			"created_after": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return versions created at or after this time (ISO 8601, e.g. `2024-01-31T00:00:00Z`).",
+			},
+			"created_before": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return versions created before this time (ISO 8601, e.g. `2024-01-31T00:00:00Z`).",
+			},
+			"versions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Versions of the Release Bundle, the latest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version":        schema.StringAttribute{Computed: true},
+						"status":         schema.StringAttribute{Computed: true, MarkdownDescription: "Creation status of the version, e.g. `COMPLETED` or `FAILED`."},
+						"release_status": schema.StringAttribute{Computed: true, MarkdownDescription: "Release status of the version, e.g. `PROMOTED` or `RELEASED`."},
+						"repository_key": schema.StringAttribute{Computed: true, MarkdownDescription: "Key of the Release Bundle repository."},
+						"created":        schema.StringAttribute{Computed: true, MarkdownDescription: "Timestamp when the version was created (ISO 8601 standard)."},
+						"created_by":     schema.StringAttribute{Computed: true},
+						"service_id":     schema.StringAttribute{Computed: true, MarkdownDescription: "The unique identifier of the Artifactory instance where the version was created."},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Provides the versions of a Release Bundle v2, including versions not managed by Terraform.",
+	}
+}
+
+func (d *ReleaseBundleV2VersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), d.TypeName)
+}
+
+func (d *ReleaseBundleV2VersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ReleaseBundleV2VersionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdAfter := parseTimeFilter(data.CreatedAfter, path.Root("created_after"), &resp.Diagnostics)
+	createdBefore := parseTimeFilter(data.CreatedBefore, path.Root("created_before"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions, err := lifecycle.GetReleaseBundleV2Versions(d.ProviderData.Client, data.Name.ValueString(), data.ProjectKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Data Source", err.Error())
+		return
+	}
+
+	versions = lo.Filter(versions, func(v lifecycle.ReleaseBundleV2VersionAPIModel, _ int) bool {
+		if !data.Status.IsNull() && v.Status != data.Status.ValueString() {
+			return false
+		}
+
+		if createdAfter.IsZero() && createdBefore.IsZero() {
+			return true
+		}
+
+		created, err := time.Parse(time.RFC3339, v.Created)
+		if err != nil {
+			return false
+		}
+
+		return (createdAfter.IsZero() || !created.Before(createdAfter)) &&
+			(createdBefore.IsZero() || created.Before(createdBefore))
+	})
+
+	resp.Diagnostics.Append(data.fromVersions(versions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
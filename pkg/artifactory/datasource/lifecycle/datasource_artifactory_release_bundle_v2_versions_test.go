@@ -0,0 +1,124 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// releaseBundleTestConfig uploads an artifact to a new repository and returns the configuration
+// of a Release Bundle version built from it and promoted to DEV.
+func releaseBundleTestConfig(t *testing.T) (string, map[string]string) {
+	repoName := fmt.Sprintf("test-repo-%d", testutil.RandomInt())
+	acctest.CreateRepo(t, repoName, "local", "maven", true, true)
+
+	body, err := os.ReadFile("../../../../samples/multi1-3.7-20220310.233748-1.jar")
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+
+	_, err = acctest.GetTestResty(t).R().
+		SetHeader("Content-Type", "application/java-archive").
+		SetBody(body).
+		Put(fmt.Sprintf("/artifactory/%s/org/jfrog/test/multi1/3.7-SNAPSHOT/multi1-3.7-SNAPSHOT.jar", repoName))
+	if err != nil {
+		t.Fatalf("failed to upload file: %s", err)
+	}
+
+	testData := map[string]string{
+		"keypair_name":        fmt.Sprintf("test-keypair-%d", testutil.RandomInt()),
+		"repo_name":           repoName,
+		"release_bundle_name": fmt.Sprintf("test-release-bundle-v2-%d", testutil.RandomInt()),
+		"private_key":         os.Getenv("JFROG_TEST_RSA_PRIVATE_KEY"),
+		"public_key":          os.Getenv("JFROG_TEST_RSA_PUBLIC_KEY"),
+	}
+
+	const template = `
+	resource "artifactory_keypair" "{{ .keypair_name }}" {
+		pair_name = "{{ .keypair_name }}"
+		pair_type = "RSA"
+		alias = "test-alias-{{ .keypair_name }}"
+		private_key = <<EOF
+{{ .private_key }}
+EOF
+		public_key = <<EOF
+{{ .public_key }}
+EOF
+	}
+
+	resource "artifactory_release_bundle_v2" "{{ .release_bundle_name }}" {
+		name = "{{ .release_bundle_name }}"
+		version = "1.0.0"
+		keypair_name = artifactory_keypair.{{ .keypair_name }}.pair_name
+		skip_docker_manifest_resolution = true
+		source_type = "aql"
+
+		source = {
+			aql = "items.find({\"repo\": {\"$match\": \"{{ .repo_name }}\"}})"
+		}
+	}
+
+	resource "artifactory_release_bundle_v2_promotion" "{{ .release_bundle_name }}" {
+		name = artifactory_release_bundle_v2.{{ .release_bundle_name }}.name
+		version = artifactory_release_bundle_v2.{{ .release_bundle_name }}.version
+		keypair_name = artifactory_keypair.{{ .keypair_name }}.pair_name
+		environment = "DEV"
+		included_repository_keys = ["{{ .repo_name }}"]
+	}`
+
+	return util.ExecuteTemplate("releaseBundleTestConfig", template, testData), testData
+}
+
+func TestAccDataSourceReleaseBundleV2Versions(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("versions-", "data.artifactory_release_bundle_v2_versions")
+
+	releaseBundleConfig, testData := releaseBundleTestConfig(t)
+	testData["name"] = name
+
+	config := releaseBundleConfig + util.ExecuteTemplate("TestAccDataSourceReleaseBundleV2Versions", `
+	data "artifactory_release_bundle_v2_versions" "{{ .name }}" {
+		name   = artifactory_release_bundle_v2.{{ .release_bundle_name }}.name
+		status = "COMPLETED"
+	}`, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			acctest.DeleteRepo(t, testData["repo_name"])
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "versions.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "versions.0.version", "1.0.0"),
+					resource.TestCheckResourceAttr(fqrn, "versions.0.status", "COMPLETED"),
+					resource.TestCheckResourceAttrSet(fqrn, "versions.0.created"),
+				),
+			},
+		},
+	})
+}
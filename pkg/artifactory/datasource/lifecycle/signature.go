@@ -0,0 +1,198 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// dsseEnvelope is the signed evidence envelope (https://github.com/secure-systems-lab/dsse)
+// stored next to each Release Bundle v2 version.
+type dsseEnvelope struct {
+	Payload     string          `json:"payload"`
+	PayloadType string          `json:"payloadType"`
+	Signatures  []dsseSignature `json:"signatures"`
+}
+
+type dsseSignature struct {
+	KeyID string `json:"keyid"`
+	Sig   string `json:"sig"`
+}
+
+// inTotoStatement is the signed payload of the envelope (https://github.com/in-toto/attestation),
+// only the subject is used to check that the envelope belongs to the Release Bundle version.
+type inTotoStatement struct {
+	Type    string          `json:"_type"`
+	Subject []inTotoSubject `json:"subject"`
+}
+
+type inTotoSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// preAuthEncoding returns the DSSE pre-authentication encoding, which is the message actually signed.
+func preAuthEncoding(payloadType string, payload []byte) []byte {
+	return fmt.Appendf(nil, "DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload)
+}
+
+func parsePublicKey(publicKeyPEM string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(publicKeyPEM))
+	if block == nil {
+		return nil, errors.New("public key is not PEM encoded")
+	}
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported public key type %q, expected an RSA, ECDSA or Ed25519 public key", block.Type)
+	}
+}
+
+func verifySignature(publicKey crypto.PublicKey, message, signature []byte) bool {
+	digest := sha256.Sum256(message)
+
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil ||
+			rsa.VerifyPSS(key, crypto.SHA256, digest[:], signature, nil) == nil
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, digest[:], signature)
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, message, signature)
+	default:
+		return false
+	}
+}
+
+// publicKeyFingerprint returns the hex encoded SHA-256 digest of the DER encoded public key.
+func publicKeyFingerprint(publicKey crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(der)
+	return hex.EncodeToString(digest[:]), nil
+}
+
+// verifyEnvelope checks the envelope signatures against the public key. It returns the
+// decoded payload and the fingerprint of the public key when a signature is valid, or an
+// error describing why none of the signatures is valid. The key IDs of the signatures are
+// not signed, so they are ignored.
+func verifyEnvelope(envelope dsseEnvelope, publicKeyPEM string) ([]byte, string, error) {
+	publicKey, err := parsePublicKey(publicKeyPEM)
+	if err != nil {
+		return nil, "", err
+	}
+
+	fingerprint, err := publicKeyFingerprint(publicKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid envelope payload: %w", err)
+	}
+
+	if len(envelope.Signatures) == 0 {
+		return payload, "", errors.New("envelope is not signed")
+	}
+
+	message := preAuthEncoding(envelope.PayloadType, payload)
+	for _, signature := range envelope.Signatures {
+		sig, err := base64.StdEncoding.DecodeString(signature.Sig)
+		if err != nil {
+			continue
+		}
+
+		if verifySignature(publicKey, message, sig) {
+			return payload, fingerprint, nil
+		}
+	}
+
+	return payload, "", errors.New("no signature matches the public key")
+}
+
+// subjectMatches returns true if the subject name has the name and version of the Release Bundle as
+// consecutive path segments, e.g. `release-bundles-v2/my-bundle/1.0/release-bundle.json`.
+func subjectMatches(subjectName, name, version string) bool {
+	segments := strings.Split(strings.Trim(subjectName, "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if segments[i] == name && segments[i+1] == version {
+			return true
+		}
+	}
+
+	return false
+}
+
+// verifyStatement checks that the signed payload is an in-toto statement about the Release Bundle version,
+// so that a valid envelope of another Release Bundle can't be passed off for this one, and that the subject
+// digest is manifestSHA256, so that the manifest is the signed one.
+func verifyStatement(payload []byte, name, version, manifestSHA256 string) error {
+	var statement inTotoStatement
+	if err := json.Unmarshal(payload, &statement); err != nil {
+		return fmt.Errorf("payload is not an in-toto statement: %w", err)
+	}
+
+	if len(statement.Subject) == 0 {
+		return errors.New("in-toto statement has no subject")
+	}
+
+	subjects := lo.Filter(statement.Subject, func(s inTotoSubject, _ int) bool {
+		return subjectMatches(s.Name, name, version)
+	})
+	if len(subjects) == 0 {
+		return fmt.Errorf(
+			"in-toto statement subject %s doesn't match release bundle %s version %s",
+			strings.Join(lo.Map(statement.Subject, func(s inTotoSubject, _ int) string { return s.Name }), ", "),
+			name,
+			version,
+		)
+	}
+
+	digests := lo.FilterMap(subjects, func(s inTotoSubject, _ int) (string, bool) {
+		digest, ok := s.Digest["sha256"]
+		return strings.ToLower(digest), ok
+	})
+	if len(digests) == 0 {
+		return errors.New("in-toto statement subject has no sha256 digest to check the release bundle manifest against")
+	}
+	if !slices.Contains(digests, strings.ToLower(manifestSHA256)) {
+		return fmt.Errorf("in-toto statement subject digest %s doesn't match the release bundle manifest digest %s", strings.Join(digests, ", "), manifestSHA256)
+	}
+
+	return nil
+}
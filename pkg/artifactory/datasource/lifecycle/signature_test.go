@@ -0,0 +1,235 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+)
+
+const testPayloadType = "application/vnd.in-toto+json"
+
+func publicKeyPEM(t *testing.T, publicKey crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func signedEnvelope(t *testing.T, signer crypto.Signer, payload string) dsseEnvelope {
+	message := preAuthEncoding(testPayloadType, []byte(payload))
+
+	var sig []byte
+	var err error
+	if _, ok := signer.(ed25519.PrivateKey); ok {
+		sig, err = signer.Sign(rand.Reader, message, crypto.Hash(0))
+	} else {
+		digest := sha256.Sum256(message)
+		sig, err = signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return dsseEnvelope{
+		Payload:     base64.StdEncoding.EncodeToString([]byte(payload)),
+		PayloadType: testPayloadType,
+		Signatures: []dsseSignature{
+			{KeyID: "my-keypair", Sig: base64.StdEncoding.EncodeToString(sig)},
+		},
+	}
+}
+
+func TestVerifyEnvelope(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const payload = `{"subject":[{"name":"my-bundle/1.0/release-bundle.json"}]}`
+
+	tampered := signedEnvelope(t, rsaKey, payload)
+	tampered.Payload = base64.StdEncoding.EncodeToString([]byte(`{"subject":[{"name":"other-bundle/1.0/release-bundle.json"}]}`))
+
+	rsaPKCS1PEM := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)}))
+
+	testCases := []struct {
+		name          string
+		envelope      dsseEnvelope
+		publicKey     string
+		signer        crypto.PublicKey
+		expectedError string
+	}{
+		{
+			name:      "rsa",
+			envelope:  signedEnvelope(t, rsaKey, payload),
+			publicKey: publicKeyPEM(t, &rsaKey.PublicKey),
+			signer:    &rsaKey.PublicKey,
+		},
+		{
+			name:      "rsa pkcs1 public key",
+			envelope:  signedEnvelope(t, rsaKey, payload),
+			publicKey: rsaPKCS1PEM,
+			signer:    &rsaKey.PublicKey,
+		},
+		{
+			name:      "ecdsa",
+			envelope:  signedEnvelope(t, ecdsaKey, payload),
+			publicKey: publicKeyPEM(t, &ecdsaKey.PublicKey),
+			signer:    &ecdsaKey.PublicKey,
+		},
+		{
+			name:      "ed25519",
+			envelope:  signedEnvelope(t, ed25519Key, payload),
+			publicKey: publicKeyPEM(t, ed25519Key.Public()),
+			signer:    ed25519Key.Public(),
+		},
+		{
+			name:          "tampered payload",
+			envelope:      tampered,
+			publicKey:     publicKeyPEM(t, &rsaKey.PublicKey),
+			expectedError: "no signature matches",
+		},
+		{
+			name:          "other key",
+			envelope:      signedEnvelope(t, rsaKey, payload),
+			publicKey:     publicKeyPEM(t, &ecdsaKey.PublicKey),
+			expectedError: "no signature matches",
+		},
+		{
+			name:          "unsigned",
+			envelope:      dsseEnvelope{Payload: base64.StdEncoding.EncodeToString([]byte(payload)), PayloadType: testPayloadType},
+			publicKey:     publicKeyPEM(t, &rsaKey.PublicKey),
+			expectedError: "not signed",
+		},
+		{
+			name:          "not pem",
+			envelope:      signedEnvelope(t, rsaKey, payload),
+			publicKey:     "not a key",
+			expectedError: "not PEM encoded",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			statement, signer, err := verifyEnvelope(tc.envelope, tc.publicKey)
+
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				fingerprint, _ := publicKeyFingerprint(tc.signer)
+				if signer != fingerprint {
+					t.Errorf("expected signer %s, got %q", fingerprint, signer)
+				}
+				if string(statement) != payload {
+					t.Errorf("expected payload %s, got %s", payload, statement)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Fatalf("expected error %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestVerifyStatement(t *testing.T) {
+	const digest = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	testCases := []struct {
+		name           string
+		payload        string
+		manifestSHA256 string
+		expectedError  string
+	}{
+		{
+			name:           "matching subject",
+			payload:        `{"_type":"https://in-toto.io/Statement/v1","subject":[{"name":"release-bundles-v2/my-bundle/1.0/release-bundle.json","digest":{"sha256":"` + digest + `"}}]}`,
+			manifestSHA256: digest,
+		},
+		{
+			name:           "matching digest in upper case",
+			payload:        `{"subject":[{"name":"my-bundle/1.0/release-bundle.json","digest":{"sha256":"` + strings.ToUpper(digest) + `"}}]}`,
+			manifestSHA256: digest,
+		},
+		{
+			name:           "subject without digest",
+			payload:        `{"subject":[{"name":"my-bundle/1.0/release-bundle.json"}]}`,
+			manifestSHA256: digest,
+			expectedError:  "no sha256 digest",
+		},
+		{
+			name:           "other version",
+			payload:        `{"subject":[{"name":"my-bundle/2.0/release-bundle.json","digest":{"sha256":"` + digest + `"}}]}`,
+			manifestSHA256: digest,
+			expectedError:  "doesn't match release bundle my-bundle version 1.0",
+		},
+		{
+			name:           "other digest",
+			payload:        `{"subject":[{"name":"my-bundle/1.0/release-bundle.json","digest":{"sha256":"abc"}}]}`,
+			manifestSHA256: digest,
+			expectedError:  "doesn't match the release bundle manifest digest",
+		},
+		{
+			name:          "no subject",
+			payload:       `{"_type":"https://in-toto.io/Statement/v1"}`,
+			expectedError: "no subject",
+		},
+		{
+			name:          "not a statement",
+			payload:       `not json`,
+			expectedError: "not an in-toto statement",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := verifyStatement([]byte(tc.payload), "my-bundle", "1.0", tc.manifestSHA256)
+
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Fatalf("expected error %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
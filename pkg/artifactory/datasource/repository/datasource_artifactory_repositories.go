@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
 	"github.com/jfrog/terraform-provider-shared/util"
 )
 
@@ -148,7 +149,7 @@ func (d *RepositoriesDataSource) Configure(ctx context.Context, req datasource.C
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), "artifactory_repositories")
 }
 
 func (d *RepositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	datasource_repository "github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/datasource/repository"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
 	"github.com/jfrog/terraform-provider-shared/util"
@@ -85,7 +86,7 @@ func (d *LocalHexRepositoryDataSource) Configure(ctx context.Context, req dataso
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), "artifactory_local_hex_repository")
 }
 
 func (d *LocalHexRepositoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -25,6 +25,7 @@ import (
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/user"
 	"github.com/jfrog/terraform-provider-shared/validator"
 )
@@ -129,8 +130,8 @@ type User struct {
 func readUser(req *resty.Request, artifactoryVersion, name string, result *User, artifactoryError *artifactory.ArtifactoryErrorsResponse) (*resty.Response, error) {
 	endpoint := user.GetUserEndpointPath(artifactoryVersion)
 
-	// 7.83.1 or later, use Access API
-	if ok, err := util.CheckVersion(artifactoryVersion, user.AccessAPIArtifactoryVersion); err == nil && ok {
+	// 7.84.3 or later, use Access API
+	if capability.AccessUsersAPI.SupportedBy(artifactoryVersion) {
 		return req.
 			SetPathParam("name", name).
 			SetResult(&result).
@@ -0,0 +1,239 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httplog writes a structured JSON Lines audit log of the API calls made by the provider,
+// with credentials and other sensitive values redacted.
+package httplog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// maxBodySize is the largest request or response body included in the log.
+const maxBodySize = 64 * 1024
+
+// Entry is a single line of the log.
+type Entry struct {
+	Time            time.Time         `json:"time"`
+	ResourceType    string            `json:"resource_type,omitempty"`
+	Method          string            `json:"method"`
+	Host            string            `json:"host"`
+	Path            string            `json:"path"`
+	Query           string            `json:"query,omitempty"`
+	Status          int               `json:"status,omitempty"`
+	DurationMillis  int64             `json:"duration_ms"`
+	RequestID       string            `json:"request_id,omitempty"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	RequestBody     any               `json:"request_body,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	ResponseBody    any               `json:"response_body,omitempty"`
+	Error           string            `json:"error,omitempty"`
+}
+
+// Logger appends entries to a log file. It is safe for concurrent use.
+type Logger struct {
+	mu       sync.Mutex
+	file     io.Writer
+	redactor *Redactor
+}
+
+var (
+	loggersMu sync.Mutex
+	loggers   = map[string]*Logger{}
+)
+
+// Open returns the logger writing to the file at path, creating the file if needed. The SDKv2 and
+// framework providers share the same logger for a given path so their entries don't interleave.
+func Open(path string, redactor *Redactor) (*Logger, error) {
+	loggersMu.Lock()
+	defer loggersMu.Unlock()
+
+	if logger, ok := loggers[path]; ok {
+		return logger, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open HTTP log file: %w", err)
+	}
+
+	logger := &Logger{
+		file:     file,
+		redactor: redactor,
+	}
+	loggers[path] = logger
+
+	return logger, nil
+}
+
+func (l *Logger) write(entry Entry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, _ = l.file.Write(append(line, '\n'))
+}
+
+type resourceTypeKey struct{}
+
+var (
+	clientsMu sync.Mutex
+	// logged holds the clients with logging enabled and the clones created for each resource type.
+	logged        = map[*resty.Client]map[string]*resty.Client{}
+	resourceTypes = map[*resty.Client]string{}
+)
+
+// Attach logs every request sent by the Resty client, including retries. It must be called after
+// all other transport configuration as it wraps the client transport.
+func (l *Logger) Attach(client *resty.Client) {
+	base := client.GetClient().Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	client.SetTransport(&transport{
+		base:   base,
+		logger: l,
+	})
+
+	client.OnBeforeRequest(func(c *resty.Client, r *resty.Request) error {
+		clientsMu.Lock()
+		resourceType, ok := resourceTypes[c]
+		clientsMu.Unlock()
+
+		if ok {
+			r.SetContext(context.WithValue(r.Context(), resourceTypeKey{}, resourceType))
+		}
+
+		return nil
+	})
+
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	logged[client] = map[string]*resty.Client{}
+}
+
+// ForResource returns the provider data to use for a resource or data source, so requests can be
+// attributed to its type in the log. It returns the provider data unchanged if logging is disabled.
+// The returned client is a clone when logging is enabled, so per provider state must not be keyed
+// by the client, it wouldn't be found by the resources.
+func ForResource(meta util.ProviderMetadata, resourceType string) util.ProviderMetadata {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	clones, ok := logged[meta.Client]
+	if !ok {
+		return meta
+	}
+
+	clone, ok := clones[resourceType]
+	if !ok {
+		clone = meta.Client.Clone()
+		clones[resourceType] = clone
+		resourceTypes[clone] = resourceType
+	}
+
+	meta.Client = clone
+	return meta
+}
+
+type transport struct {
+	base   http.RoundTripper
+	logger *Logger
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	redactor := t.logger.redactor
+
+	entry := Entry{
+		Time:           time.Now().UTC(),
+		Method:         req.Method,
+		Host:           req.URL.Host,
+		Path:           req.URL.Path,
+		Query:          redactor.Query(req.URL.Query()),
+		RequestHeaders: redactor.Headers(req.Header),
+	}
+	if resourceType, ok := req.Context().Value(resourceTypeKey{}).(string); ok {
+		entry.ResourceType = resourceType
+	}
+	if req.GetBody != nil && req.ContentLength != 0 {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(io.LimitReader(body, maxBodySize+1))
+			body.Close()
+			entry.RequestBody = redactor.Body(req.Header.Get("Content-Type"), data)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	entry.DurationMillis = time.Since(start).Milliseconds()
+
+	if err != nil {
+		entry.Error = err.Error()
+		t.logger.write(entry)
+		return resp, err
+	}
+
+	entry.Status = resp.StatusCode
+	entry.ResponseHeaders = redactor.Headers(resp.Header)
+	entry.RequestID = resp.Header.Get("X-Request-Id")
+	if entry.RequestID == "" {
+		entry.RequestID = req.Header.Get("X-Request-Id")
+	}
+
+	if resp.Body != nil && isLoggedContentType(resp.Header.Get("Content-Type")) {
+		// Only read as much as is logged, the rest is streamed to the caller as is.
+		data, readErr := io.ReadAll(io.LimitReader(resp.Body, maxBodySize+1))
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(data), resp.Body), resp.Body}
+
+		if readErr == nil {
+			entry.ResponseBody = redactor.Body(resp.Header.Get("Content-Type"), data)
+		}
+	}
+
+	t.logger.write(entry)
+
+	return resp, nil
+}
+
+func isLoggedContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" ||
+		strings.HasSuffix(mediaType, "+json") ||
+		mediaType == "application/x-www-form-urlencoded"
+}
@@ -0,0 +1,176 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httplog
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const redacted = "<REDACTED>"
+
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"X-Jfrog-Art-Api":     true,
+	"X-Jfrog-Event-Auth":  true,
+}
+
+// sensitiveKeyParts are matched against normalized field names, e.g. both `client_secret` and
+// `clientSecret` contain `secret`.
+var sensitiveKeyParts = []string{
+	"password",
+	"passwd",
+	"passphrase",
+	"secret",
+	"token",
+	"privatekey",
+	"apikey",
+	"credential",
+}
+
+var jwtRegex = regexp.MustCompile(`^eyJ[\w-]*\.[\w-]+\.[\w-]*$`)
+
+// Redactor removes credentials and other sensitive values from logged requests and responses.
+type Redactor struct {
+	fields map[string]bool
+}
+
+// NewRedactor returns a redactor that, in addition to the well known credential fields, redacts the
+// given fields, e.g. the attributes marked as sensitive in the provider schemas.
+func NewRedactor(sensitiveFields []string) *Redactor {
+	fields := map[string]bool{}
+	for _, field := range sensitiveFields {
+		fields[normalize(field)] = true
+	}
+
+	return &Redactor{fields: fields}
+}
+
+func normalize(name string) string {
+	return strings.NewReplacer("_", "", "-", "", ".", "").Replace(strings.ToLower(name))
+}
+
+func (r *Redactor) isSensitive(name string) bool {
+	normalized := normalize(name)
+	if r.fields[normalized] {
+		return true
+	}
+
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(normalized, part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSensitiveValue catches PEM data and JWT access tokens regardless of the field they are in.
+func isSensitiveValue(value string) bool {
+	value = strings.TrimSpace(value)
+	return strings.HasPrefix(value, "-----BEGIN") || jwtRegex.MatchString(value)
+}
+
+// Headers returns the headers flattened to a single value each, with credentials redacted.
+func (r *Redactor) Headers(headers http.Header) map[string]string {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(headers))
+	for name, values := range headers {
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] || r.isSensitive(name) {
+			result[name] = redacted
+			continue
+		}
+		result[name] = strings.Join(values, ", ")
+	}
+
+	return result
+}
+
+// Query returns the encoded query string with sensitive parameters redacted.
+func (r *Redactor) Query(values url.Values) string {
+	for name, vals := range values {
+		for i, value := range vals {
+			if r.isSensitive(name) || isSensitiveValue(value) {
+				vals[i] = redacted
+			}
+		}
+	}
+
+	return values.Encode()
+}
+
+// Body returns the redacted body for JSON and form encoded content. Other content is not logged.
+func (r *Redactor) Body(contentType string, data []byte) any {
+	if len(data) == 0 {
+		return nil
+	}
+	if len(data) > maxBodySize {
+		return "<body too large, not logged>"
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(data))
+		if err != nil {
+			return "<invalid form body, not logged>"
+		}
+		return r.Query(values)
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		var body any
+		if err := json.Unmarshal(data, &body); err != nil {
+			return "<invalid JSON body, not logged>"
+		}
+		return r.value(body)
+	default:
+		return nil
+	}
+}
+
+func (r *Redactor) value(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if r.isSensitive(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = r.value(item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = r.value(item)
+		}
+		return v
+	case string:
+		if isSensitiveValue(v) {
+			return redacted
+		}
+		return v
+	default:
+		return v
+	}
+}
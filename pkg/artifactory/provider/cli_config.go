@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const (
+	cliConfigFileName       = "jfrog-cli.conf.v6"
+	cliTokenRefreshEndpoint = "access/api/v1/tokens"
+)
+
+// cliServerDetails holds the subset of a JFrog CLI server configuration used by the provider.
+type cliServerDetails struct {
+	ServerId          string `json:"serverId"`
+	Url               string `json:"url"`
+	ArtifactoryUrl    string `json:"artifactoryUrl"`
+	AccessToken       string `json:"accessToken"`
+	RefreshToken      string `json:"refreshToken"`
+	ClientCertPath    string `json:"clientCertPath"`
+	ClientCertKeyPath string `json:"clientCertKeyPath"`
+}
+
+type cliConfig struct {
+	Servers []cliServerDetails `json:"servers"`
+	Enc     bool               `json:"enc"`
+}
+
+// URL returns the platform URL of the server, falling back to the Artifactory URL without its
+// artifactory context path for configurations created by older versions of the CLI.
+func (s cliServerDetails) URL() string {
+	if s.Url != "" || s.ArtifactoryUrl == "" {
+		return s.Url
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(s.ArtifactoryUrl, "/"), "/artifactory") + "/"
+}
+
+// cliConfigFilePath returns the JFrog CLI config file location, honoring JFROG_CLI_HOME_DIR
+// the same way the CLI does.
+func cliConfigFilePath() (string, error) {
+	dir := os.Getenv("JFROG_CLI_HOME_DIR")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to locate JFrog CLI home directory: %w", err)
+		}
+		dir = filepath.Join(home, ".jfrog")
+	}
+
+	return filepath.Join(dir, cliConfigFileName), nil
+}
+
+func loadCLIServer(configPath, serverId string) (*cliServerDetails, error) {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JFrog CLI config: %w", err)
+	}
+
+	var config cliConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse JFrog CLI config %s: %w", configPath, err)
+	}
+
+	if config.Enc {
+		return nil, fmt.Errorf("JFrog CLI config %s is encrypted, which is not supported", configPath)
+	}
+
+	for _, server := range config.Servers {
+		if server.ServerId == serverId {
+			return &server, nil
+		}
+	}
+
+	return nil, fmt.Errorf("server '%s' not found in JFrog CLI config %s", serverId, configPath)
+}
+
+type cliTokenRefreshResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+var (
+	cliRefreshedTokensMu sync.Mutex
+	cliRefreshedTokens   = map[string]cliTokenRefreshResponse{}
+)
+
+// refreshCLIAccessToken exchanges the server refresh token for a new access token and saves both
+// tokens back to the CLI config, as the previous refresh token is no longer valid afterwards.
+// Refreshed tokens are cached so the SDKv2 and framework providers don't both refresh.
+func refreshCLIAccessToken(restyClient *resty.Client, configPath string, server *cliServerDetails) (string, error) {
+	if server.RefreshToken == "" {
+		return "", fmt.Errorf("access token of server '%s' has expired and no refresh token is configured, run 'jf config edit %s' to update it", server.ServerId, server.ServerId)
+	}
+
+	key := configPath + "|" + server.ServerId
+
+	cliRefreshedTokensMu.Lock()
+	defer cliRefreshedTokensMu.Unlock()
+
+	// A cached token different from the server one was refreshed by the other half of the provider.
+	if tokens, ok := cliRefreshedTokens[key]; ok && tokens.AccessToken != server.AccessToken && !tokenExpired(tokens.AccessToken, time.Now()) {
+		return tokens.AccessToken, nil
+	}
+
+	var result cliTokenRefreshResponse
+	var jfrogErrors util.JFrogErrors
+
+	resp, err := restyClient.R().
+		SetFormData(map[string]string{
+			"grant_type":    "refresh_token",
+			"refresh_token": server.RefreshToken,
+			"access_token":  server.AccessToken,
+		}).
+		SetResult(&result).
+		SetError(&jfrogErrors).
+		Post(cliTokenRefreshEndpoint)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return "", fmt.Errorf("failed to refresh access token of server '%s': %s", server.ServerId, jfrogErrors.String())
+	}
+
+	if err := saveCLITokens(configPath, server.ServerId, result); err != nil {
+		return "", err
+	}
+
+	cliRefreshedTokens[key] = result
+	server.AccessToken = result.AccessToken
+	server.RefreshToken = result.RefreshToken
+
+	return result.AccessToken, nil
+}
+
+// saveCLITokens updates the tokens of a server in the CLI config, leaving all other settings untouched.
+func saveCLITokens(configPath, serverId string, tokens cliTokenRefreshResponse) error {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to read JFrog CLI config: %w", err)
+	}
+
+	var config map[string]interface{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("failed to parse JFrog CLI config %s: %w", configPath, err)
+	}
+
+	servers, _ := config["servers"].([]interface{})
+	for _, s := range servers {
+		server, ok := s.(map[string]interface{})
+		if !ok || server["serverId"] != serverId {
+			continue
+		}
+
+		server["accessToken"] = tokens.AccessToken
+		if tokens.RefreshToken != "" {
+			server["refreshToken"] = tokens.RefreshToken
+		}
+	}
+
+	updated, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(configPath)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so a failure never leaves a truncated config behind.
+	tmp := configPath + ".tmp"
+	if err := os.WriteFile(tmp, updated, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to save JFrog CLI config: %w", err)
+	}
+	if err := os.Rename(tmp, configPath); err != nil {
+		return fmt.Errorf("failed to save JFrog CLI config: %w", err)
+	}
+
+	return nil
+}
+
+// cliServerAccessToken returns the access token of the server, refreshing it first if it has expired.
+func cliServerAccessToken(restyClient *resty.Client, configPath string, server *cliServerDetails) (string, error) {
+	if !tokenExpired(server.AccessToken, time.Now()) {
+		return server.AccessToken, nil
+	}
+
+	return refreshCLIAccessToken(restyClient, configPath, server)
+}
+
+// loadCLIServerFromHome loads the server from the JFrog CLI config in the CLI home directory.
+func loadCLIServerFromHome(serverId string) (string, *cliServerDetails, error) {
+	configPath, err := cliConfigFilePath()
+	if err != nil {
+		return "", nil, err
+	}
+
+	server, err := loadCLIServer(configPath, serverId)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return configPath, server, nil
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func testJWT(exp time.Time) string {
+	payload, _ := json.Marshal(map[string]int64{"exp": exp.Unix()})
+	return fmt.Sprintf("eyJhbGciOiJSUzI1NiJ9.%s.signature", base64.RawURLEncoding.EncodeToString(payload))
+}
+
+func writeTestCLIConfig(t *testing.T, servers ...map[string]interface{}) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("JFROG_CLI_HOME_DIR", dir)
+
+	data, err := json.Marshal(map[string]interface{}{
+		"servers": servers,
+		"version": "6",
+	})
+	if err != nil {
+		t.Fatalf("failed marshalling config: %s", err)
+	}
+
+	configPath := filepath.Join(dir, cliConfigFileName)
+	if err := os.WriteFile(configPath, data, 0o600); err != nil {
+		t.Fatalf("failed writing config file: %s", err)
+	}
+
+	return configPath
+}
+
+func TestLoadCLIServerFromHome(t *testing.T) {
+	configPath := writeTestCLIConfig(t,
+		map[string]interface{}{
+			"serverId":    "other",
+			"url":         "https://other.jfrog.io/",
+			"accessToken": "other-token",
+		},
+		map[string]interface{}{
+			"serverId":          "dev",
+			"url":               "https://dev.jfrog.io/",
+			"artifactoryUrl":    "https://dev.jfrog.io/artifactory/",
+			"accessToken":       "dev-token",
+			"clientCertPath":    "/certs/client.pem",
+			"clientCertKeyPath": "/certs/client-key.pem",
+		},
+	)
+
+	path, server, err := loadCLIServerFromHome("dev")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if path != configPath {
+		t.Fatalf("expected config path %s, got %s", configPath, path)
+	}
+	if server.URL() != "https://dev.jfrog.io/" {
+		t.Fatalf("unexpected url: %s", server.URL())
+	}
+	if server.AccessToken != "dev-token" {
+		t.Fatalf("unexpected access token: %s", server.AccessToken)
+	}
+	if server.ClientCertPath != "/certs/client.pem" || server.ClientCertKeyPath != "/certs/client-key.pem" {
+		t.Fatalf("unexpected client certificate paths: %s, %s", server.ClientCertPath, server.ClientCertKeyPath)
+	}
+}
+
+func TestLoadCLIServerFallsBackToArtifactoryURL(t *testing.T) {
+	writeTestCLIConfig(t, map[string]interface{}{
+		"serverId":       "legacy",
+		"artifactoryUrl": "https://legacy.jfrog.io/artifactory/",
+	})
+
+	_, server, err := loadCLIServerFromHome("legacy")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if server.URL() != "https://legacy.jfrog.io/" {
+		t.Fatalf("unexpected url: %s", server.URL())
+	}
+}
+
+func TestCLIServerURL(t *testing.T) {
+	for _, tc := range []struct {
+		server   cliServerDetails
+		expected string
+	}{
+		{cliServerDetails{Url: "https://dev.jfrog.io/", ArtifactoryUrl: "https://dev.jfrog.io/artifactory/"}, "https://dev.jfrog.io/"},
+		{cliServerDetails{ArtifactoryUrl: "https://legacy.jfrog.io/artifactory/"}, "https://legacy.jfrog.io/"},
+		{cliServerDetails{ArtifactoryUrl: "https://legacy.jfrog.io/artifactory"}, "https://legacy.jfrog.io/"},
+		{cliServerDetails{ArtifactoryUrl: "http://localhost:8081/"}, "http://localhost:8081/"},
+		{cliServerDetails{}, ""},
+	} {
+		if actual := tc.server.URL(); actual != tc.expected {
+			t.Errorf("expected url %s for %+v, got %s", tc.expected, tc.server, actual)
+		}
+	}
+}
+
+func TestLoadCLIServerNotFound(t *testing.T) {
+	writeTestCLIConfig(t, map[string]interface{}{"serverId": "dev"})
+
+	_, _, err := loadCLIServerFromHome("prod")
+	if err == nil || !strings.Contains(err.Error(), "server 'prod' not found") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestLoadCLIServerEncryptedConfig(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("JFROG_CLI_HOME_DIR", dir)
+
+	if err := os.WriteFile(filepath.Join(dir, cliConfigFileName), []byte(`{"servers":[],"version":"6","enc":true}`), 0o600); err != nil {
+		t.Fatalf("failed writing config file: %s", err)
+	}
+
+	_, _, err := loadCLIServerFromHome("dev")
+	if err == nil || !strings.Contains(err.Error(), "encrypted") {
+		t.Fatalf("expected encrypted config error, got %v", err)
+	}
+}
+
+func TestCLIServerAccessTokenRefresh(t *testing.T) {
+	expiredToken := testJWT(time.Now().Add(-time.Hour))
+	newToken := testJWT(time.Now().Add(time.Hour))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+cliTokenRefreshEndpoint {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != "refresh_token" || r.Form.Get("refresh_token") != "old-refresh" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":%q,"refresh_token":"new-refresh"}`, newToken)
+	}))
+	defer server.Close()
+
+	configPath := writeTestCLIConfig(t, map[string]interface{}{
+		"serverId":     "dev",
+		"url":          server.URL,
+		"accessToken":  expiredToken,
+		"refreshToken": "old-refresh",
+		"webLogin":     true,
+	})
+
+	_, cliServer, err := loadCLIServerFromHome("dev")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	token, err := cliServerAccessToken(resty.New().SetBaseURL(server.URL), configPath, cliServer)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token != newToken {
+		t.Fatalf("expected refreshed token, got %s", token)
+	}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("failed reading config file: %s", err)
+	}
+
+	var saved struct {
+		Servers []map[string]interface{} `json:"servers"`
+	}
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatalf("failed parsing config file: %s", err)
+	}
+	if saved.Servers[0]["accessToken"] != newToken || saved.Servers[0]["refreshToken"] != "new-refresh" {
+		t.Fatalf("expected refreshed tokens to be saved, got %v", saved.Servers[0])
+	}
+	if saved.Servers[0]["webLogin"] != true {
+		t.Fatalf("expected other server settings to be preserved, got %v", saved.Servers[0])
+	}
+}
+
+func TestCLIServerAccessTokenExpiredWithoutRefreshToken(t *testing.T) {
+	cliServer := &cliServerDetails{
+		ServerId:    "dev",
+		AccessToken: testJWT(time.Now().Add(-time.Hour)),
+	}
+
+	_, err := cliServerAccessToken(resty.New(), "unused", cliServer)
+	if err == nil || !strings.Contains(err.Error(), "no refresh token") {
+		t.Fatalf("expected missing refresh token error, got %v", err)
+	}
+}
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	defaultMaxRetries            = 20
+	defaultRetryMinBackoffMillis = 100
+	defaultRetryMaxBackoffMillis = 2000
+
+	// maxRetryAfterWait caps how long a Retry-After header may delay a retry
+	maxRetryAfterWait = 5 * time.Minute
+)
+
+type clientOptions struct {
+	MaxRetries            int64
+	RetryMinBackoffMillis int64
+	RetryMaxBackoffMillis int64
+	RespectRetryAfter     bool
+	RequestTimeoutSeconds int64
+	RequestsPerSecond     int64
+	MaxInFlightRequests   int64
+}
+
+func defaultClientOptions() clientOptions {
+	return clientOptions{
+		MaxRetries:            defaultMaxRetries,
+		RetryMinBackoffMillis: defaultRetryMinBackoffMillis,
+		RetryMaxBackoffMillis: defaultRetryMaxBackoffMillis,
+		RespectRetryAfter:     true,
+	}
+}
+
+// configureClient applies the retry, timeout and rate limit settings to the Resty client. It must be
+// called after TLS and proxy configuration as the rate limiter wraps the client transport.
+func configureClient(restyClient *resty.Client, url string, opts clientOptions) error {
+	if opts.RetryMinBackoffMillis > opts.RetryMaxBackoffMillis {
+		return fmt.Errorf("retry_min_backoff_millis (%d) must not be greater than retry_max_backoff_millis (%d)", opts.RetryMinBackoffMillis, opts.RetryMaxBackoffMillis)
+	}
+
+	minBackoff := time.Duration(opts.RetryMinBackoffMillis) * time.Millisecond
+	maxBackoff := time.Duration(opts.RetryMaxBackoffMillis) * time.Millisecond
+
+	restyClient.
+		SetRetryCount(int(opts.MaxRetries)).
+		SetRetryWaitTime(minBackoff).
+		SetRetryMaxWaitTime(maxBackoff).
+		SetTimeout(time.Duration(opts.RequestTimeoutSeconds) * time.Second).
+		AddRetryCondition(retryOnThrottling)
+
+	if opts.RespectRetryAfter {
+		// Resty clamps the Retry-After wait to the max wait time, so that is raised to the Retry-After
+		// cap and the backoff is capped by retryAfterOrBackoff instead.
+		restyClient.
+			SetRetryMaxWaitTime(max(maxBackoff, maxRetryAfterWait)).
+			SetRetryAfter(retryAfterOrBackoff(minBackoff, maxBackoff))
+	}
+
+	if opts.RequestsPerSecond > 0 || opts.MaxInFlightRequests > 0 {
+		base := restyClient.GetClient().Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+
+		restyClient.SetTransport(&limitedTransport{
+			base:    base,
+			limiter: sharedRequestLimiter(url, opts.RequestsPerSecond, opts.MaxInFlightRequests),
+		})
+	}
+
+	return nil
+}
+
+// retryOnThrottling retries requests rejected because the server is overloaded, in addition
+// to the transport errors Resty retries by default.
+func retryOnThrottling(response *resty.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	return response != nil &&
+		(response.StatusCode() == http.StatusTooManyRequests || response.StatusCode() == http.StatusServiceUnavailable)
+}
+
+// retryAfter returns the wait time from the Retry-After header, either in seconds or as an HTTP date.
+// Returning 0 makes Resty fall back to its exponential backoff.
+func retryAfter(_ *resty.Client, response *resty.Response) (time.Duration, error) {
+	if response == nil {
+		return 0, nil
+	}
+
+	value := response.Header().Get("Retry-After")
+	if value == "" {
+		return 0, nil
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), nil
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), nil
+	}
+
+	return 0, nil
+}
+
+// retryAfterOrBackoff returns the wait time from the Retry-After header, up to maxRetryAfterWait, or
+// the capped exponential backoff with jitter when the response has none.
+func retryAfterOrBackoff(minBackoff, maxBackoff time.Duration) resty.RetryAfterFunc {
+	return func(client *resty.Client, response *resty.Response) (time.Duration, error) {
+		wait, err := retryAfter(client, response)
+		if err != nil {
+			return 0, err
+		}
+		if wait > 0 {
+			return min(wait, max(maxBackoff, maxRetryAfterWait)), nil
+		}
+
+		attempt := 0
+		if response != nil && response.Request != nil {
+			attempt = max(response.Request.Attempt-1, 0)
+		}
+
+		return jitterBackoff(minBackoff, maxBackoff, attempt), nil
+	}
+}
+
+// jitterBackoff returns the exponential backoff for the attempt, capped at maxBackoff, with
+// jitter over its upper half.
+func jitterBackoff(minBackoff, maxBackoff time.Duration, attempt int) time.Duration {
+	backoff := minBackoff
+	for i := 0; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, maxBackoff)
+
+	half := backoff / 2
+	if half <= 0 {
+		return backoff
+	}
+
+	return half + rand.N(half)
+}
+
+type requestLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+	slots    chan struct{}
+}
+
+func newRequestLimiter(requestsPerSecond, maxInFlight int64) *requestLimiter {
+	limiter := &requestLimiter{}
+	if requestsPerSecond > 0 {
+		limiter.interval = time.Second / time.Duration(requestsPerSecond)
+	}
+	if maxInFlight > 0 {
+		limiter.slots = make(chan struct{}, maxInFlight)
+	}
+
+	return limiter
+}
+
+// acquire blocks until the request is allowed to be sent. The returned func must be called once the
+// response body has been closed.
+func (l *requestLimiter) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			release = func() { <-l.slots }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if l.interval > 0 {
+		l.mu.Lock()
+		now := time.Now()
+		if l.next.Before(now) {
+			l.next = now
+		}
+		delay := l.next.Sub(now)
+		l.next = l.next.Add(l.interval)
+		l.mu.Unlock()
+
+		if delay > 0 {
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				release()
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	return release, nil
+}
+
+var (
+	requestLimitersMu sync.Mutex
+	requestLimiters   = map[string]*requestLimiter{}
+)
+
+// sharedRequestLimiter returns the limiter for the given URL and settings. The SDKv2 and framework
+// providers each build their own client, so the limiter is shared for the limits to apply to the
+// provider as a whole.
+func sharedRequestLimiter(url string, requestsPerSecond, maxInFlight int64) *requestLimiter {
+	key := fmt.Sprintf("%s|%d|%d", url, requestsPerSecond, maxInFlight)
+
+	requestLimitersMu.Lock()
+	defer requestLimitersMu.Unlock()
+
+	if limiter, ok := requestLimiters[key]; ok {
+		return limiter
+	}
+
+	limiter := newRequestLimiter(requestsPerSecond, maxInFlight)
+	requestLimiters[key] = limiter
+
+	return limiter
+}
+
+type limitedTransport struct {
+	base    http.RoundTripper
+	limiter *requestLimiter
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := t.base.RoundTrip(req)
+	if err != nil || response.Body == nil {
+		release()
+		return response, err
+	}
+
+	// the request is in flight until its body has been read
+	response.Body = &releasingBody{ReadCloser: response.Body, release: release}
+
+	return response, nil
+}
+
+// releasingBody releases the limiter slot of the request when the response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+
+	return err
+}
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestConfigureClientRetriesOnTooManyRequests(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	opts := defaultClientOptions()
+	opts.RetryMinBackoffMillis = 1
+	opts.RetryMaxBackoffMillis = 10
+
+	restyClient := resty.New().SetBaseURL(server.URL)
+	if err := configureClient(restyClient, server.URL, opts); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := restyClient.R().Get("/")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode())
+	}
+	if attempts.Load() != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts.Load())
+	}
+}
+
+func TestConfigureClientMaxRetries(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	opts := defaultClientOptions()
+	opts.MaxRetries = 2
+	opts.RetryMinBackoffMillis = 1
+	opts.RetryMaxBackoffMillis = 1
+
+	restyClient := resty.New().SetBaseURL(server.URL)
+	if err := configureClient(restyClient, server.URL, opts); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := restyClient.R().Get("/")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode() != http.StatusServiceUnavailable {
+		t.Fatalf("expected status 503, got %d", resp.StatusCode())
+	}
+	if attempts.Load() != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts.Load())
+	}
+}
+
+func TestConfigureClientInvalidBackoff(t *testing.T) {
+	opts := defaultClientOptions()
+	opts.RetryMinBackoffMillis = 5000
+
+	err := configureClient(resty.New(), "http://localhost", opts)
+	if err == nil || !strings.Contains(err.Error(), "retry_min_backoff_millis") {
+		t.Fatalf("expected backoff error, got %v", err)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	for value, expected := range map[string]time.Duration{
+		"":        0,
+		"5":       5 * time.Second,
+		"-1":      0,
+		"invalid": 0,
+	} {
+		resp := &resty.Response{RawResponse: &http.Response{Header: http.Header{}}}
+		if value != "" {
+			resp.RawResponse.Header.Set("Retry-After", value)
+		}
+
+		actual, err := retryAfter(nil, resp)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if actual != expected {
+			t.Fatalf("expected %s for Retry-After '%s', got %s", expected, value, actual)
+		}
+	}
+
+	resp := &resty.Response{RawResponse: &http.Response{Header: http.Header{}}}
+	resp.RawResponse.Header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+
+	actual, err := retryAfter(nil, resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if actual <= 0 || actual > time.Minute {
+		t.Fatalf("expected wait time up to 1m for HTTP date, got %s", actual)
+	}
+}
+
+// A Retry-After longer than the max backoff is waited for in full.
+func TestConfigureClientRetryAfterLongerThanMaxBackoff(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	opts := defaultClientOptions()
+	opts.RetryMinBackoffMillis = 1
+	opts.RetryMaxBackoffMillis = 10
+
+	restyClient := resty.New().SetBaseURL(server.URL)
+	if err := configureClient(restyClient, server.URL, opts); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	start := time.Now()
+	resp, err := restyClient.R().Get("/")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode())
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("expected to wait for the Retry-After of 1s, waited %s", elapsed)
+	}
+}
+
+func TestRetryAfterOrBackoff(t *testing.T) {
+	retryAfterFunc := retryAfterOrBackoff(100*time.Millisecond, 2*time.Second)
+
+	resp := &resty.Response{Request: &resty.Request{Attempt: 10}, RawResponse: &http.Response{Header: http.Header{}}}
+	resp.RawResponse.Header.Set("Retry-After", "60")
+
+	actual, err := retryAfterFunc(nil, resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if actual != time.Minute {
+		t.Fatalf("expected 1m for Retry-After '60', got %s", actual)
+	}
+
+	resp.RawResponse.Header.Set("Retry-After", "3600")
+
+	actual, err = retryAfterFunc(nil, resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if actual != maxRetryAfterWait {
+		t.Fatalf("expected %s for Retry-After '3600', got %s", maxRetryAfterWait, actual)
+	}
+
+	resp.RawResponse.Header.Del("Retry-After")
+
+	actual, err = retryAfterFunc(nil, resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if actual < time.Second || actual > 2*time.Second {
+		t.Fatalf("expected backoff between 1s and 2s without Retry-After, got %s", actual)
+	}
+}
+
+func TestRequestLimiterMaxInFlight(t *testing.T) {
+	limiter := newRequestLimiter(0, 1)
+
+	release, err := limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.acquire(ctx); err == nil {
+		t.Fatal("expected second request to block while the first is in flight")
+	}
+
+	release()
+
+	if _, err := limiter.acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error after release: %s", err)
+	}
+}
+
+func TestRequestLimiterRequestsPerSecond(t *testing.T) {
+	limiter := newRequestLimiter(20, 0)
+
+	start := time.Now()
+	for range 3 {
+		release, err := limiter.acquire(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		release()
+	}
+
+	// First request is sent immediately, the next two are spaced 50ms apart.
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Fatalf("expected requests to be spaced out, took %s", elapsed)
+	}
+}
+
+func TestLimitedTransportReleasesOnBodyClose(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	limiter := newRequestLimiter(0, 1)
+	transport := &limitedTransport{base: http.DefaultTransport, limiter: limiter}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.acquire(ctx); err == nil {
+		t.Fatal("expected the slot to be held until the response body is closed")
+	}
+
+	response.Body.Close()
+	// closing twice must not release another request's slot
+	response.Body.Close()
+
+	release, err := limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error after closing the body: %s", err)
+	}
+	defer release()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.acquire(ctx); err == nil {
+		t.Fatal("expected a single slot to be released")
+	}
+}
+
+func TestSharedRequestLimiter(t *testing.T) {
+	first := sharedRequestLimiter("https://example.jfrog.io", 10, 5)
+	second := sharedRequestLimiter("https://example.jfrog.io", 10, 5)
+	other := sharedRequestLimiter("https://other.jfrog.io", 10, 5)
+
+	if first != second {
+		t.Fatal("expected the same limiter for the same URL and settings")
+	}
+	if first == other {
+		t.Fatal("expected a different limiter for a different URL")
+	}
+}
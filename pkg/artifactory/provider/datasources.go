@@ -30,7 +30,6 @@ import (
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/remote"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/virtual"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
 func datasourcesMap() map[string]*schema.Resource {
@@ -140,5 +139,5 @@ func datasourcesMap() map[string]*schema.Resource {
 		dataSourcesMap[federatedDataSourceName] = datasource_federated.DataSourceArtifactoryFederatedGenericRepository(packageType)
 	}
 
-	return utilsdk.AddTelemetry(productId, dataSourcesMap)
+	return addUsageReporting(productId, dataSourcesMap)
 }
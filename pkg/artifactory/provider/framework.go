@@ -20,23 +20,33 @@ import (
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	datasource_artifact "github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/datasource/artifact"
+	datasource_build "github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/datasource/build"
+	datasource_configuration "github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/datasource/configuration"
+	datasource_lifecycle "github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/datasource/lifecycle"
 	datasource_repository "github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/datasource/repository"
 	datasource_local "github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/datasource/repository/local"
 	datasource_remote "github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/datasource/repository/remote"
 	datasource_virtual "github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/datasource/repository/virtual"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/artifact"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/build"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/configuration"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/lifecycle"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/replication"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/federated"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/remote"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/virtual"
@@ -52,7 +62,10 @@ import (
 // Ensure the implementation satisfies the provider.Provider interface.
 var _ provider.Provider = &ArtifactoryProvider{}
 
-type ArtifactoryProvider struct{}
+type ArtifactoryProvider struct {
+	// repositoryDefaults are the configured `repository_defaults`, handed to the repository resources
+	repositoryDefaults []repository.RepositoryDefaults
+}
 
 // ArtifactoryProviderModel describes the provider data model.
 type ArtifactoryProviderModel struct {
@@ -61,10 +74,27 @@ type ArtifactoryProviderModel struct {
 	ApiKey                   types.String `tfsdk:"api_key"`
 	OIDCProviderName         types.String `tfsdk:"oidc_provider_name"`
 	TFCCredentialTagName     types.String `tfsdk:"tfc_credential_tag_name"`
+	ServerId                 types.String `tfsdk:"server_id"`
 	ClientCertificatePath    types.String `tfsdk:"client_certificate_path"`
 	ClientCertificateKeyPath types.String `tfsdk:"client_certificate_key_path"`
 	ClientCertificatePEM     types.String `tfsdk:"client_certificate_pem"`
 	ClientPrivateKeyPEM      types.String `tfsdk:"client_private_key_pem"`
+	CACertificatePath        types.String `tfsdk:"ca_certificate_path"`
+	CACertificatePEM         types.String `tfsdk:"ca_certificate_pem"`
+	MinTLSVersion            types.String `tfsdk:"min_tls_version"`
+	TLSCipherSuites          types.List   `tfsdk:"tls_cipher_suites"`
+	ProxyURL                 types.String `tfsdk:"proxy_url"`
+	NoProxy                  types.String `tfsdk:"no_proxy"`
+	MaxRetries               types.Int64  `tfsdk:"max_retries"`
+	RetryMinBackoffMillis    types.Int64  `tfsdk:"retry_min_backoff_millis"`
+	RetryMaxBackoffMillis    types.Int64  `tfsdk:"retry_max_backoff_millis"`
+	RespectRetryAfter        types.Bool   `tfsdk:"respect_retry_after"`
+	RequestTimeoutSeconds    types.Int64  `tfsdk:"request_timeout_seconds"`
+	RequestsPerSecond        types.Int64  `tfsdk:"requests_per_second"`
+	MaxInFlightRequests      types.Int64  `tfsdk:"max_in_flight_requests"`
+	HTTPLogPath              types.String `tfsdk:"http_log_path"`
+	DisableUsageReporting    types.Bool   `tfsdk:"disable_usage_reporting"`
+	RepositoryDefaults       types.List   `tfsdk:"repository_defaults"`
 }
 
 // Metadata satisfies the provider.Provider interface for ArtifactoryProvider
@@ -112,6 +142,13 @@ func (p *ArtifactoryProvider) Schema(ctx context.Context, req provider.SchemaReq
 				},
 				Description: "Terraform Cloud Workload Identity Token tag name. Use for generating multiple TFC workload identity tokens. When set, the provider will attempt to use env var with this tag name as suffix. **Note:** this is case sensitive, so if set to `JFROG`, then env var `TFC_WORKLOAD_IDENTITY_TOKEN_JFROG` is used instead of `TFC_WORKLOAD_IDENTITY_TOKEN`. See [Generating Multiple Tokens](https://developer.hashicorp.com/terraform/cloud-docs/workspaces/dynamic-provider-credentials/manual-generation#generating-multiple-tokens) on HCP Terraform for more details.",
 			},
+			"server_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "ID of a server configured with the JFrog CLI (`jf config add`). The url, access token and client certificate of the server are loaded from the JFrog CLI config in `JFROG_CLI_HOME_DIR` (defaults to `~/.jfrog`). An expired access token is refreshed with the server refresh token. Provider attributes take precedence over the values from the JFrog CLI config.",
+			},
 			"client_certificate_path": schema.StringAttribute{
 				Optional: true,
 				Validators: []validator.String{
@@ -142,6 +179,177 @@ func (p *ArtifactoryProvider) Schema(ctx context.Context, req provider.SchemaReq
 				},
 				Description: "Inline PEM-encoded private key that matches `client_certificate_pem`.",
 			},
+			"ca_certificate_path": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_certificate_pem")),
+				},
+				Description: "Filesystem path to a PEM-encoded CA certificate bundle used to verify the Artifactory server certificate. The certificates are trusted in addition to the system certificate pool.",
+			},
+			"ca_certificate_pem": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Inline PEM-encoded CA certificate bundle used to verify the Artifactory server certificate. The certificates are trusted in addition to the system certificate pool.",
+			},
+			"min_tls_version": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(supportedTLSVersions...),
+				},
+				Description: fmt.Sprintf("Minimum TLS version accepted when connecting to Artifactory. Allowed values: %s.", strings.Join(supportedTLSVersions, ", ")),
+			},
+			"tls_cipher_suites": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				Description: "List of TLS cipher suite names (e.g. `TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256`) allowed when connecting to Artifactory. Only applies to TLS 1.2 and earlier; TLS 1.3 cipher suites are not configurable.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "URL of the proxy used for all outbound requests to Artifactory, e.g. `http://proxy.example.com:3128`. Supported schemes are `http`, `https` and `socks5`. If not set, the `HTTP_PROXY`/`HTTPS_PROXY` environment variables are used.",
+			},
+			"no_proxy": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Overrides the `NO_PROXY` environment variable.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: fmt.Sprintf("Maximum number of times a request is retried on connection errors and on HTTP 429 (Too Many Requests) or 503 (Service Unavailable) responses. Default: `%d`.", defaultMaxRetries),
+			},
+			"retry_min_backoff_millis": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: fmt.Sprintf("Minimum wait time between retries, in milliseconds. The wait time grows exponentially with each retry. Default: `%d`.", defaultRetryMinBackoffMillis),
+			},
+			"retry_max_backoff_millis": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: fmt.Sprintf("Maximum exponential backoff between retries, in milliseconds. A `Retry-After` header is not capped by it. Default: `%d`.", defaultRetryMaxBackoffMillis),
+			},
+			"respect_retry_after": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When `true`, the wait time before retrying a request is taken from the `Retry-After` response header, if present, up to 5 minutes. Default: `true`.",
+			},
+			"request_timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "Timeout for each request attempt, in seconds, including reading the response body. `0` means no timeout. Default: `0`.",
+			},
+			"requests_per_second": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "Maximum number of requests per second sent to Artifactory by the provider. `0` means no limit. Default: `0`.",
+			},
+			"max_in_flight_requests": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "Maximum number of concurrent requests sent to Artifactory by the provider. `0` means no limit. Default: `0`.",
+			},
+			"http_log_path": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Path of a file the provider appends a JSON Lines log of every API request to, with the method, path, status, duration, request ID and resource type. Credentials, tokens, passwords, private keys and attributes marked as sensitive are redacted. Can also be sourced from the `JFROG_HTTP_LOG_PATH` or `ARTIFACTORY_HTTP_LOG_PATH` environment variable.",
+			},
+			"disable_usage_reporting": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Disables reporting the resources and data sources used by the provider to Artifactory, e.g. for air-gapped or regulated instances. When enabled, usage is reported in a single request when the provider exits. Can also be sourced from the `JFROG_DISABLE_USAGE_REPORTING` or `ARTIFACTORY_DISABLE_USAGE_REPORTING` environment variable. Default: `false`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"repository_defaults": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"rclass": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(repository.RepositoryDefaultsRclasses...),
+							},
+							Description: repositoryDefaultsDescriptions["rclass"],
+						},
+						"package_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+							Description: repositoryDefaultsDescriptions["package_types"],
+						},
+						"xray_index": schema.BoolAttribute{
+							Optional:    true,
+							Description: repositoryDefaultsDescriptions["xray_index"],
+						},
+						"property_sets": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+							Description: repositoryDefaultsDescriptions["property_sets"],
+						},
+						"includes_pattern": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+							Description: repositoryDefaultsDescriptions["includes_pattern"],
+						},
+						"notes": schema.StringAttribute{
+							Optional:    true,
+							Description: repositoryDefaultsDescriptions["notes"],
+						},
+						"project_environments": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: repositoryDefaultsDescriptions["project_environments"],
+						},
+						"socket_timeout_millis": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+							Description: repositoryDefaultsDescriptions["socket_timeout_millis"],
+						},
+						"retrieval_cache_period_seconds": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+							Description: repositoryDefaultsDescriptions["retrieval_cache_period_seconds"],
+						},
+						"proxy": schema.StringAttribute{
+							Optional:    true,
+							Description: repositoryDefaultsDescriptions["proxy"],
+						},
+					},
+				},
+				Description: repositoryDefaultsDescriptions["repository_defaults"],
+			},
 		},
 	}
 }
@@ -154,6 +362,10 @@ func (p *ArtifactoryProvider) Configure(ctx context.Context, req provider.Config
 	clientCertificateKeyPath := util.CheckEnvVars([]string{"JFROG_CLIENT_CERT_KEY_PATH", "ARTIFACTORY_CLIENT_CERT_KEY_PATH"}, "")
 	clientCertificatePEM := util.CheckEnvVars([]string{"JFROG_CLIENT_CERT_PEM", "ARTIFACTORY_CLIENT_CERT_PEM"}, "")
 	clientPrivateKeyPEM := util.CheckEnvVars([]string{"JFROG_CLIENT_PRIVATE_KEY_PEM", "ARTIFACTORY_CLIENT_PRIVATE_KEY_PEM"}, "")
+	caCertificatePath := util.CheckEnvVars([]string{"JFROG_CA_CERT_PATH", "ARTIFACTORY_CA_CERT_PATH"}, "")
+	caCertificatePEM := util.CheckEnvVars([]string{"JFROG_CA_CERT_PEM", "ARTIFACTORY_CA_CERT_PEM"}, "")
+	httpLogPath := util.CheckEnvVars([]string{"JFROG_HTTP_LOG_PATH", "ARTIFACTORY_HTTP_LOG_PATH"}, "")
+	disableUsageReporting := util.GetBoolEnvVar([]string{"JFROG_DISABLE_USAGE_REPORTING", "ARTIFACTORY_DISABLE_USAGE_REPORTING"}, false)
 
 	var config ArtifactoryProviderModel
 
@@ -163,6 +375,28 @@ func (p *ArtifactoryProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
+	var cliConfigPath string
+	var cliServer *cliServerDetails
+	if serverId := config.ServerId.ValueString(); serverId != "" {
+		var err error
+		cliConfigPath, cliServer, err = loadCLIServerFromHome(serverId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error loading JFrog CLI configuration",
+				err.Error(),
+			)
+			return
+		}
+
+		if cliServer.URL() != "" {
+			url = cliServer.URL()
+		}
+		if cliServer.ClientCertPath != "" {
+			clientCertificatePath = cliServer.ClientCertPath
+			clientCertificateKeyPath = cliServer.ClientCertKeyPath
+		}
+	}
+
 	if config.Url.ValueString() != "" {
 		url = config.Url.ValueString()
 	}
@@ -186,9 +420,131 @@ func (p *ArtifactoryProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
+	if !config.ClientCertificatePath.IsNull() && !config.ClientCertificatePath.IsUnknown() && config.ClientCertificatePath.ValueString() != "" {
+		clientCertificatePath = config.ClientCertificatePath.ValueString()
+	}
+	if !config.ClientCertificateKeyPath.IsNull() && !config.ClientCertificateKeyPath.IsUnknown() && config.ClientCertificateKeyPath.ValueString() != "" {
+		clientCertificateKeyPath = config.ClientCertificateKeyPath.ValueString()
+	}
+	if !config.ClientCertificatePEM.IsNull() && !config.ClientCertificatePEM.IsUnknown() && config.ClientCertificatePEM.ValueString() != "" {
+		clientCertificatePEM = config.ClientCertificatePEM.ValueString()
+	}
+	if !config.ClientPrivateKeyPEM.IsNull() && !config.ClientPrivateKeyPEM.IsUnknown() && config.ClientPrivateKeyPEM.ValueString() != "" {
+		clientPrivateKeyPEM = config.ClientPrivateKeyPEM.ValueString()
+	}
+
+	if !config.CACertificatePath.IsNull() && !config.CACertificatePath.IsUnknown() && config.CACertificatePath.ValueString() != "" {
+		caCertificatePath = config.CACertificatePath.ValueString()
+	}
+	if !config.CACertificatePEM.IsNull() && !config.CACertificatePEM.IsUnknown() && config.CACertificatePEM.ValueString() != "" {
+		caCertificatePEM = config.CACertificatePEM.ValueString()
+	}
+
+	var cipherSuites []string
+	resp.Diagnostics.Append(config.TLSCipherSuites.ElementsAs(ctx, &cipherSuites, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// TLS and proxy settings must be in place before the first request, which may be the OIDC token exchange.
+	bypassJFrogTLSVerification := os.Getenv("JFROG_BYPASS_TLS_VERIFICATION")
+	tlsConfig, err := buildTLSConfig(tlsConfigOptions{
+		ClientCertificatePath:    clientCertificatePath,
+		ClientCertificateKeyPath: clientCertificateKeyPath,
+		ClientCertificatePEM:     clientCertificatePEM,
+		ClientPrivateKeyPEM:      clientPrivateKeyPEM,
+		CACertificatePath:        caCertificatePath,
+		CACertificatePEM:         caCertificatePEM,
+		MinTLSVersion:            config.MinTLSVersion.ValueString(),
+		CipherSuites:             cipherSuites,
+		InsecureSkipVerify:       strings.ToLower(bypassJFrogTLSVerification) == "true",
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error configuring TLS",
+			err.Error(),
+		)
+		return
+	}
+	if tlsConfig != nil {
+		restyClient.SetTLSClientConfig(tlsConfig)
+	}
+
+	if err := configureProxy(restyClient, config.ProxyURL.ValueString(), config.NoProxy.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error configuring proxy",
+			err.Error(),
+		)
+		return
+	}
+
+	clientOpts := defaultClientOptions()
+	if !config.MaxRetries.IsNull() {
+		clientOpts.MaxRetries = config.MaxRetries.ValueInt64()
+	}
+	if !config.RetryMinBackoffMillis.IsNull() {
+		clientOpts.RetryMinBackoffMillis = config.RetryMinBackoffMillis.ValueInt64()
+	}
+	if !config.RetryMaxBackoffMillis.IsNull() {
+		clientOpts.RetryMaxBackoffMillis = config.RetryMaxBackoffMillis.ValueInt64()
+	}
+	if !config.RespectRetryAfter.IsNull() {
+		clientOpts.RespectRetryAfter = config.RespectRetryAfter.ValueBool()
+	}
+	clientOpts.RequestTimeoutSeconds = config.RequestTimeoutSeconds.ValueInt64()
+	clientOpts.RequestsPerSecond = config.RequestsPerSecond.ValueInt64()
+	clientOpts.MaxInFlightRequests = config.MaxInFlightRequests.ValueInt64()
+
+	if err := configureClient(restyClient, url, clientOpts); err != nil {
+		resp.Diagnostics.AddError(
+			"Error configuring Resty client",
+			err.Error(),
+		)
+		return
+	}
+
+	if config.HTTPLogPath.ValueString() != "" {
+		httpLogPath = config.HTTPLogPath.ValueString()
+	}
+	if err := configureHTTPLog(ctx, restyClient, httpLogPath); err != nil {
+		resp.Diagnostics.AddError(
+			"Error configuring HTTP log",
+			err.Error(),
+		)
+		return
+	}
+
+	// Tokens are renewed with a client without auth, so an expired token is never sent along.
+	tokenClient := restyClient.Clone()
+	var tokenRefresh tokenRefreshFunc
+	var tokenSourceKey string
+
+	if cliServer != nil && config.AccessToken.ValueString() == "" {
+		cliAccessToken, err := cliServerAccessToken(restyClient, cliConfigPath, cliServer)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error refreshing JFrog CLI access token",
+				err.Error(),
+			)
+			return
+		}
+
+		if cliAccessToken != "" {
+			accessToken = cliAccessToken
+		}
+
+		if cliServer.RefreshToken != "" {
+			tokenRefresh = func() (string, error) {
+				return refreshCLIAccessToken(tokenClient, cliConfigPath, cliServer)
+			}
+			tokenSourceKey = fmt.Sprintf("%s|cli|%s|%s", url, cliConfigPath, cliServer.ServerId)
+		}
+	}
+
 	oidcProviderName := config.OIDCProviderName.ValueString()
 	if oidcProviderName != "" {
-		oidcAccessToken, err := util.OIDCTokenExchange(ctx, restyClient, oidcProviderName, config.TFCCredentialTagName.ValueString())
+		tfcCredentialTagName := config.TFCCredentialTagName.ValueString()
+		oidcAccessToken, err := util.OIDCTokenExchange(ctx, restyClient, oidcProviderName, tfcCredentialTagName)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Failed OIDC ID token exchange",
@@ -201,6 +557,10 @@ func (p *ArtifactoryProvider) Configure(ctx context.Context, req provider.Config
 		// environment variable data, if found.
 		if oidcAccessToken != "" {
 			accessToken = oidcAccessToken
+			tokenRefresh = func() (string, error) {
+				return util.OIDCTokenExchange(ctx, tokenClient, oidcProviderName, tfcCredentialTagName)
+			}
+			tokenSourceKey = fmt.Sprintf("%s|oidc|%s|%s", url, oidcProviderName, tfcCredentialTagName)
 		}
 	}
 
@@ -208,19 +568,7 @@ func (p *ArtifactoryProvider) Configure(ctx context.Context, req provider.Config
 	// environment variable data, if found.
 	if config.AccessToken.ValueString() != "" {
 		accessToken = config.AccessToken.ValueString()
-	}
-
-	if !config.ClientCertificatePath.IsNull() && !config.ClientCertificatePath.IsUnknown() && config.ClientCertificatePath.ValueString() != "" {
-		clientCertificatePath = config.ClientCertificatePath.ValueString()
-	}
-	if !config.ClientCertificateKeyPath.IsNull() && !config.ClientCertificateKeyPath.IsUnknown() && config.ClientCertificateKeyPath.ValueString() != "" {
-		clientCertificateKeyPath = config.ClientCertificateKeyPath.ValueString()
-	}
-	if !config.ClientCertificatePEM.IsNull() && !config.ClientCertificatePEM.IsUnknown() && config.ClientCertificatePEM.ValueString() != "" {
-		clientCertificatePEM = config.ClientCertificatePEM.ValueString()
-	}
-	if !config.ClientPrivateKeyPEM.IsNull() && !config.ClientPrivateKeyPEM.IsUnknown() && config.ClientPrivateKeyPEM.ValueString() != "" {
-		clientPrivateKeyPEM = config.ClientPrivateKeyPEM.ValueString()
+		tokenRefresh = nil
 	}
 
 	apiKey := config.ApiKey.ValueString()
@@ -243,23 +591,8 @@ func (p *ArtifactoryProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
-	bypassJFrogTLSVerification := os.Getenv("JFROG_BYPASS_TLS_VERIFICATION")
-	tlsConfig, err := buildTLSConfig(tlsConfigOptions{
-		ClientCertificatePath:    clientCertificatePath,
-		ClientCertificateKeyPath: clientCertificateKeyPath,
-		ClientCertificatePEM:     clientCertificatePEM,
-		ClientPrivateKeyPEM:      clientPrivateKeyPEM,
-		InsecureSkipVerify:       strings.ToLower(bypassJFrogTLSVerification) == "true",
-	})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error configuring TLS",
-			err.Error(),
-		)
-		return
-	}
-	if tlsConfig != nil {
-		restyClient.SetTLSClientConfig(tlsConfig)
+	if tokenRefresh != nil {
+		configureTokenRefresh(restyClient, sharedTokenSource(tokenSourceKey, accessToken, tokenRefresh))
 	}
 
 	version, err := util.GetArtifactoryVersion(restyClient)
@@ -271,13 +604,30 @@ func (p *ArtifactoryProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
-	featureUsage := fmt.Sprintf("Terraform/%s", req.TerraformVersion)
-	go util.SendUsage(ctx, restyClient.R(), productId, featureUsage)
+	// The Access version is only used to check the availability of features, an older server or a
+	// token without access to the endpoint shouldn't prevent using the provider.
+	accessVersion, err := util.GetAccessVersion(restyClient)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to get Access version, features depending on it are not checked", map[string]any{"error": err.Error()})
+	}
+
+	repositoryDefaults, d := getRepositoryDefaults(ctx, config.RepositoryDefaults)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.repositoryDefaults = repositoryDefaults
+
+	if !config.DisableUsageReporting.IsNull() {
+		disableUsageReporting = config.DisableUsageReporting.ValueBool()
+	}
+	configureUsageReporting(restyClient, productId, req.TerraformVersion, disableUsageReporting)
 
 	meta := util.ProviderMetadata{
 		Client:             restyClient,
 		ProductId:          productId,
 		ArtifactoryVersion: version,
+		AccessVersion:      accessVersion,
 	}
 
 	resp.DataSourceData = meta
@@ -313,7 +663,49 @@ func (p *ArtifactoryProvider) Resources(ctx context.Context) []func() resource.R
 	)
 	resources = append(resources, remoteBasicLikeRepositoryResources...)
 
-	return append(
+	virtualGenericLikeRepositoryResources := lo.Map(
+		virtual.PackageTypesLikeGeneric,
+		func(packageType string, _ int) func() resource.Resource {
+			return virtual.NewGenericVirtualRepositoryResource(packageType)
+		},
+	)
+	resources = append(resources, virtualGenericLikeRepositoryResources...)
+
+	virtualGenericWithRetrievalCachePeriodSecsRepositoryResources := lo.Map(
+		virtual.PackageTypesLikeGenericWithRetrievalCachePeriodSecs,
+		func(packageType string, _ int) func() resource.Resource {
+			return virtual.NewGenericWithRetrievalCachePeriodSecsVirtualRepositoryResource(packageType)
+		},
+	)
+	resources = append(resources, virtualGenericWithRetrievalCachePeriodSecsRepositoryResources...)
+
+	virtualGradleLikeRepositoryResources := lo.Map(
+		repository.PackageTypesLikeGradle,
+		func(packageType string, _ int) func() resource.Resource {
+			return virtual.NewJavaVirtualRepositoryResource(packageType)
+		},
+	)
+	resources = append(resources, virtualGradleLikeRepositoryResources...)
+	resources = append(resources, virtual.NewJavaVirtualRepositoryResource(repository.MavenPackageType))
+
+	federatedGenericLikeRepositoryResources := lo.Map(
+		federated.PackageTypesLikeGeneric,
+		func(packageType string, _ int) func() resource.Resource {
+			return federated.NewGenericFederatedRepositoryResource(packageType)
+		},
+	)
+	resources = append(resources, federatedGenericLikeRepositoryResources...)
+
+	federatedGradleLikeRepositoryResources := lo.Map(
+		repository.PackageTypesLikeGradle,
+		func(packageType string, _ int) func() resource.Resource {
+			return federated.NewJavaFederatedRepositoryResource(packageType, true)
+		},
+	)
+	resources = append(resources, federatedGradleLikeRepositoryResources...)
+	resources = append(resources, federated.NewJavaFederatedRepositoryResource(repository.MavenPackageType, false))
+
+	resources = append(
 		resources,
 		[]func() resource.Resource{
 			artifact.NewArtifactResource,
@@ -331,6 +723,8 @@ func (p *ArtifactoryProvider) Resources(ctx context.Context) []func() resource.R
 			security.NewPasswordExpirationPolicyResource,
 			security.NewUserLockPolicyResource,
 			security.NewVaultConfigurationResource,
+			build.NewBuildPromotionResource,
+			build.NewBuildRetentionPolicyResource,
 			configuration.NewArchivePolicyResource,
 			configuration.NewResourceBundleCleanupPolicyV2Resource,
 			configuration.NewLdapSettingResource,
@@ -345,6 +739,7 @@ func (p *ArtifactoryProvider) Resources(ctx context.Context) []func() resource.R
 			configuration.NewTrashCanConfigResource,
 			lifecycle.NewReleaseBundleV2Resource,
 			lifecycle.NewReleaseBundleV2PromotionResource,
+			lifecycle.NewReleaseBundleV2DistributionResource,
 			replication.NewLocalRepositorySingleReplicationResource,
 			replication.NewLocalRepositoryMultiReplicationResource,
 			replication.NewRemoteRepositoryReplicationResource,
@@ -414,10 +809,42 @@ func (p *ArtifactoryProvider) Resources(ctx context.Context) []func() resource.R
 			webhook.NewReleaseBundleV2PromotionCustomWebhookResource,
 			webhook.NewUserWebhookResource,
 			webhook.NewUserCustomWebhookResource,
+			virtual.NewAlpineVirtualRepositoryResource,
+			virtual.NewBowerVirtualRepositoryResource,
+			virtual.NewConanVirtualRepositoryResource,
+			virtual.NewDebianVirtualRepositoryResource,
+			virtual.NewDockerVirtualRepositoryResource,
+			virtual.NewGoVirtualRepositoryResource,
+			virtual.NewHelmVirtualRepositoryResource,
+			virtual.NewHelmOCIVirtualRepositoryResource,
 			virtual.NewHexVirtualRepositoryResource,
 			virtual.NewNixVirtualRepositoryResource,
+			virtual.NewNPMVirtualRepositoryResource,
+			virtual.NewNugetVirtualRepositoryResource,
+			virtual.NewOCIVirtualRepositoryResource,
+			virtual.NewRPMVirtualRepositoryResource,
+			federated.NewAlpineFederatedRepositoryResource,
+			federated.NewAnsibleFederatedRepositoryResource,
+			federated.NewCargoFederatedRepositoryResource,
+			federated.NewConanFederatedRepositoryResource,
+			federated.NewDebianFederatedRepositoryResource,
+			federated.NewDockerFederatedRepositoryResource,
+			federated.NewDockerV1FederatedRepositoryResource,
+			federated.NewDockerV2FederatedRepositoryResource,
+			federated.NewHelmOCIFederatedRepositoryResource,
+			federated.NewNugetFederatedRepositoryResource,
+			federated.NewOCIFederatedRepositoryResource,
+			federated.NewRPMFederatedRepositoryResource,
+			federated.NewTerraformModuleFederatedRepositoryResource,
+			federated.NewTerraformProviderFederatedRepositoryResource,
 		}...,
 	)
+
+	resources = lo.Map(resources, func(constructor func() resource.Resource, _ int) func() resource.Resource {
+		return p.withRepositoryDefaults(constructor)
+	})
+
+	return append(resources, repository.NewUnifiedRepositoryResource(resources))
 }
 
 // DataSources satisfies the provider.Provider interface for ArtifactoryProvider.
@@ -425,6 +852,13 @@ func (p *ArtifactoryProvider) DataSources(_ context.Context) []func() datasource
 	return []func() datasource.DataSource{
 		datasource_repository.NewRepositoriesDataSource,
 		datasource_artifact.NewFileListDataSource,
+		datasource_build.NewBuildInfoDataSource,
+		datasource_configuration.NewCleanupPolicyPreviewDataSource,
+		datasource_configuration.NewCleanupPolicyExecutionsDataSource,
+		datasource_lifecycle.NewReleaseBundleV2VersionsDataSource,
+		datasource_lifecycle.NewReleaseBundleV2ContentsDataSource,
+		datasource_lifecycle.NewReleaseBundleV2PromotionsDataSource,
+		datasource_lifecycle.NewReleaseBundleV2SignatureDataSource,
 		datasource_local.NewLocalHexRepositoryDataSource,
 		datasource_local.NewLocalNixRepositoryDataSource,
 		datasource_remote.NewRemoteHexRepositoryDataSource,
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// configureHTTPLog enables the HTTP audit log when path is set. It must be called after all other
+// transport configuration as the logger wraps the client transport.
+func configureHTTPLog(ctx context.Context, restyClient *resty.Client, path string) error {
+	if path == "" {
+		return nil
+	}
+
+	logger, err := httplog.Open(path, httplog.NewRedactor(sensitiveSchemaFields(ctx)))
+	if err != nil {
+		return err
+	}
+	logger.Attach(restyClient)
+
+	return nil
+}
+
+var (
+	sensitiveSchemaFieldsOnce sync.Once
+	sensitiveSchemaFieldsList []string
+)
+
+// sensitiveSchemaFields returns the names of all attributes marked as sensitive in the resource and
+// data source schemas of both the SDKv2 and framework providers.
+func sensitiveSchemaFields(ctx context.Context) []string {
+	sensitiveSchemaFieldsOnce.Do(func() {
+		fields := map[string]bool{}
+
+		for _, resources := range []map[string]*schema.Resource{resourcesMap(), datasourcesMap()} {
+			for _, r := range resources {
+				collectSDKv2SensitiveFields(r.Schema, fields)
+			}
+		}
+
+		server := providerserver.NewProtocol6(Framework()())()
+		if resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{}); err == nil {
+			for _, schemas := range []map[string]*tfprotov6.Schema{resp.ResourceSchemas, resp.DataSourceSchemas} {
+				for _, s := range schemas {
+					collectProtocolSensitiveFields(s.Block, fields)
+				}
+			}
+		}
+
+		for field := range fields {
+			sensitiveSchemaFieldsList = append(sensitiveSchemaFieldsList, field)
+		}
+		sort.Strings(sensitiveSchemaFieldsList)
+	})
+
+	return sensitiveSchemaFieldsList
+}
+
+func collectSDKv2SensitiveFields(s map[string]*schema.Schema, fields map[string]bool) {
+	for name, attr := range s {
+		if attr.Sensitive {
+			fields[name] = true
+		}
+		if elem, ok := attr.Elem.(*schema.Resource); ok {
+			collectSDKv2SensitiveFields(elem.Schema, fields)
+		}
+	}
+}
+
+func collectProtocolSensitiveFields(block *tfprotov6.SchemaBlock, fields map[string]bool) {
+	if block == nil {
+		return
+	}
+
+	collectProtocolSensitiveAttributes(block.Attributes, fields)
+	for _, nested := range block.BlockTypes {
+		collectProtocolSensitiveFields(nested.Block, fields)
+	}
+}
+
+func collectProtocolSensitiveAttributes(attributes []*tfprotov6.SchemaAttribute, fields map[string]bool) {
+	for _, attr := range attributes {
+		if attr.Sensitive {
+			fields[attr.Name] = true
+		}
+		if attr.NestedType != nil {
+			collectProtocolSensitiveAttributes(attr.NestedType.Attributes, fields)
+		}
+	}
+}
+
+func tagProviderMetadata(typeName string, meta interface{}) interface{} {
+	if providerMetadata, ok := meta.(util.ProviderMetadata); ok {
+		return httplog.ForResource(providerMetadata, typeName)
+	}
+	return meta
+}
+
+// tagResourceClients makes the SDKv2 resources and data sources use a client attributed to their
+// type in the HTTP log. This is a no-op while the log is disabled.
+func tagResourceClients(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for typeName, resource := range resources {
+		// Resources and importers may be shared between type names, so each type gets its own copy.
+		r := *resource
+		resources[typeName] = &r
+
+		if f := r.CreateContext; f != nil {
+			r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				return f(ctx, d, tagProviderMetadata(typeName, meta))
+			}
+		}
+		if f := r.ReadContext; f != nil {
+			r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				return f(ctx, d, tagProviderMetadata(typeName, meta))
+			}
+		}
+		if f := r.UpdateContext; f != nil {
+			r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				return f(ctx, d, tagProviderMetadata(typeName, meta))
+			}
+		}
+		if f := r.DeleteContext; f != nil {
+			r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				return f(ctx, d, tagProviderMetadata(typeName, meta))
+			}
+		}
+		if f := r.CustomizeDiff; f != nil {
+			r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				return f(ctx, d, tagProviderMetadata(typeName, meta))
+			}
+		}
+		if r.Importer != nil && r.Importer.StateContext != nil {
+			importer := *r.Importer
+			f := importer.StateContext
+			importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return f(ctx, d, tagProviderMetadata(typeName, meta))
+			}
+			r.Importer = &importer
+		}
+	}
+
+	return resources
+}
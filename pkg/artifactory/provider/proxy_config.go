@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/net/http/httpproxy"
+)
+
+var supportedProxySchemes = []string{"http", "https", "socks5"}
+
+// buildProxyFunc returns the proxy selector for outbound requests. When proxyURL is empty the
+// standard HTTP_PROXY/HTTPS_PROXY environment variables are used, with noProxy (if set)
+// replacing NO_PROXY. Returns nil when neither option is set so the transport default is kept.
+func buildProxyFunc(proxyURL, noProxy string) (func(*http.Request) (*url.URL, error), error) {
+	if proxyURL == "" && noProxy == "" {
+		return nil, nil
+	}
+
+	config := httpproxy.FromEnvironment()
+
+	if proxyURL != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proxy_url: %w", err)
+		}
+		if !slices.Contains(supportedProxySchemes, u.Scheme) || u.Host == "" {
+			return nil, fmt.Errorf("invalid proxy_url '%s', must be an absolute URL with one of the schemes: %v", proxyURL, supportedProxySchemes)
+		}
+
+		config.HTTPProxy = proxyURL
+		config.HTTPSProxy = proxyURL
+	}
+
+	if noProxy != "" {
+		config.NoProxy = noProxy
+	}
+
+	proxyFunc := config.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}, nil
+}
+
+func configureProxy(restyClient *resty.Client, proxyURL, noProxy string) error {
+	proxyFunc, err := buildProxyFunc(proxyURL, noProxy)
+	if err != nil {
+		return err
+	}
+	if proxyFunc == nil {
+		return nil
+	}
+
+	transport, err := restyClient.Transport()
+	if err != nil {
+		return err
+	}
+	transport.Proxy = proxyFunc
+
+	return nil
+}
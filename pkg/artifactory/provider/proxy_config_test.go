@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBuildProxyFuncReturnsNilWhenNoOptions(t *testing.T) {
+	proxyFunc, err := buildProxyFunc("", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if proxyFunc != nil {
+		t.Fatal("expected nil proxy func when no options are provided")
+	}
+}
+
+func TestBuildProxyFuncWithNoProxy(t *testing.T) {
+	proxyFunc, err := buildProxyFunc("http://proxy.example.com:3128", "internal.example.com,10.0.0.0/8")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for requestURL, expected := range map[string]string{
+		"https://myinstance.jfrog.io/artifactory":      "http://proxy.example.com:3128",
+		"http://myinstance.jfrog.io/artifactory":       "http://proxy.example.com:3128",
+		"https://artifactory.internal.example.com/api": "",
+		"https://10.1.2.3/artifactory/api/system/ping": "",
+	} {
+		req, err := http.NewRequest(http.MethodGet, requestURL, nil)
+		if err != nil {
+			t.Fatalf("failed creating request: %s", err)
+		}
+
+		proxy, err := proxyFunc(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		actual := ""
+		if proxy != nil {
+			actual = proxy.String()
+		}
+		if actual != expected {
+			t.Fatalf("expected proxy '%s' for %s, got '%s'", expected, requestURL, actual)
+		}
+	}
+}
+
+func TestBuildProxyFuncInvalidURL(t *testing.T) {
+	for _, proxyURL := range []string{"proxy.example.com:3128", "ftp://proxy.example.com"} {
+		_, err := buildProxyFunc(proxyURL, "")
+		if err == nil || !strings.Contains(err.Error(), "proxy_url") {
+			t.Fatalf("expected proxy_url error for %s, got %v", proxyURL, err)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
+)
+
+// repositoryDefaultsDescriptions are shared by the Framework and SDKv2 provider schemas, which must be identical.
+var repositoryDefaultsDescriptions = map[string]string{
+	"repository_defaults": "Default attribute values for repositories, applied at plan time to attributes not set in the resource configuration. " +
+		"Blocks without `package_types` are applied first, then blocks matching the repository package type override them. " +
+		"Only supported by the repository resources implemented with the Terraform Plugin Framework.",
+	"rclass":                         "Repository class the defaults apply to. Allowed values: `local`, `remote`, `virtual`.",
+	"package_types":                  "Package types the defaults apply to. If not set, the defaults apply to all package types of the repository class.",
+	"xray_index":                     "Default value for `xray_index`.",
+	"property_sets":                  "Default value for `property_sets`.",
+	"includes_pattern":               "Default value for `includes_pattern`.",
+	"notes":                          "Default value for `notes`.",
+	"project_environments":           "Default value for `project_environments`.",
+	"socket_timeout_millis":          "Default value for `socket_timeout_millis`. Only allowed for `remote` repositories.",
+	"retrieval_cache_period_seconds": "Default value for `retrieval_cache_period_seconds`. Only allowed for `remote` repositories.",
+	"proxy":                          "Default value for `proxy`. Only allowed for `remote` repositories.",
+}
+
+// repositoryDefaultsSDKv2Schema is only declared so the SDKv2 provider schema matches the Framework provider schema.
+// The defaults are applied by the Framework repository resources.
+var repositoryDefaultsSDKv2Schema = &sdkv2_schema.Schema{
+	Type:     sdkv2_schema.TypeList,
+	Optional: true,
+	Elem: &sdkv2_schema.Resource{
+		Schema: map[string]*sdkv2_schema.Schema{
+			"rclass": {
+				Type:             sdkv2_schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(repository.RepositoryDefaultsRclasses, false)),
+				Description:      repositoryDefaultsDescriptions["rclass"],
+			},
+			"package_types": {
+				Type:        sdkv2_schema.TypeSet,
+				Elem:        &sdkv2_schema.Schema{Type: sdkv2_schema.TypeString},
+				Optional:    true,
+				Description: repositoryDefaultsDescriptions["package_types"],
+			},
+			"xray_index": {
+				Type:        sdkv2_schema.TypeBool,
+				Optional:    true,
+				Description: repositoryDefaultsDescriptions["xray_index"],
+			},
+			"property_sets": {
+				Type:        sdkv2_schema.TypeSet,
+				Elem:        &sdkv2_schema.Schema{Type: sdkv2_schema.TypeString},
+				Optional:    true,
+				Description: repositoryDefaultsDescriptions["property_sets"],
+			},
+			"includes_pattern": {
+				Type:        sdkv2_schema.TypeString,
+				Optional:    true,
+				Description: repositoryDefaultsDescriptions["includes_pattern"],
+			},
+			"notes": {
+				Type:        sdkv2_schema.TypeString,
+				Optional:    true,
+				Description: repositoryDefaultsDescriptions["notes"],
+			},
+			"project_environments": {
+				Type:        sdkv2_schema.TypeSet,
+				Elem:        &sdkv2_schema.Schema{Type: sdkv2_schema.TypeString},
+				Optional:    true,
+				Description: repositoryDefaultsDescriptions["project_environments"],
+			},
+			"socket_timeout_millis": {
+				Type:        sdkv2_schema.TypeInt,
+				Optional:    true,
+				Description: repositoryDefaultsDescriptions["socket_timeout_millis"],
+			},
+			"retrieval_cache_period_seconds": {
+				Type:        sdkv2_schema.TypeInt,
+				Optional:    true,
+				Description: repositoryDefaultsDescriptions["retrieval_cache_period_seconds"],
+			},
+			"proxy": {
+				Type:        sdkv2_schema.TypeString,
+				Optional:    true,
+				Description: repositoryDefaultsDescriptions["proxy"],
+			},
+		},
+	},
+	Description: repositoryDefaultsDescriptions["repository_defaults"],
+}
+
+func getRepositoryDefaults(ctx context.Context, repositoryDefaults types.List) ([]repository.RepositoryDefaults, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	if repositoryDefaults.IsNull() {
+		return nil, diags
+	}
+
+	if repositoryDefaults.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("repository_defaults"),
+			"Unknown repository defaults",
+			"repository_defaults must be known when the provider is configured",
+		)
+		return nil, diags
+	}
+
+	var models []repository.RepositoryDefaultsModel
+	diags.Append(repositoryDefaults.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	defaults := make([]repository.RepositoryDefaults, 0, len(models))
+	for _, model := range models {
+		d, ds := model.ToRepositoryDefaults(ctx)
+		diags.Append(ds...)
+		defaults = append(defaults, d)
+	}
+
+	return defaults, diags
+}
+
+// withRepositoryDefaults hands the provider `repository_defaults` to the repository resources built by
+// constructor. They are read when the resource is built, which is after the provider is configured.
+func (p *ArtifactoryProvider) withRepositoryDefaults(constructor func() resource.Resource) func() resource.Resource {
+	return func() resource.Resource {
+		r := constructor()
+		if withDefaults, ok := r.(repository.ResourceWithRepositoryDefaults); ok {
+			withDefaults.SetRepositoryDefaults(p.repositoryDefaults)
+		}
+
+		return r
+	}
+}
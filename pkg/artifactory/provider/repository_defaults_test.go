@@ -0,0 +1,167 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/provider"
+)
+
+// objectValue returns an object of type t with the given attribute values, the other attributes are null.
+func objectValue(t tftypes.Object, values map[string]tftypes.Value) tftypes.Value {
+	attrs := map[string]tftypes.Value{}
+	for name, attrType := range t.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+			continue
+		}
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	return tftypes.NewValue(t, attrs)
+}
+
+// configureProvider configures the Framework provider against a fake Artifactory with one
+// `repository_defaults` block for local repositories.
+func configureProvider(t *testing.T, ctx context.Context, providerValues map[string]tftypes.Value) (fwprovider.Provider, any) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/artifactory/api/system/version" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version":"7.100.0","revision":"1"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	p := provider.Framework()()
+
+	schemaResp := fwprovider.SchemaResponse{}
+	p.Schema(ctx, fwprovider.SchemaRequest{}, &schemaResp)
+	providerType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	defaultsListType := providerType.AttributeTypes["repository_defaults"].(tftypes.List)
+	defaultsType := defaultsListType.ElementType.(tftypes.Object)
+
+	values := map[string]tftypes.Value{
+		"url":                     tftypes.NewValue(tftypes.String, server.URL),
+		"access_token":            tftypes.NewValue(tftypes.String, "token"),
+		"disable_usage_reporting": tftypes.NewValue(tftypes.Bool, true),
+		"repository_defaults": tftypes.NewValue(defaultsListType, []tftypes.Value{
+			objectValue(defaultsType, map[string]tftypes.Value{
+				"rclass": tftypes.NewValue(tftypes.String, "local"),
+				"notes":  tftypes.NewValue(tftypes.String, "from repository_defaults"),
+			}),
+		}),
+	}
+	for name, value := range providerValues {
+		values[name] = value
+	}
+
+	configureResp := fwprovider.ConfigureResponse{}
+	p.Configure(ctx, fwprovider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    objectValue(providerType, values),
+		},
+	}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("failed to configure provider: %v", configureResp.Diagnostics)
+	}
+
+	return p, configureResp.ResourceData
+}
+
+// plannedNotes returns the planned `notes` of a local generic repository without `notes` in its configuration.
+func plannedNotes(t *testing.T, ctx context.Context, p fwprovider.Provider, providerData any) types.String {
+	t.Helper()
+
+	var r resource.Resource
+	for _, constructor := range p.Resources(ctx) {
+		candidate := constructor()
+		metadataResp := resource.MetadataResponse{}
+		candidate.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "artifactory"}, &metadataResp)
+		if metadataResp.TypeName == "artifactory_local_generic_repository" {
+			r = candidate
+			break
+		}
+	}
+	if r == nil {
+		t.Fatal("artifactory_local_generic_repository not found")
+	}
+
+	configureResp := resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: providerData}, &configureResp)
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	raw := objectValue(schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object), map[string]tftypes.Value{
+		"key": tftypes.NewValue(tftypes.String, "generic-local"),
+	})
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw}
+
+	modifyPlanResp := resource.ModifyPlanResponse{Plan: plan}
+	r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw},
+		Plan:   plan,
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(raw.Type(), nil)},
+	}, &modifyPlanResp)
+	if modifyPlanResp.Diagnostics.HasError() {
+		t.Fatalf("failed to modify plan: %v", modifyPlanResp.Diagnostics)
+	}
+
+	var notes types.String
+	modifyPlanResp.Plan.GetAttribute(ctx, path.Root("notes"), &notes)
+
+	return notes
+}
+
+func TestRepositoryDefaults(t *testing.T) {
+	ctx := context.Background()
+
+	p, providerData := configureProvider(t, ctx, nil)
+
+	if notes := plannedNotes(t, ctx, p, providerData); notes.ValueString() != "from repository_defaults" {
+		t.Errorf("expected notes from repository_defaults, got %s", notes)
+	}
+}
+
+// The resources get a clone of the provider client when the HTTP log is enabled, which must not
+// affect the repository defaults.
+func TestRepositoryDefaultsWithHTTPLog(t *testing.T) {
+	ctx := context.Background()
+
+	p, providerData := configureProvider(t, ctx, map[string]tftypes.Value{
+		"http_log_path": tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "http.log")),
+	})
+
+	if notes := plannedNotes(t, ctx, p, providerData); notes.ValueString() != "from repository_defaults" {
+		t.Errorf("expected notes from repository_defaults, got %s", notes)
+	}
+}
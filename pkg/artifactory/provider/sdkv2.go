@@ -20,11 +20,13 @@ import (
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-shared/client"
 	"github.com/jfrog/terraform-provider-shared/util"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/jfrog/terraform-provider-shared/validator"
 )
 
@@ -67,6 +69,12 @@ func SdkV2() *schema.Provider {
 				ValidateDiagFunc: validator.StringIsNotEmpty,
 				Description:      "Terraform Cloud Workload Identity Token tag name. Use for generating multiple TFC workload identity tokens. When set, the provider will attempt to use env var with this tag name as suffix. **Note:** this is case sensitive, so if set to `JFROG`, then env var `TFC_WORKLOAD_IDENTITY_TOKEN_JFROG` is used instead of `TFC_WORKLOAD_IDENTITY_TOKEN`. See [Generating Multiple Tokens](https://developer.hashicorp.com/terraform/cloud-docs/workspaces/dynamic-provider-credentials/manual-generation#generating-multiple-tokens) on HCP Terraform for more details.",
 			},
+			"server_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validator.StringIsNotEmpty,
+				Description:      "ID of a server configured with the JFrog CLI (`jf config add`). The url, access token and client certificate of the server are loaded from the JFrog CLI config in `JFROG_CLI_HOME_DIR` (defaults to `~/.jfrog`). An expired access token is refreshed with the server refresh token. Provider attributes take precedence over the values from the JFrog CLI config.",
+			},
 			"client_certificate_path": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -109,10 +117,109 @@ func SdkV2() *schema.Provider {
 					"client_certificate_key_path",
 				},
 			},
+			"ca_certificate_path": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validator.StringIsNotEmpty,
+				Description:      "Filesystem path to a PEM-encoded CA certificate bundle used to verify the Artifactory server certificate. The certificates are trusted in addition to the system certificate pool.",
+				ConflictsWith:    []string{"ca_certificate_pem"},
+			},
+			"ca_certificate_pem": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validator.StringIsNotEmpty,
+				Description:      "Inline PEM-encoded CA certificate bundle used to verify the Artifactory server certificate. The certificates are trusted in addition to the system certificate pool.",
+				ConflictsWith:    []string{"ca_certificate_path"},
+			},
+			"min_tls_version": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(supportedTLSVersions, false)),
+				Description:      fmt.Sprintf("Minimum TLS version accepted when connecting to Artifactory. Allowed values: %s.", strings.Join(supportedTLSVersions, ", ")),
+			},
+			"tls_cipher_suites": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of TLS cipher suite names (e.g. `TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256`) allowed when connecting to Artifactory. Only applies to TLS 1.2 and earlier; TLS 1.3 cipher suites are not configurable.",
+			},
+			"proxy_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validator.StringIsNotEmpty,
+				Description:      "URL of the proxy used for all outbound requests to Artifactory, e.g. `http://proxy.example.com:3128`. Supported schemes are `http`, `https` and `socks5`. If not set, the `HTTP_PROXY`/`HTTPS_PROXY` environment variables are used.",
+			},
+			"no_proxy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validator.StringIsNotEmpty,
+				Description:      "Comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Overrides the `NO_PROXY` environment variable.",
+			},
+			"max_retries": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          defaultMaxRetries,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      fmt.Sprintf("Maximum number of times a request is retried on connection errors and on HTTP 429 (Too Many Requests) or 503 (Service Unavailable) responses. Default: `%d`.", defaultMaxRetries),
+			},
+			"retry_min_backoff_millis": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          defaultRetryMinBackoffMillis,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      fmt.Sprintf("Minimum wait time between retries, in milliseconds. The wait time grows exponentially with each retry. Default: `%d`.", defaultRetryMinBackoffMillis),
+			},
+			"retry_max_backoff_millis": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          defaultRetryMaxBackoffMillis,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      fmt.Sprintf("Maximum exponential backoff between retries, in milliseconds. A `Retry-After` header is not capped by it. Default: `%d`.", defaultRetryMaxBackoffMillis),
+			},
+			"respect_retry_after": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When `true`, the wait time before retrying a request is taken from the `Retry-After` response header, if present, up to 5 minutes. Default: `true`.",
+			},
+			"request_timeout_seconds": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Timeout for each request attempt, in seconds, including reading the response body. `0` means no timeout. Default: `0`.",
+			},
+			"requests_per_second": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Maximum number of requests per second sent to Artifactory by the provider. `0` means no limit. Default: `0`.",
+			},
+			"max_in_flight_requests": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Maximum number of concurrent requests sent to Artifactory by the provider. `0` means no limit. Default: `0`.",
+			},
+			"http_log_path": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validator.StringIsNotEmpty,
+				Description:      "Path of a file the provider appends a JSON Lines log of every API request to, with the method, path, status, duration, request ID and resource type. Credentials, tokens, passwords, private keys and attributes marked as sensitive are redacted. Can also be sourced from the `JFROG_HTTP_LOG_PATH` or `ARTIFACTORY_HTTP_LOG_PATH` environment variable.",
+			},
+			"disable_usage_reporting": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Disables reporting the resources and data sources used by the provider to Artifactory, e.g. for air-gapped or regulated instances. When enabled, usage is reported in a single request when the provider exits. Can also be sourced from the `JFROG_DISABLE_USAGE_REPORTING` or `ARTIFACTORY_DISABLE_USAGE_REPORTING` environment variable. Default: `false`.",
+			},
+			"repository_defaults": repositoryDefaultsSDKv2Schema,
 		},
 
-		ResourcesMap:   resourcesMap(),
-		DataSourcesMap: datasourcesMap(),
+		ResourcesMap:   tagResourceClients(resourcesMap()),
+		DataSourcesMap: tagResourceClients(datasourcesMap()),
 	}
 
 	p.ConfigureContextFunc = func(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -136,6 +243,28 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 	clientCertificateKeyPath := util.CheckEnvVars([]string{"JFROG_CLIENT_CERT_KEY_PATH", "ARTIFACTORY_CLIENT_CERT_KEY_PATH"}, "")
 	clientCertificatePEM := util.CheckEnvVars([]string{"JFROG_CLIENT_CERT_PEM", "ARTIFACTORY_CLIENT_CERT_PEM"}, "")
 	clientPrivateKeyPEM := util.CheckEnvVars([]string{"JFROG_CLIENT_PRIVATE_KEY_PEM", "ARTIFACTORY_CLIENT_PRIVATE_KEY_PEM"}, "")
+	caCertificatePath := util.CheckEnvVars([]string{"JFROG_CA_CERT_PATH", "ARTIFACTORY_CA_CERT_PATH"}, "")
+	caCertificatePEM := util.CheckEnvVars([]string{"JFROG_CA_CERT_PEM", "ARTIFACTORY_CA_CERT_PEM"}, "")
+	httpLogPath := util.CheckEnvVars([]string{"JFROG_HTTP_LOG_PATH", "ARTIFACTORY_HTTP_LOG_PATH"}, "")
+	disableUsageReporting := util.GetBoolEnvVar([]string{"JFROG_DISABLE_USAGE_REPORTING", "ARTIFACTORY_DISABLE_USAGE_REPORTING"}, false)
+
+	var cliConfigPath string
+	var cliServer *cliServerDetails
+	if v, ok := d.GetOk("server_id"); ok {
+		var err error
+		cliConfigPath, cliServer, err = loadCLIServerFromHome(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if cliServer.URL() != "" {
+			url = cliServer.URL()
+		}
+		if cliServer.ClientCertPath != "" {
+			clientCertificatePath = cliServer.ClientCertPath
+			clientCertificateKeyPath = cliServer.ClientCertKeyPath
+		}
+	}
 
 	if v, ok := d.GetOk("url"); ok {
 		url = v.(string)
@@ -149,21 +278,6 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		return nil, diag.FromErr(err)
 	}
 
-	if v, ok := d.GetOk("oidc_provider_name"); ok {
-		oidcAccessToken, err := util.OIDCTokenExchange(ctx, restyClient, v.(string), d.Get("tfc_credential_tag_name").(string))
-		if err != nil {
-			return nil, diag.FromErr(err)
-		}
-
-		if oidcAccessToken != "" {
-			accessToken = oidcAccessToken
-		}
-	}
-
-	if v, ok := d.GetOk("access_token"); ok && v != "" {
-		accessToken = v.(string)
-	}
-
 	if v, ok := d.GetOk("client_certificate_path"); ok {
 		clientCertificatePath = v.(string)
 	}
@@ -177,19 +291,24 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		clientPrivateKeyPEM = v.(string)
 	}
 
-	apiKey := d.Get("api_key").(string)
-
-	restyClient, err = client.AddAuth(restyClient, apiKey, accessToken)
-	if err != nil {
-		return nil, diag.FromErr(err)
+	if v, ok := d.GetOk("ca_certificate_path"); ok {
+		caCertificatePath = v.(string)
+	}
+	if v, ok := d.GetOk("ca_certificate_pem"); ok {
+		caCertificatePEM = v.(string)
 	}
 
+	// TLS and proxy settings must be in place before the first request, which may be the OIDC token exchange.
 	bypassJFrogTLSVerification := os.Getenv("JFROG_BYPASS_TLS_VERIFICATION")
 	tlsConfig, err := buildTLSConfig(tlsConfigOptions{
 		ClientCertificatePath:    clientCertificatePath,
 		ClientCertificateKeyPath: clientCertificateKeyPath,
 		ClientCertificatePEM:     clientCertificatePEM,
 		ClientPrivateKeyPEM:      clientPrivateKeyPEM,
+		CACertificatePath:        caCertificatePath,
+		CACertificatePEM:         caCertificatePEM,
+		MinTLSVersion:            d.Get("min_tls_version").(string),
+		CipherSuites:             utilsdk.CastToStringArr(d.Get("tls_cipher_suites").([]interface{})),
 		InsecureSkipVerify:       strings.ToLower(bypassJFrogTLSVerification) == "true",
 	})
 	if err != nil {
@@ -203,6 +322,98 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		restyClient.SetTLSClientConfig(tlsConfig)
 	}
 
+	if err := configureProxy(restyClient, d.Get("proxy_url").(string), d.Get("no_proxy").(string)); err != nil {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Error configuring proxy",
+			Detail:   err.Error(),
+		}}
+	}
+
+	err = configureClient(restyClient, url, clientOptions{
+		MaxRetries:            int64(d.Get("max_retries").(int)),
+		RetryMinBackoffMillis: int64(d.Get("retry_min_backoff_millis").(int)),
+		RetryMaxBackoffMillis: int64(d.Get("retry_max_backoff_millis").(int)),
+		RespectRetryAfter:     d.Get("respect_retry_after").(bool),
+		RequestTimeoutSeconds: int64(d.Get("request_timeout_seconds").(int)),
+		RequestsPerSecond:     int64(d.Get("requests_per_second").(int)),
+		MaxInFlightRequests:   int64(d.Get("max_in_flight_requests").(int)),
+	})
+	if err != nil {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Error configuring Resty client",
+			Detail:   err.Error(),
+		}}
+	}
+
+	if v, ok := d.GetOk("http_log_path"); ok {
+		httpLogPath = v.(string)
+	}
+	if err := configureHTTPLog(ctx, restyClient, httpLogPath); err != nil {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Error configuring HTTP log",
+			Detail:   err.Error(),
+		}}
+	}
+
+	// Tokens are renewed with a client without auth, so an expired token is never sent along.
+	tokenClient := restyClient.Clone()
+	var tokenRefresh tokenRefreshFunc
+	var tokenSourceKey string
+
+	if cliServer != nil && d.Get("access_token").(string) == "" {
+		cliAccessToken, err := cliServerAccessToken(restyClient, cliConfigPath, cliServer)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if cliAccessToken != "" {
+			accessToken = cliAccessToken
+		}
+
+		if cliServer.RefreshToken != "" {
+			tokenRefresh = func() (string, error) {
+				return refreshCLIAccessToken(tokenClient, cliConfigPath, cliServer)
+			}
+			tokenSourceKey = fmt.Sprintf("%s|cli|%s|%s", url, cliConfigPath, cliServer.ServerId)
+		}
+	}
+
+	if v, ok := d.GetOk("oidc_provider_name"); ok {
+		oidcProviderName := v.(string)
+		tfcCredentialTagName := d.Get("tfc_credential_tag_name").(string)
+		oidcAccessToken, err := util.OIDCTokenExchange(ctx, restyClient, oidcProviderName, tfcCredentialTagName)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if oidcAccessToken != "" {
+			accessToken = oidcAccessToken
+			tokenRefresh = func() (string, error) {
+				return util.OIDCTokenExchange(ctx, tokenClient, oidcProviderName, tfcCredentialTagName)
+			}
+			tokenSourceKey = fmt.Sprintf("%s|oidc|%s|%s", url, oidcProviderName, tfcCredentialTagName)
+		}
+	}
+
+	if v, ok := d.GetOk("access_token"); ok && v != "" {
+		accessToken = v.(string)
+		tokenRefresh = nil
+	}
+
+	apiKey := d.Get("api_key").(string)
+
+	restyClient, err = client.AddAuth(restyClient, apiKey, accessToken)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if tokenRefresh != nil {
+		configureTokenRefresh(restyClient, sharedTokenSource(tokenSourceKey, accessToken, tokenRefresh))
+	}
+
 	version, err := util.GetArtifactoryVersion(restyClient)
 	if err != nil {
 		return nil, diag.Diagnostics{{
@@ -212,11 +423,21 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		}}
 	}
 
-	featureUsage := fmt.Sprintf("Terraform/%s", terraformVersion)
-	go util.SendUsage(ctx, restyClient.R(), productId, featureUsage)
+	// The Access version is only used to check the availability of features, an older server or a
+	// token without access to the endpoint shouldn't prevent using the provider.
+	accessVersion, err := util.GetAccessVersion(restyClient)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to get Access version, features depending on it are not checked", map[string]any{"error": err.Error()})
+	}
+
+	if v := d.GetRawConfig().GetAttr("disable_usage_reporting"); v.IsKnown() && !v.IsNull() {
+		disableUsageReporting = v.True()
+	}
+	configureUsageReporting(restyClient, productId, terraformVersion, disableUsageReporting)
 
 	return util.ProviderMetadata{
 		Client:             restyClient,
 		ArtifactoryVersion: version,
+		AccessVersion:      accessVersion,
 	}, nil
 }
@@ -2,21 +2,40 @@ package provider
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"os"
 	"strings"
+
+	"github.com/samber/lo"
 )
 
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+var supportedTLSVersions = []string{"1.0", "1.1", "1.2", "1.3"}
+
 type tlsConfigOptions struct {
 	ClientCertificatePath    string
 	ClientCertificateKeyPath string
 	ClientCertificatePEM     string
 	ClientPrivateKeyPEM      string
+	CACertificatePath        string
+	CACertificatePEM         string
+	MinTLSVersion            string
+	CipherSuites             []string
 	InsecureSkipVerify       bool
 }
 
 func (o *tlsConfigOptions) normalize() {
 	o.ClientCertificatePEM = strings.TrimSpace(o.ClientCertificatePEM)
 	o.ClientPrivateKeyPEM = strings.TrimSpace(o.ClientPrivateKeyPEM)
+	o.CACertificatePEM = strings.TrimSpace(o.CACertificatePEM)
+	o.MinTLSVersion = strings.TrimSpace(o.MinTLSVersion)
 }
 
 func buildTLSConfig(opts tlsConfigOptions) (*tls.Config, error) {
@@ -29,6 +48,10 @@ func buildTLSConfig(opts tlsConfigOptions) (*tls.Config, error) {
 		return nil, fmt.Errorf("cannot configure both path-based and inline client certificate options")
 	}
 
+	if opts.CACertificatePath != "" && opts.CACertificatePEM != "" {
+		return nil, fmt.Errorf("cannot configure both path-based and inline CA certificate options")
+	}
+
 	var cert tls.Certificate
 	var haveCert bool
 
@@ -56,7 +79,43 @@ func buildTLSConfig(opts tlsConfigOptions) (*tls.Config, error) {
 		haveCert = true
 	}
 
-	if !haveCert && !opts.InsecureSkipVerify {
+	caPEM := []byte(opts.CACertificatePEM)
+	if opts.CACertificatePath != "" {
+		data, err := os.ReadFile(opts.CACertificatePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load CA certificate from path: %w", err)
+		}
+		caPEM = data
+	}
+
+	var rootCAs *x509.CertPool
+	if len(caPEM) > 0 {
+		// Custom CAs are trusted in addition to the system ones, not instead of them.
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("failed to parse CA certificate PEM data: no certificates found")
+		}
+		rootCAs = pool
+	}
+
+	var minVersion uint16
+	if opts.MinTLSVersion != "" {
+		v, ok := tlsVersions[opts.MinTLSVersion]
+		if !ok {
+			return nil, fmt.Errorf("unsupported min_tls_version '%s', must be one of: %s", opts.MinTLSVersion, strings.Join(supportedTLSVersions, ", "))
+		}
+		minVersion = v
+	}
+
+	cipherSuites, err := parseCipherSuites(opts.CipherSuites)
+	if err != nil {
+		return nil, err
+	}
+
+	if !haveCert && rootCAs == nil && minVersion == 0 && len(cipherSuites) == 0 && !opts.InsecureSkipVerify {
 		return nil, nil
 	}
 
@@ -64,9 +123,41 @@ func buildTLSConfig(opts tlsConfigOptions) (*tls.Config, error) {
 	if haveCert {
 		tlsConfig.Certificates = []tls.Certificate{cert}
 	}
+	if rootCAs != nil {
+		tlsConfig.RootCAs = rootCAs
+	}
+	if minVersion != 0 {
+		tlsConfig.MinVersion = minVersion
+	}
+	if len(cipherSuites) > 0 {
+		tlsConfig.CipherSuites = cipherSuites
+	}
 	if opts.InsecureSkipVerify {
 		tlsConfig.InsecureSkipVerify = true
 	}
 
 	return tlsConfig, nil
 }
+
+// parseCipherSuites maps IANA cipher suite names (e.g. TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256)
+// to their IDs. Only suites Go considers secure are accepted.
+func parseCipherSuites(names []string) ([]uint16, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	known := lo.SliceToMap(tls.CipherSuites(), func(s *tls.CipherSuite) (string, uint16) {
+		return s.Name, s.ID
+	})
+
+	ids := make([]uint16, 0, len(names))
+	for _, name := range names {
+		id, ok := known[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported TLS cipher suite '%s'", name)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"crypto/tls"
 	"os"
 	"path/filepath"
 	"strings"
@@ -142,3 +143,91 @@ func TestBuildTLSConfigReturnsNilWhenNoOptions(t *testing.T) {
 		t.Fatalf("expected nil config, got %#v", cfg)
 	}
 }
+
+func TestBuildTLSConfigWithCAPEM(t *testing.T) {
+	cfg, err := buildTLSConfig(tlsConfigOptions{
+		CACertificatePEM: testClientCertificatePEM,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if cfg == nil || cfg.RootCAs == nil {
+		t.Fatal("expected TLS config with root CAs when CA PEM is provided")
+	}
+	if len(cfg.Certificates) != 0 {
+		t.Fatalf("expected no client certificates, got %d", len(cfg.Certificates))
+	}
+}
+
+func TestBuildTLSConfigWithCAPath(t *testing.T) {
+	caPath := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caPath, []byte(testClientCertificatePEM), 0o600); err != nil {
+		t.Fatalf("failed writing CA file: %s", err)
+	}
+
+	cfg, err := buildTLSConfig(tlsConfigOptions{
+		CACertificatePath: caPath,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if cfg == nil || cfg.RootCAs == nil {
+		t.Fatal("expected TLS config with root CAs when CA path is provided")
+	}
+}
+
+func TestBuildTLSConfigConflictingCAOptions(t *testing.T) {
+	_, err := buildTLSConfig(tlsConfigOptions{
+		CACertificatePath: "ca.pem",
+		CACertificatePEM:  testClientCertificatePEM,
+	})
+	if err == nil || !strings.Contains(err.Error(), "cannot configure both path-based and inline CA") {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+}
+
+func TestBuildTLSConfigInvalidCAPEM(t *testing.T) {
+	_, err := buildTLSConfig(tlsConfigOptions{
+		CACertificatePEM: "not a certificate",
+	})
+	if err == nil || !strings.Contains(err.Error(), "no certificates found") {
+		t.Fatalf("expected parse error, got %v", err)
+	}
+}
+
+func TestBuildTLSConfigMinVersionAndCipherSuites(t *testing.T) {
+	cfg, err := buildTLSConfig(tlsConfigOptions{
+		MinTLSVersion: "1.2",
+		CipherSuites:  []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if cfg == nil {
+		t.Fatal("expected TLS config when TLS version options are provided")
+	}
+	if cfg.MinVersion != tls.VersionTLS12 {
+		t.Fatalf("expected min version TLS 1.2, got %x", cfg.MinVersion)
+	}
+	if len(cfg.CipherSuites) != 1 || cfg.CipherSuites[0] != tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256 {
+		t.Fatalf("unexpected cipher suites: %v", cfg.CipherSuites)
+	}
+}
+
+func TestBuildTLSConfigInvalidMinVersion(t *testing.T) {
+	_, err := buildTLSConfig(tlsConfigOptions{
+		MinTLSVersion: "1.4",
+	})
+	if err == nil || !strings.Contains(err.Error(), "unsupported min_tls_version") {
+		t.Fatalf("expected version error, got %v", err)
+	}
+}
+
+func TestBuildTLSConfigInvalidCipherSuite(t *testing.T) {
+	_, err := buildTLSConfig(tlsConfigOptions{
+		CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "unsupported TLS cipher suite") {
+		t.Fatalf("expected cipher suite error, got %v", err)
+	}
+}
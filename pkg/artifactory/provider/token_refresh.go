@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	// Tokens expiring within this window are refreshed ahead of time so they
+	// don't expire in the middle of a request.
+	tokenExpiryLeeway = 5 * time.Minute
+	// A token rejected with 401 is only refreshed if it wasn't just refreshed, so a token that
+	// is refused for another reason doesn't trigger a refresh on every request.
+	minTokenRefreshInterval = 30 * time.Second
+)
+
+// tokenExpiry returns the expiry time from the `exp` claim of a JWT access token. Reference tokens
+// and tokens without an `exp` claim have no known expiry.
+func tokenExpiry(accessToken string) (time.Time, bool) {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(claims.Exp, 0), true
+}
+
+// tokenExpired reports whether the access token expires within tokenExpiryLeeway.
+// Tokens whose expiry can't be determined are assumed valid.
+func tokenExpired(accessToken string, now time.Time) bool {
+	expiry, ok := tokenExpiry(accessToken)
+	return ok && now.Add(tokenExpiryLeeway).After(expiry)
+}
+
+type tokenRefreshFunc func() (string, error)
+
+// tokenSource holds the access token used by the provider and renews it when it is about to
+// expire or gets rejected with 401.
+type tokenSource struct {
+	mu          sync.Mutex
+	token       string
+	issued      map[string]bool
+	refreshedAt time.Time
+	refresh     tokenRefreshFunc
+}
+
+func newTokenSource(token string, refresh tokenRefreshFunc) *tokenSource {
+	return &tokenSource{
+		token:       token,
+		issued:      map[string]bool{token: true},
+		refreshedAt: time.Now(),
+		refresh:     refresh,
+	}
+}
+
+func (s *tokenSource) refreshLocked() error {
+	token, err := s.refresh()
+	if err != nil {
+		return fmt.Errorf("failed to refresh access token: %w", err)
+	}
+	if token == "" {
+		return fmt.Errorf("failed to refresh access token: empty token returned")
+	}
+
+	s.token = token
+	s.issued[token] = true
+	s.refreshedAt = time.Now()
+
+	return nil
+}
+
+// Token returns the current access token, refreshing it first if it is about to expire. A failed
+// refresh is only an error once the current token has actually expired.
+func (s *tokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if tokenExpired(s.token, now) {
+		if err := s.refreshLocked(); err != nil {
+			if expiry, _ := tokenExpiry(s.token); now.After(expiry) {
+				return "", err
+			}
+		}
+	}
+
+	return s.token, nil
+}
+
+// refreshAfterUnauthorized refreshes the token after it was rejected with 401 and reports whether
+// the request should be retried with the new token.
+func (s *tokenSource) refreshAfterUnauthorized(rejected string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.issued[rejected] {
+		return false
+	}
+
+	// Already refreshed by a concurrent request.
+	if rejected != s.token {
+		return true
+	}
+
+	if time.Since(s.refreshedAt) < minTokenRefreshInterval {
+		return false
+	}
+
+	return s.refreshLocked() == nil
+}
+
+func (s *tokenSource) isIssued(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.issued[token]
+}
+
+// setAuthToken is a Resty request middleware setting the current token on each attempt. Tokens set
+// explicitly on a request, e.g. for a federated repository member, are left untouched.
+func (s *tokenSource) setAuthToken(_ *resty.Client, r *resty.Request) error {
+	if r.Token != "" && !s.isIssued(r.Token) {
+		return nil
+	}
+
+	token, err := s.Token()
+	if err != nil {
+		return err
+	}
+	r.SetAuthToken(token)
+
+	return nil
+}
+
+func (s *tokenSource) retryOnUnauthorized(response *resty.Response, err error) bool {
+	if err != nil || response == nil || response.StatusCode() != http.StatusUnauthorized {
+		return false
+	}
+
+	return s.refreshAfterUnauthorized(response.Request.Token)
+}
+
+var (
+	tokenSourcesMu sync.Mutex
+	tokenSources   = map[string]*tokenSource{}
+)
+
+// sharedTokenSource returns the token source for the given key, creating it with the token if needed.
+// The SDKv2 and framework providers each build their own client, so the source is shared for the
+// token to be refreshed once for the provider as a whole.
+func sharedTokenSource(key, token string, refresh tokenRefreshFunc) *tokenSource {
+	tokenSourcesMu.Lock()
+	defer tokenSourcesMu.Unlock()
+
+	if source, ok := tokenSources[key]; ok {
+		return source
+	}
+
+	source := newTokenSource(token, refresh)
+	tokenSources[key] = source
+
+	return source
+}
+
+// configureTokenRefresh makes the Resty client use the token from the source for every request and
+// retry requests rejected with 401 once the token has been refreshed.
+func configureTokenRefresh(restyClient *resty.Client, source *tokenSource) {
+	restyClient.OnBeforeRequest(source.setAuthToken)
+
+	// Resty uses the result of the last retry condition evaluated, so the 401 check goes first to
+	// leave the conditions added by configureClient in charge of all other responses.
+	restyClient.RetryConditions = append([]resty.RetryConditionFunc{source.retryOnUnauthorized}, restyClient.RetryConditions...)
+}
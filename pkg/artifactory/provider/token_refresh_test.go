@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestTokenExpired(t *testing.T) {
+	now := time.Now()
+
+	if tokenExpired(testJWT(now.Add(time.Hour)), now) {
+		t.Fatal("expected token valid for an hour to not be expired")
+	}
+	if !tokenExpired(testJWT(now.Add(-time.Minute)), now) {
+		t.Fatal("expected token expired a minute ago to be expired")
+	}
+	if !tokenExpired(testJWT(now.Add(time.Minute)), now) {
+		t.Fatal("expected token expiring within the leeway to be expired")
+	}
+	if tokenExpired("reference-token", now) {
+		t.Fatal("expected non JWT token to be assumed valid")
+	}
+}
+
+func TestTokenSourceRefreshesExpiredToken(t *testing.T) {
+	newToken := testJWT(time.Now().Add(time.Hour))
+	source := newTokenSource(testJWT(time.Now().Add(time.Minute)), func() (string, error) {
+		return newToken, nil
+	})
+
+	token, err := source.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token != newToken {
+		t.Fatal("expected token about to expire to be refreshed")
+	}
+}
+
+func TestTokenSourceKeepsValidTokenWhenRefreshFails(t *testing.T) {
+	currentToken := testJWT(time.Now().Add(time.Minute))
+	source := newTokenSource(currentToken, func() (string, error) {
+		return "", fmt.Errorf("exchange failed")
+	})
+
+	token, err := source.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token != currentToken {
+		t.Fatal("expected current token to be used until it expires")
+	}
+
+	source = newTokenSource(testJWT(time.Now().Add(-time.Minute)), func() (string, error) {
+		return "", fmt.Errorf("exchange failed")
+	})
+	if _, err := source.Token(); err == nil {
+		t.Fatal("expected error when the token has expired and can't be refreshed")
+	}
+}
+
+func TestConfigureTokenRefreshRetriesOnUnauthorized(t *testing.T) {
+	var refreshes, attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		if r.Header.Get("Authorization") != "Bearer renewed-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	source := newTokenSource("revoked-token", func() (string, error) {
+		refreshes.Add(1)
+		return "renewed-token", nil
+	})
+	// Pretend the token was issued a while ago so it can be refreshed after a 401.
+	source.refreshedAt = time.Now().Add(-time.Hour)
+
+	opts := defaultClientOptions()
+	opts.RetryMinBackoffMillis = 1
+	opts.RetryMaxBackoffMillis = 1
+
+	restyClient := resty.New().SetBaseURL(server.URL).SetAuthToken("revoked-token")
+	if err := configureClient(restyClient, server.URL, opts); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	configureTokenRefresh(restyClient, source)
+
+	resp, err := restyClient.R().Get("/")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode())
+	}
+	if attempts.Load() != 2 || refreshes.Load() != 1 {
+		t.Fatalf("expected 2 attempts and 1 refresh, got %d attempts and %d refreshes", attempts.Load(), refreshes.Load())
+	}
+
+	// A token that keeps being rejected right after a refresh is not refreshed again.
+	source.refresh = func() (string, error) {
+		refreshes.Add(1)
+		return "another-token", nil
+	}
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	resp, err = restyClient.R().Get("/")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode() != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %d", resp.StatusCode())
+	}
+	if refreshes.Load() != 1 {
+		t.Fatalf("expected no further refresh, got %d refreshes", refreshes.Load())
+	}
+}
+
+func TestConfigureTokenRefreshKeepsExplicitToken(t *testing.T) {
+	var authorization atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization.Store(r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+
+	restyClient := resty.New().SetBaseURL(server.URL)
+	configureTokenRefresh(restyClient, newTokenSource("provider-token", func() (string, error) {
+		return "renewed-token", nil
+	}))
+
+	if _, err := restyClient.R().SetAuthToken("member-token").Get("/"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if authorization.Load() != "Bearer member-token" {
+		t.Fatalf("expected explicit token to be used, got %v", authorization.Load())
+	}
+
+	if _, err := restyClient.R().Get("/"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if authorization.Load() != "Bearer provider-token" {
+		t.Fatalf("expected provider token to be used, got %v", authorization.Load())
+	}
+}
+
+func TestSharedTokenSource(t *testing.T) {
+	first := sharedTokenSource("https://example.jfrog.io|oidc|test", "first", nil)
+	second := sharedTokenSource("https://example.jfrog.io|oidc|test", "second", nil)
+
+	if first != second {
+		t.Fatal("expected the same token source for the same key")
+	}
+}
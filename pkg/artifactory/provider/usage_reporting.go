@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// configureUsageReporting starts collecting the provider usage, reported once on shutdown, unless
+// usage reporting is disabled.
+func configureUsageReporting(restyClient *resty.Client, productId, terraformVersion string, disabled bool) {
+	if disabled {
+		return
+	}
+
+	usage.Enable(restyClient, productId)
+	usage.Record(restyClient, productId, fmt.Sprintf("Terraform/%s", terraformVersion))
+}
+
+// addUsageReporting records the operations of the SDKv2 resources and data sources.
+func addUsageReporting(productId string, resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for name, resource := range resources {
+		if resource.Create != nil || resource.Read != nil || resource.Update != nil || resource.Delete != nil {
+			panic(fmt.Sprintf("[%s] deprecated CRUD function in use", name))
+		}
+
+		if resource.CreateContext != nil {
+			resource.CreateContext = recordUsage(productId, name, "CREATE", resource.CreateContext)
+		}
+		if resource.ReadContext != nil {
+			resource.ReadContext = recordUsage(productId, name, "READ", resource.ReadContext)
+		}
+		if resource.UpdateContext != nil {
+			resource.UpdateContext = recordUsage(productId, name, "UPDATE", resource.UpdateContext)
+		}
+		if resource.DeleteContext != nil {
+			resource.DeleteContext = recordUsage(productId, name, "DELETE", resource.DeleteContext)
+		}
+	}
+
+	return resources
+}
+
+func recordUsage(productId, name, method string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if m, ok := meta.(util.ProviderMetadata); ok {
+			usage.RecordResource(m.Client, productId, name, method)
+		}
+
+		return f(ctx, d, meta)
+	}
+}
@@ -32,6 +32,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 )
@@ -224,11 +226,11 @@ func (r *ArtifactResource) Configure(ctx context.Context, req resource.Configure
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), r.TypeName)
 }
 
 func (r *ArtifactResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ArtifactResourceModel
 	// Read Terraform plan data into the model
@@ -285,7 +287,7 @@ func (r *ArtifactResource) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *ArtifactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ArtifactResourceModel
 
@@ -332,7 +334,7 @@ func (r *ArtifactResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *ArtifactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ArtifactResourceModel
 	// Read Terraform plan data into the model
@@ -389,7 +391,7 @@ func (r *ArtifactResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *ArtifactResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ArtifactResourceModel
 
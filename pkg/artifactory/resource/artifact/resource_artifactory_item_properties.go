@@ -32,9 +32,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
@@ -43,6 +46,13 @@ import (
 
 const itemPropertiesEndpoint = "/artifactory/api/storage/{repo_key}"
 
+const (
+	itemPropertiesModeAuthoritative = "authoritative"
+	itemPropertiesModeAdditive      = "additive"
+)
+
+var itemPropertiesModes = []string{itemPropertiesModeAuthoritative, itemPropertiesModeAdditive}
+
 func NewItemPropertiesResource() resource.Resource {
 	return &ItemPropertiesResource{
 		TypeName: "artifactory_item_properties",
@@ -59,6 +69,11 @@ type ItemPropertiesResourceModel struct {
 	ItemPath    types.String `tfsdk:"item_path"`
 	Properties  types.Map    `tfsdk:"properties"`
 	IsRecursive types.Bool   `tfsdk:"is_recursive"`
+	Mode        types.String `tfsdk:"mode"`
+}
+
+func (r ItemPropertiesResourceModel) isAdditive() bool {
+	return r.Mode.ValueString() == itemPropertiesModeAdditive
 }
 
 func (r *ItemPropertiesResourceModel) toPropertiesQueryParamsString(ctx context.Context, params *string) diag.Diagnostics {
@@ -87,8 +102,20 @@ func (r *ItemPropertiesResourceModel) toPropertiesQueryParamsString(ctx context.
 }
 
 func (r *ItemPropertiesResourceModel) fromAPIModel(ctx context.Context, apiModel ItemPropertiesGetAPIModel) (ds diag.Diagnostics) {
+	if r.Mode.IsNull() {
+		r.Mode = types.StringValue(itemPropertiesModeAuthoritative)
+	}
+
+	properties := apiModel.Properties
+	// In additive mode, only keys already tracked in state are managed by this resource.
+	// Other keys (e.g. added by CI tools) are ignored for drift detection.
+	if r.isAdditive() {
+		managedKeys := lo.Keys(r.Properties.Elements())
+		properties = lo.PickByKeys(properties, managedKeys)
+	}
+
 	attrValues := lo.MapEntries(
-		apiModel.Properties,
+		properties,
 		func(k string, v []string) (string, attr.Value) {
 			valueSet, d := types.SetValueFrom(ctx, types.StringType, v)
 			if d.HasError() {
@@ -189,6 +216,15 @@ func (r *ItemPropertiesResource) Schema(ctx context.Context, req resource.Schema
 				Default:             booldefault.StaticBool(false),
 				MarkdownDescription: "Add this property to the selected folder and to all of artifacts and folders under this folder. Default to `false`",
 			},
+			"mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(itemPropertiesModeAuthoritative),
+				Validators: []validator.String{
+					stringvalidator.OneOf(itemPropertiesModes...),
+				},
+				MarkdownDescription: "How properties on the item are managed. `authoritative` treats every property on the item as owned by this resource: properties not listed in `properties` are reported as drift and removed on apply. `additive` only manages the keys listed in `properties`: other keys are ignored and only the listed keys are removed on destroy. Default to `authoritative`.",
+			},
 		},
 		MarkdownDescription: "Provides a resource for managaing item (file, folder, or repository) properties. When a folder is used property attachment is recursive by default. See [JFrog documentation](https://jfrog.com/help/r/jfrog-artifactory-documentation/working-with-jfrog-properties) for more details.",
 	}
@@ -199,11 +235,11 @@ func (r *ItemPropertiesResource) Configure(ctx context.Context, req resource.Con
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), r.TypeName)
 }
 
 func (r *ItemPropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ItemPropertiesResourceModel
 	// Read Terraform plan data into the model
@@ -247,7 +283,7 @@ func (r *ItemPropertiesResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *ItemPropertiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ItemPropertiesResourceModel
 
@@ -288,7 +324,7 @@ func (r *ItemPropertiesResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *ItemPropertiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ItemPropertiesResourceModel
 	// Read Terraform plan data into the model
@@ -320,6 +356,11 @@ func (r *ItemPropertiesResource) Update(ctx context.Context, req resource.Update
 		lo.Keys(planProperties),
 		lo.Keys(stateProperties),
 	)
+	// Keys from a state that wasn't in additive mode, e.g. after an import, were not all set by this resource
+	// so switching to additive mode leaves them in place.
+	if plan.isAdditive() && !state.isAdditive() {
+		propKeysToRemove = nil
+	}
 
 	props := lo.MapEntries(
 		planProperties,
@@ -364,7 +405,7 @@ func (r *ItemPropertiesResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *ItemPropertiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ItemPropertiesResourceModel
 
@@ -211,6 +211,118 @@ func TestAccItemProperties_repo_with_path(t *testing.T) {
 	})
 }
 
+func setItemProperty(t *testing.T, repoKey, path, key, value string) {
+	restyClient := acctest.GetTestResty(t)
+	response, err := restyClient.R().
+		SetRawPathParams(map[string]string{
+			"repo_key": repoKey,
+			"path":     path,
+		}).
+		SetQueryParam("properties", fmt.Sprintf("%s=%s", key, value)).
+		Put("artifactory/api/storage/{repo_key}/{path}")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if response.IsError() {
+		t.Error(response.String())
+	}
+}
+
+func TestAccItemProperties_additive_mode(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-item-properties-", "artifactory_item_properties")
+	_, _, repoName := testutil.MkNames("test-generic-local", "artifactory_local_generic_repository")
+
+	temp := `
+	resource "artifactory_item_properties" "{{ .name }}" {
+		repo_key = "{{ .repoName }}"
+		item_path = "foo/bar"
+		properties = {
+			"key1" = ["value1", "value2"],
+		}
+		mode = "additive"
+	}`
+
+	testData := map[string]string{
+		"name":     name,
+		"repoName": repoName,
+	}
+	config := util.ExecuteTemplate(name, temp, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+
+			acctest.CreateRepo(t, repoName, "local", "generic", false, false)
+			createRepoPath(t, repoName, "foo/bar")
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6MuxProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			defer acctest.DeleteRepo(t, repoName)
+
+			restyClient := acctest.GetTestResty(t)
+			var properties struct {
+				Properties map[string][]string `json:"properties"`
+			}
+			response, err := restyClient.R().
+				SetRawPathParams(map[string]string{
+					"repo_key": repoName,
+					"path":     "foo/bar",
+				}).
+				SetQueryParam("properties", "").
+				SetResult(&properties).
+				Get("artifactory/api/storage/{repo_key}/{path}")
+			if err != nil {
+				return err
+			}
+			if response.IsError() {
+				return fmt.Errorf("failed to get item properties: %s", response.String())
+			}
+
+			if _, ok := properties.Properties["key1"]; ok {
+				return fmt.Errorf("expected managed property 'key1' to be removed")
+			}
+			if _, ok := properties.Properties["build.name"]; !ok {
+				return fmt.Errorf("expected unmanaged property 'build.name' to be kept")
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "mode", "additive"),
+					resource.TestCheckResourceAttr(fqrn, "properties.%", "1"),
+					resource.TestCheckResourceAttr(fqrn, "properties.key1.#", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					setItemProperty(t, repoName, "foo/bar", "build.name", "ci")
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				ResourceName:       fqrn,
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s:foo/bar", repoName),
+				ImportStatePersist: true,
+				Config:             config,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "mode", "additive"),
+					resource.TestCheckResourceAttr(fqrn, "properties.%", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccItemProperties_invalid_property_key_character(t *testing.T) {
 	invalidChars := []string{")", "(", "}", "{", "]", "[", "*", "+", "^", "$", "/", "~", "`", "!", "@", "#", "%", "&", "<", ">", ";", "=", ",", "±", "§", " "}
 	for _, invalidChar := range invalidChars {
@@ -0,0 +1,187 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const (
+	BuildInfoEndpoint      = "artifactory/api/build/{name}/{number}"
+	BuildRunsEndpoint      = "artifactory/api/build/{name}"
+	BuildPromotionEndpoint = "artifactory/api/build/promote/{name}/{number}"
+	BuildRetentionEndpoint = "artifactory/api/build/retention/{name}"
+
+	// BuildTimestampLayout is the timestamp format of the build-info API
+	BuildTimestampLayout = "2006-01-02T15:04:05.000-0700"
+)
+
+type BuildInfoAPIModel struct {
+	Name       string                `json:"name"`
+	Number     string                `json:"number"`
+	Started    string                `json:"started"`
+	URL        string                `json:"url"`
+	Modules    []BuildModuleAPIModel `json:"modules"`
+	Properties map[string]string     `json:"properties"`
+	Statuses   []BuildStatusAPIModel `json:"statuses"`
+}
+
+type BuildModuleAPIModel struct {
+	ID           string                    `json:"id"`
+	Type         string                    `json:"type"`
+	Artifacts    []BuildArtifactAPIModel   `json:"artifacts"`
+	Dependencies []BuildDependencyAPIModel `json:"dependencies"`
+}
+
+type BuildArtifactAPIModel struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Path   string `json:"path"`
+	SHA1   string `json:"sha1"`
+	SHA256 string `json:"sha256"`
+	MD5    string `json:"md5"`
+}
+
+type BuildDependencyAPIModel struct {
+	ID     string   `json:"id"`
+	Type   string   `json:"type"`
+	SHA1   string   `json:"sha1"`
+	SHA256 string   `json:"sha256"`
+	MD5    string   `json:"md5"`
+	Scopes []string `json:"scopes"`
+}
+
+type BuildStatusAPIModel struct {
+	Status     string `json:"status"`
+	Comment    string `json:"comment"`
+	Repository string `json:"repository"`
+	Timestamp  string `json:"timestamp"`
+	User       string `json:"user"`
+	CIUser     string `json:"ciUser"`
+}
+
+type buildInfoResponseAPIModel struct {
+	BuildInfo BuildInfoAPIModel `json:"buildInfo"`
+}
+
+type buildRunsResponseAPIModel struct {
+	BuildsNumbers []struct {
+		URI     string `json:"uri"`
+		Started string `json:"started"`
+	} `json:"buildsNumbers"`
+}
+
+// envPropertyPrefix prefixes the build properties holding the environment variables captured by the build tool.
+const envPropertyPrefix = "buildInfo.env."
+
+// EnvVars returns the environment variables captured in the build-info properties.
+func (m BuildInfoAPIModel) EnvVars() map[string]string {
+	envVars := map[string]string{}
+	for k, v := range m.Properties {
+		if name, ok := strings.CutPrefix(k, envPropertyPrefix); ok {
+			envVars[name] = v
+		}
+	}
+
+	return envVars
+}
+
+// CustomProperties returns the build-info properties that are not environment variables.
+func (m BuildInfoAPIModel) CustomProperties() map[string]string {
+	properties := map[string]string{}
+	for k, v := range m.Properties {
+		if !strings.HasPrefix(k, envPropertyPrefix) {
+			properties[k] = v
+		}
+	}
+
+	return properties
+}
+
+func buildRequest(client *resty.Client, projectKey string) *resty.Request {
+	request := client.R()
+	if projectKey != "" {
+		request.SetQueryParam("project", projectKey)
+	}
+
+	return request
+}
+
+// GetBuildInfo returns the build-info of a build run, or nil if it doesn't exist.
+func GetBuildInfo(client *resty.Client, name, number, projectKey string) (*BuildInfoAPIModel, error) {
+	var result buildInfoResponseAPIModel
+	var jfrogErrors util.JFrogErrors
+	response, err := buildRequest(client, projectKey).
+		SetPathParams(map[string]string{
+			"name":   name,
+			"number": number,
+		}).
+		SetResult(&result).
+		SetError(&jfrogErrors).
+		Get(BuildInfoEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+	if response.IsError() {
+		return nil, fmt.Errorf("failed to get build %s number %s: %s", name, number, jfrogErrors.String())
+	}
+
+	return &result.BuildInfo, nil
+}
+
+// GetLatestBuildNumber returns the number of the most recently started run of a build.
+func GetLatestBuildNumber(client *resty.Client, name, projectKey string) (string, error) {
+	var result buildRunsResponseAPIModel
+	var jfrogErrors util.JFrogErrors
+	response, err := buildRequest(client, projectKey).
+		SetPathParam("name", name).
+		SetResult(&result).
+		SetError(&jfrogErrors).
+		Get(BuildRunsEndpoint)
+	if err != nil {
+		return "", err
+	}
+	if response.StatusCode() == http.StatusNotFound || (!response.IsError() && len(result.BuildsNumbers) == 0) {
+		return "", fmt.Errorf("build %s not found", name)
+	}
+	if response.IsError() {
+		return "", fmt.Errorf("failed to get runs of build %s: %s", name, jfrogErrors.String())
+	}
+
+	var latestNumber string
+	var latestStarted time.Time
+	for _, run := range result.BuildsNumbers {
+		started, err := time.Parse(BuildTimestampLayout, run.Started)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse start time of build %s%s: %w", name, run.URI, err)
+		}
+
+		if latestNumber == "" || started.After(latestStarted) {
+			latestNumber = strings.TrimPrefix(run.URI, "/")
+			latestStarted = started
+		}
+	}
+
+	return latestNumber, nil
+}
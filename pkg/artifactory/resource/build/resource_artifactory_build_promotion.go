@@ -0,0 +1,340 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+var _ resource.Resource = &BuildPromotionResource{}
+
+func NewBuildPromotionResource() resource.Resource {
+	return &BuildPromotionResource{
+		TypeName: "artifactory_build_promotion",
+	}
+}
+
+type BuildPromotionResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type BuildPromotionResourceModel struct {
+	BuildName           types.String `tfsdk:"build_name"`
+	BuildNumber         types.String `tfsdk:"build_number"`
+	ProjectKey          types.String `tfsdk:"project_key"`
+	SourceRepo          types.String `tfsdk:"source_repo"`
+	TargetRepo          types.String `tfsdk:"target_repo"`
+	Status              types.String `tfsdk:"status"`
+	Comment             types.String `tfsdk:"comment"`
+	Copy                types.Bool   `tfsdk:"copy"`
+	IncludeDependencies types.Bool   `tfsdk:"include_dependencies"`
+	Scopes              types.Set    `tfsdk:"scopes"`
+	FailFast            types.Bool   `tfsdk:"fail_fast"`
+	Timestamp           types.String `tfsdk:"timestamp"`
+}
+
+func (m BuildPromotionResourceModel) toAPIModel(ctx context.Context, timestamp string, apiModel *BuildPromotionAPIModel) (diags diag.Diagnostics) {
+	var scopes []string
+	diags.Append(m.Scopes.ElementsAs(ctx, &scopes, false)...)
+
+	*apiModel = BuildPromotionAPIModel{
+		Status:       m.Status.ValueString(),
+		Comment:      m.Comment.ValueString(),
+		Timestamp:    timestamp,
+		SourceRepo:   m.SourceRepo.ValueString(),
+		TargetRepo:   m.TargetRepo.ValueString(),
+		Copy:         m.Copy.ValueBool(),
+		Artifacts:    true,
+		Dependencies: m.IncludeDependencies.ValueBool(),
+		Scopes:       scopes,
+		FailFast:     m.FailFast.ValueBool(),
+		DryRun:       false,
+	}
+
+	return
+}
+
+type BuildPromotionAPIModel struct {
+	Status       string   `json:"status,omitempty"`
+	Comment      string   `json:"comment,omitempty"`
+	Timestamp    string   `json:"timestamp"`
+	SourceRepo   string   `json:"sourceRepo,omitempty"`
+	TargetRepo   string   `json:"targetRepo"`
+	Copy         bool     `json:"copy"`
+	Artifacts    bool     `json:"artifacts"`
+	Dependencies bool     `json:"dependencies"`
+	Scopes       []string `json:"scopes,omitempty"`
+	FailFast     bool     `json:"failFast"`
+	DryRun       bool     `json:"dryRun"`
+}
+
+type BuildPromotionResultAPIModel struct {
+	Messages []struct {
+		Level   string `json:"level"`
+		Message string `json:"message"`
+	} `json:"messages"`
+}
+
+// errorMessages returns the messages reported at ERROR level. Artifactory answers a partially failed
+// promotion with 200 when fail_fast is disabled, so the messages are the only way to detect it.
+func (m BuildPromotionResultAPIModel) errorMessages() []string {
+	var messages []string
+	for _, message := range m.Messages {
+		if strings.EqualFold(message.Level, "error") {
+			messages = append(messages, message.Message)
+		}
+	}
+
+	return messages
+}
+
+func (r *BuildPromotionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *BuildPromotionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"build_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the build to promote.",
+			},
+			"build_number": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Number of the build run to promote.",
+			},
+			"project_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Project key the build belongs to.",
+			},
+			"source_repo": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Only promote artifacts located in this repository. When not set, artifacts are promoted from every repository they are found in.",
+			},
+			"target_repo": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Repository the build artifacts are copied or moved to.",
+			},
+			"status": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Promotion status recorded on the build, e.g. `staged` or `released`.",
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Comment recorded with the promotion status.",
+			},
+			"copy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Copy the artifacts to `target_repo` instead of moving them. Default value is `false`.",
+			},
+			"include_dependencies": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Also promote the build dependencies. Default value is `false`.",
+			},
+			"scopes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Only promote dependencies with one of these scopes. Requires `include_dependencies`.",
+			},
+			"fail_fast": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Stop the promotion on the first error. Default value is `true`.",
+			},
+			"timestamp": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Timestamp of the promotion.",
+			},
+		},
+		MarkdownDescription: "Promotes a build run by copying or moving its artifacts to a target repository and recording a promotion status on the build. " +
+			"Promotions cannot be reverted: destroying this resource only removes it from the Terraform state. " +
+			"For more information, see [JFrog documentation](https://jfrog.com/help/r/jfrog-rest-apis/build-promotion).",
+	}
+}
+
+func (r *BuildPromotionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), r.TypeName)
+}
+
+func (r *BuildPromotionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
+
+	var plan BuildPromotionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timestamp := time.Now().Format(BuildTimestampLayout)
+
+	var promotion BuildPromotionAPIModel
+	resp.Diagnostics.Append(plan.toAPIModel(ctx, timestamp, &promotion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result BuildPromotionResultAPIModel
+	response, err := buildRequest(r.ProviderData.Client, plan.ProjectKey.ValueString()).
+		SetPathParams(map[string]string{
+			"name":   plan.BuildName.ValueString(),
+			"number": plan.BuildNumber.ValueString(),
+		}).
+		SetBody(promotion).
+		SetResult(&result).
+		SetError(&result).
+		Post(BuildPromotionEndpoint)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, response.String())
+		return
+	}
+
+	if messages := result.errorMessages(); len(messages) > 0 {
+		utilfw.UnableToCreateResourceError(resp, fmt.Sprintf("promotion of build %s number %s: %s", plan.BuildName.ValueString(), plan.BuildNumber.ValueString(), strings.Join(messages, "; ")))
+		return
+	}
+
+	plan.Timestamp = types.StringValue(timestamp)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *BuildPromotionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
+
+	var state BuildPromotionResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buildInfo, err := GetBuildInfo(r.ProviderData.Client, state.BuildName.ValueString(), state.BuildNumber.ValueString(), state.ProjectKey.ValueString())
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	// The promotion is gone along with the build run it was recorded on.
+	if buildInfo == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+func (r *BuildPromotionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddWarning(
+		"Update not supported",
+		"Build promotion cannot be updated.",
+	)
+}
+
+func (r *BuildPromotionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
+
+	// Artifactory has no API to revert a promotion, so the resource is only removed from the state.
+}
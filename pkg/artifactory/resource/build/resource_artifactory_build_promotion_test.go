@@ -0,0 +1,81 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccBuildPromotion_full(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("test-build-promotion", "artifactory_build_promotion")
+
+	buildName := fmt.Sprintf("test-build-%d", testutil.RandomInt())
+	targetRepo := fmt.Sprintf("test-repo-%d", testutil.RandomInt())
+
+	const template = `
+	resource "artifactory_build_promotion" "{{ .name }}" {
+		build_name   = "{{ .build_name }}"
+		build_number = "1"
+		target_repo  = "{{ .target_repo }}"
+		status       = "released"
+		comment      = "promoted by Terraform"
+		copy         = true
+	}`
+
+	config := util.ExecuteTemplate("TestAccBuildPromotion_full", template, map[string]string{
+		"name":        resourceName,
+		"build_name":  buildName,
+		"target_repo": targetRepo,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateRepo(t, targetRepo, "local", "maven", true, true)
+			acctest.CreateBuild(t, buildName, "1", "", nil)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			acctest.DeleteBuild(t, buildName, "")
+			acctest.DeleteRepo(t, targetRepo)
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "build_name", buildName),
+					resource.TestCheckResourceAttr(fqrn, "status", "released"),
+					resource.TestCheckResourceAttr(fqrn, "copy", "true"),
+					resource.TestCheckResourceAttr(fqrn, "include_dependencies", "false"),
+					resource.TestCheckResourceAttr(fqrn, "fail_fast", "true"),
+					resource.TestCheckResourceAttrSet(fqrn, "timestamp"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,254 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+var _ resource.Resource = &BuildRetentionPolicyResource{}
+
+func NewBuildRetentionPolicyResource() resource.Resource {
+	return &BuildRetentionPolicyResource{
+		TypeName: "artifactory_build_retention_policy",
+	}
+}
+
+type BuildRetentionPolicyResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type BuildRetentionPolicyResourceModel struct {
+	BuildName            types.String `tfsdk:"build_name"`
+	ProjectKey           types.String `tfsdk:"project_key"`
+	MaxBuilds            types.Int64  `tfsdk:"max_builds"`
+	MaxDays              types.Int64  `tfsdk:"max_days"`
+	DeleteArtifacts      types.Bool   `tfsdk:"delete_artifacts"`
+	ExcludedBuildNumbers types.Set    `tfsdk:"excluded_build_numbers"`
+	RunTrigger           types.String `tfsdk:"run_trigger"`
+}
+
+func (m BuildRetentionPolicyResourceModel) toAPIModel(ctx context.Context, apiModel *BuildRetentionAPIModel) (diags diag.Diagnostics) {
+	var excludedBuildNumbers []string
+	diags.Append(m.ExcludedBuildNumbers.ElementsAs(ctx, &excludedBuildNumbers, false)...)
+
+	*apiModel = BuildRetentionAPIModel{
+		DeleteBuildArtifacts:         m.DeleteArtifacts.ValueBool(),
+		Count:                        m.MaxBuilds.ValueInt64Pointer(),
+		Days:                         m.MaxDays.ValueInt64Pointer(),
+		BuildNumbersNotToBeDiscarded: excludedBuildNumbers,
+	}
+
+	return
+}
+
+// retentionChanged reports whether any of the retention settings differs from the other model.
+func (m BuildRetentionPolicyResourceModel) retentionChanged(other BuildRetentionPolicyResourceModel) bool {
+	return !m.MaxBuilds.Equal(other.MaxBuilds) ||
+		!m.MaxDays.Equal(other.MaxDays) ||
+		!m.DeleteArtifacts.Equal(other.DeleteArtifacts) ||
+		!m.ExcludedBuildNumbers.Equal(other.ExcludedBuildNumbers)
+}
+
+type BuildRetentionAPIModel struct {
+	DeleteBuildArtifacts         bool     `json:"deleteBuildArtifacts"`
+	Count                        *int64   `json:"count,omitempty"`
+	Days                         *int64   `json:"days,omitempty"`
+	BuildNumbersNotToBeDiscarded []string `json:"buildNumbersNotToBeDiscarded,omitempty"`
+}
+
+func (r *BuildRetentionPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *BuildRetentionPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"build_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the build to discard old runs of.",
+			},
+			"project_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Project key the build belongs to.",
+			},
+			"max_builds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtLeastOneOf(path.MatchRoot("max_days")),
+				},
+				Description: "Number of most recent build runs to keep.",
+			},
+			"max_days": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Discard build runs older than this number of days.",
+			},
+			"delete_artifacts": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Also delete the artifacts of the discarded build runs. Default value is `false`.",
+			},
+			"excluded_build_numbers": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Build numbers that are never discarded.",
+			},
+			"run_trigger": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Any value, e.g. a timestamp or a version. Changing it discards the old build runs again with the current " +
+					"settings, even if they are unchanged.",
+			},
+		},
+		MarkdownDescription: "Discards old runs of a build once, when the resource is created, and again each time `run_trigger` or any of the retention settings changes. " +
+			"This is a one-shot action, not a policy stored in Artifactory: Artifactory doesn't keep the retention settings, so they are " +
+			"not read back, and build runs published afterwards are not discarded until the next run. " +
+			"Destroying this resource only removes it from the Terraform state. " +
+			"For more information, see [JFrog documentation](https://jfrog.com/help/r/jfrog-rest-apis/control-build-retention).",
+	}
+}
+
+func (r *BuildRetentionPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), r.TypeName)
+}
+
+func (r *BuildRetentionPolicyResource) applyRetention(projectKey, buildName string, retention BuildRetentionAPIModel) error {
+	var jfrogErrors util.JFrogErrors
+	response, err := buildRequest(r.ProviderData.Client, projectKey).
+		SetPathParam("name", buildName).
+		SetQueryParam("async", "false").
+		SetBody(retention).
+		SetError(&jfrogErrors).
+		Post(BuildRetentionEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return fmt.Errorf("%s", jfrogErrors.String())
+	}
+
+	return nil
+}
+
+func (r *BuildRetentionPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
+
+	var plan BuildRetentionPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var retention BuildRetentionAPIModel
+	resp.Diagnostics.Append(plan.toAPIModel(ctx, &retention)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applyRetention(plan.ProjectKey.ValueString(), plan.BuildName.ValueString(), retention); err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *BuildRetentionPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
+
+	// Artifactory does not store the retention settings of a build, the state records the settings of the last run.
+}
+
+func (r *BuildRetentionPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
+
+	var plan, state BuildRetentionPolicyResourceModel
+
+	// Read Terraform plan and state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	triggered := !plan.RunTrigger.IsNull() && !plan.RunTrigger.Equal(state.RunTrigger)
+	if !triggered && !plan.retentionChanged(state) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	var retention BuildRetentionAPIModel
+	resp.Diagnostics.Append(plan.toAPIModel(ctx, &retention)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applyRetention(plan.ProjectKey.ValueString(), plan.BuildName.ValueString(), retention); err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *BuildRetentionPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
+
+	// Discarded build runs cannot be restored, so the resource is only removed from the state.
+}
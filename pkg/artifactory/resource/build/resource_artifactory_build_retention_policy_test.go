@@ -0,0 +1,143 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/build"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccBuildRetentionPolicy_full(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("test-build-retention", "artifactory_build_retention_policy")
+
+	buildName := fmt.Sprintf("test-build-%d", testutil.RandomInt())
+
+	const template = `
+	resource "artifactory_build_retention_policy" "{{ .name }}" {
+		build_name             = "{{ .build_name }}"
+		max_builds             = {{ .max_builds }}
+		excluded_build_numbers = ["1"]
+		run_trigger            = "{{ .run_trigger }}"
+	}`
+
+	testData := map[string]string{
+		"name":        resourceName,
+		"build_name":  buildName,
+		"max_builds":  "2",
+		"run_trigger": "1",
+	}
+	config := util.ExecuteTemplate("TestAccBuildRetentionPolicy_full", template, testData)
+
+	testData["max_builds"] = "1"
+	settingsUpdatedConfig := util.ExecuteTemplate("TestAccBuildRetentionPolicy_full", template, testData)
+
+	testData["run_trigger"] = "2"
+	updatedConfig := util.ExecuteTemplate("TestAccBuildRetentionPolicy_full", template, testData)
+
+	checkBuildExists := func(number string, exists bool) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			response, err := acctest.GetTestResty(t).R().
+				SetPathParams(map[string]string{
+					"name":   buildName,
+					"number": number,
+				}).
+				Get(build.BuildInfoEndpoint)
+			if err != nil {
+				return err
+			}
+
+			if found := response.StatusCode() != http.StatusNotFound; found != exists {
+				return fmt.Errorf("expected build %s number %s to exist: %t, got: %t", buildName, number, exists, found)
+			}
+
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			for _, number := range []string{"1", "2", "3", "4"} {
+				acctest.CreateBuild(t, buildName, number, "", nil)
+			}
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			acctest.DeleteBuild(t, buildName, "")
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "max_builds", "2"),
+					resource.TestCheckResourceAttr(fqrn, "delete_artifacts", "false"),
+					checkBuildExists("1", true),
+					checkBuildExists("2", false),
+					checkBuildExists("3", true),
+					checkBuildExists("4", true),
+				),
+			},
+			{
+				Config: settingsUpdatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "max_builds", "1"),
+					checkBuildExists("1", true),
+					checkBuildExists("3", false),
+					checkBuildExists("4", true),
+				),
+			},
+			{
+				PreConfig: func() {
+					acctest.CreateBuild(t, buildName, "5", "", nil)
+				},
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "run_trigger", "2"),
+					checkBuildExists("1", true),
+					checkBuildExists("4", false),
+					checkBuildExists("5", true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBuildRetentionPolicy_requires_limit(t *testing.T) {
+	const config = `
+	resource "artifactory_build_retention_policy" "test" {
+		build_name = "test-build"
+	}`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*At least one attribute out of.*max_days.*`),
+			},
+		},
+	})
+}
@@ -0,0 +1,629 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// The search criteria of the package cleanup, archive and release bundle cleanup policies are built from the
+// attributes, validators and conversions in this file, so that a criteria added or fixed here applies to every
+// policy type.
+
+var yumPolicyPackageType = "yum" // Only used by cleanup and archive policies as RPM
+
+// policySupportedPackageTypes are the package types supported by both package cleanup and archive policies.
+var policySupportedPackageTypes = []string{
+	repository.AlpinePackageType,
+	repository.AnsiblePackageType,
+	repository.CargoPackageType,
+	repository.ChefPackageType,
+	repository.CocoapodsPackageType,
+	repository.ComposerPackageType,
+	repository.ConanPackageType,
+	repository.CondaPackageType,
+	repository.DebianPackageType,
+	repository.DockerPackageType,
+	repository.GemsPackageType,
+	repository.GenericPackageType,
+	repository.GoPackageType,
+	repository.GradlePackageType,
+	repository.HelmPackageType,
+	repository.HelmOCIPackageType,
+	repository.HuggingFacePackageType,
+	repository.MachineLearningType,
+	repository.MavenPackageType,
+	repository.NPMPackageType,
+	repository.NugetPackageType,
+	repository.OCIPackageType,
+	repository.PuppetPackageType,
+	repository.PyPiPackageType,
+	repository.SBTPackageType,
+	repository.SwiftPackageType,
+	repository.TerraformPackageType,
+	repository.TerraformBackendPackageType,
+	repository.RPMPackageType,
+	yumPolicyPackageType,
+}
+
+// archivePolicySupportedPackageType adds the package types that can be archived but not cleaned up.
+var archivePolicySupportedPackageType = append(
+	append([]string{}, policySupportedPackageTypes...),
+	repository.OpkgPackageType,
+	repository.VagrantPackageType,
+)
+
+// PolicySearchCriteriaAPIModel is the search criteria of the package cleanup and archive policies.
+type PolicySearchCriteriaAPIModel struct {
+	PackageTypes                 []string            `json:"packageTypes"`
+	Repos                        []string            `json:"repos"`
+	ExcludedRepos                *[]string           `json:"excludedRepos,omitempty"`
+	IncludedPackages             []string            `json:"includedPackages"`
+	ExcludedPackages             *[]string           `json:"excludedPackages,omitempty"`
+	IncludeAllProjects           *bool               `json:"includeAllProjects,omitempty"`
+	IncludedProjects             *[]string           `json:"includedProjects,omitempty"`
+	CreatedBeforeInMonths        *int64              `json:"createdBeforeInMonths,omitempty"`
+	LastDownloadedBeforeInMonths *int64              `json:"lastDownloadedBeforeInMonths,omitempty"`
+	CreatedBeforeInDays          *int64              `json:"createdBeforeInDays,omitempty"`
+	LastDownloadedBeforeInDays   *int64              `json:"lastDownloadedBeforeInDays,omitempty"`
+	KeepLastNVersions            *int64              `json:"keepLastNVersions,omitempty"`
+	ExcludedProperties           map[string][]string `json:"excludedProperties,omitempty"`
+	IncludedProperties           map[string][]string `json:"includedProperties,omitempty"`
+}
+
+var policyPropertiesType = types.MapType{ElemType: types.ListType{ElemType: types.StringType}}
+
+var policySearchCriteriaAttributeTypes = map[string]attr.Type{
+	"package_types":                    types.SetType{ElemType: types.StringType},
+	"repos":                            types.SetType{ElemType: types.StringType},
+	"excluded_repos":                   types.SetType{ElemType: types.StringType},
+	"included_packages":                types.SetType{ElemType: types.StringType},
+	"excluded_packages":                types.SetType{ElemType: types.StringType},
+	"include_all_projects":             types.BoolType,
+	"included_projects":                types.SetType{ElemType: types.StringType},
+	"created_before_in_months":         types.Int64Type,
+	"last_downloaded_before_in_months": types.Int64Type,
+	"created_before_in_days":           types.Int64Type,
+	"last_downloaded_before_in_days":   types.Int64Type,
+	"keep_last_n_versions":             types.Int64Type,
+	"excluded_properties":              policyPropertiesType,
+	"included_properties":              policyPropertiesType,
+}
+
+// PolicySearchCriteriaFromObject converts a `search_criteria` object, with the attributes of the package
+// cleanup and archive policy resources, into the search criteria.
+func PolicySearchCriteriaFromObject(ctx context.Context, searchCriteria types.Object) (PolicySearchCriteriaAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	attrs := searchCriteria.Attributes()
+
+	// Helper function to safely get int64 pointer
+	getInt64Pointer := func(key string) *int64 {
+		if v, ok := attrs[key]; ok && !v.IsNull() && !v.IsUnknown() {
+			if val, ok := v.(types.Int64); ok {
+				return val.ValueInt64Pointer()
+			}
+		}
+		return nil
+	}
+
+	criteria := PolicySearchCriteriaAPIModel{
+		IncludeAllProjects:           attrs["include_all_projects"].(types.Bool).ValueBoolPointer(),
+		CreatedBeforeInMonths:        getInt64Pointer("created_before_in_months"),
+		LastDownloadedBeforeInMonths: getInt64Pointer("last_downloaded_before_in_months"),
+		CreatedBeforeInDays:          getInt64Pointer("created_before_in_days"),
+		LastDownloadedBeforeInDays:   getInt64Pointer("last_downloaded_before_in_days"),
+		KeepLastNVersions:            getInt64Pointer("keep_last_n_versions"),
+		IncludedProperties:           policyPropertiesFromValue(attrs["included_properties"]),
+		ExcludedProperties:           policyPropertiesFromValue(attrs["excluded_properties"]),
+	}
+
+	diags.Append(attrs["package_types"].(types.Set).ElementsAs(ctx, &criteria.PackageTypes, false)...)
+	diags.Append(attrs["repos"].(types.Set).ElementsAs(ctx, &criteria.Repos, false)...)
+	diags.Append(attrs["excluded_repos"].(types.Set).ElementsAs(ctx, &criteria.ExcludedRepos, false)...)
+	diags.Append(attrs["included_packages"].(types.Set).ElementsAs(ctx, &criteria.IncludedPackages, false)...)
+	diags.Append(attrs["excluded_packages"].(types.Set).ElementsAs(ctx, &criteria.ExcludedPackages, false)...)
+	diags.Append(attrs["included_projects"].(types.Set).ElementsAs(ctx, &criteria.IncludedProjects, false)...)
+
+	return criteria, diags
+}
+
+func policyPropertiesFromValue(value attr.Value) map[string][]string {
+	m, ok := value.(types.Map)
+	if !ok || m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+
+	properties := make(map[string][]string)
+	for k, val := range m.Elements() {
+		if l, ok := val.(types.List); ok && !l.IsNull() && !l.IsUnknown() {
+			var values []string
+			for _, lv := range l.Elements() {
+				if s, ok := lv.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+					values = append(values, s.ValueString())
+				}
+			}
+			properties[k] = values
+		}
+	}
+
+	return properties
+}
+
+func policyPropertiesToValue(ctx context.Context, properties map[string][]string) (types.Map, diag.Diagnostics) {
+	if properties == nil {
+		return types.MapNull(policyPropertiesType.ElemType), nil
+	}
+
+	return types.MapValueFrom(ctx, policyPropertiesType.ElemType, properties)
+}
+
+// policySearchCriteriaToObject converts the search criteria returned by the API into a `search_criteria` object.
+func policySearchCriteriaToObject(ctx context.Context, criteria PolicySearchCriteriaAPIModel) (types.Object, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	stringSet := func(values []string) types.Set {
+		set, ds := types.SetValueFrom(ctx, types.StringType, values)
+		diags.Append(ds...)
+		return set
+	}
+
+	optionalStringSet := func(values *[]string) types.Set {
+		if values == nil {
+			return types.SetNull(types.StringType)
+		}
+		return stringSet(*values)
+	}
+
+	includedProperties, ds := policyPropertiesToValue(ctx, criteria.IncludedProperties)
+	diags.Append(ds...)
+
+	excludedProperties, ds := policyPropertiesToValue(ctx, criteria.ExcludedProperties)
+	diags.Append(ds...)
+
+	searchCriteria, ds := types.ObjectValue(
+		policySearchCriteriaAttributeTypes,
+		map[string]attr.Value{
+			"package_types":                    stringSet(criteria.PackageTypes),
+			"repos":                            stringSet(criteria.Repos),
+			"excluded_repos":                   optionalStringSet(criteria.ExcludedRepos),
+			"included_packages":                stringSet(criteria.IncludedPackages),
+			"excluded_packages":                optionalStringSet(criteria.ExcludedPackages),
+			"include_all_projects":             types.BoolPointerValue(criteria.IncludeAllProjects),
+			"included_projects":                optionalStringSet(criteria.IncludedProjects),
+			"created_before_in_months":         types.Int64PointerValue(criteria.CreatedBeforeInMonths),
+			"last_downloaded_before_in_months": types.Int64PointerValue(criteria.LastDownloadedBeforeInMonths),
+			"created_before_in_days":           types.Int64PointerValue(criteria.CreatedBeforeInDays),
+			"last_downloaded_before_in_days":   types.Int64PointerValue(criteria.LastDownloadedBeforeInDays),
+			"keep_last_n_versions":             types.Int64PointerValue(criteria.KeepLastNVersions),
+			"excluded_properties":              excludedProperties,
+			"included_properties":              includedProperties,
+		},
+	)
+	diags.Append(ds...)
+
+	return searchCriteria, diags
+}
+
+// policySearchCriteria describes the `search_criteria` attribute of a package policy resource. Only the wording,
+// the package types and the defaults differ between policy types.
+type policySearchCriteria struct {
+	// policyName is used in descriptions, e.g. "cleanup policy"
+	policyName string
+	// action is the past participle used in descriptions, e.g. "deleted"
+	action       string
+	packageTypes []string
+	// includeAllProjectsDefault sets a `false` default on `include_all_projects`. Changing it for an existing
+	// policy type would change the state of existing policies.
+	includeAllProjectsDefault bool
+}
+
+func (c policySearchCriteria) schema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"package_types": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(c.packageTypes...),
+					),
+				},
+				MarkdownDescription: fmt.Sprintf("The package types that are %s by the policy. Support: %s.", c.action, strings.Join(c.packageTypes, ", ")),
+			},
+			"repos": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				MarkdownDescription: fmt.Sprintf("Specify one or more patterns for the repository name(s) on which you want the %s to run. You can also specify explicit repository names. Specifying at least one pattern or explicit name is mandatory. Only packages in repositories that match the pattern or explicit name will be %s. For including all repos use `**`. Example: `repos = [\"**\"]`", c.policyName, c.action),
+			},
+			"excluded_repos": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				MarkdownDescription: fmt.Sprintf("Specify patterns for repository names or explicit repository names that you want excluded from the %s.", c.policyName),
+			},
+			"included_packages": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 1),
+				},
+				MarkdownDescription: fmt.Sprintf("Specify a pattern for a package name or an explicit package name on which you want the %s to run. Only one pattern or explicit name can be entered. To include all packages, use `**`. Example: `included_packages = [\"**\"]`", c.policyName),
+			},
+			"excluded_packages": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				MarkdownDescription: "Specify explicit package names that you want excluded from the policy. Only explicit names (and not patterns) are accepted.",
+			},
+			"include_all_projects": policyIncludeAllProjectsSchema(c.includeAllProjectsDefault),
+			"included_projects":    policyIncludedProjectsSchema(true),
+			"created_before_in_months": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf("The %s will %s packages based on how long ago they were created. For example, if this parameter is 2 then packages created more than 2 months ago will be %s as part of the policy.\n\n", c.policyName, c.verb(), c.action) +
+					fmt.Sprintf("~>JFrog recommends using the `created_before_in_months` condition to ensure that packages currently in use are not %s.", c.action),
+				DeprecationMessage: "Use `created_before_in_days` instead of `created_before_in_months`. Renamed to `created_before_in_days` starting in version 7.111.2.",
+			},
+			"last_downloaded_before_in_months": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf("The %s will %s packages based on how long ago they were downloaded. For example, if this parameter is 5 then packages downloaded more than 5 months ago will be %s as part of the policy.\n\n", c.policyName, c.verb(), c.action) +
+					fmt.Sprintf("~>JFrog recommends using the `last_downloaded_before_in_months` condition to ensure that packages currently in use are not %s.", c.action),
+				DeprecationMessage: "Use `last_downloaded_before_in_days` instead of `last_downloaded_before_in_months`. Renamed to `last_downloaded_before_in_days` starting in version 7.111.2.",
+			},
+			"created_before_in_days": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf("The %s will %s packages based on how long ago they were created. For example, if this parameter is 5 then packages created more than 5 days ago will be %s as part of the policy.\n\n", c.policyName, c.verb(), c.action) +
+					fmt.Sprintf("~>JFrog recommends using the `created_before_in_days` condition to ensure that packages currently in use are not %s.", c.action),
+			},
+			"last_downloaded_before_in_days": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf("The %s will %s packages based on how long ago they were downloaded. For example, if this parameter is 5 then packages downloaded more than 5 days ago will be %s as part of the policy.\n\n", c.policyName, c.verb(), c.action) +
+					fmt.Sprintf("~>JFrog recommends using the `last_downloaded_before_in_days` condition to ensure that packages currently in use are not %s.", c.action),
+			},
+			"keep_last_n_versions": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf("Set a value for the number of latest versions to keep. The %s will %s all versions prior to the number you select here. The latest version is always excluded.\n\n", c.policyName, c.verb()) +
+					"~>Not all package types support this condition. For information on which package types support this condition, [learn more](https://jfrog.com/help/r/jfrog-platform-administration-documentation/retention-policies/package-types-coverage).",
+			},
+			"excluded_properties": schema.MapAttribute{
+				ElementType: policyPropertiesType.ElemType,
+				Optional:    true,
+				Validators: []validator.Map{
+					singleKeySingleValueMapValidator{},
+				},
+				MarkdownDescription: fmt.Sprintf("A key-value pair applied to the lead artifact of a package. Packages with this property will not be %s.", c.action),
+			},
+			"included_properties": schema.MapAttribute{
+				ElementType: policyPropertiesType.ElemType,
+				Optional:    true,
+				Validators: []validator.Map{
+					singleKeySingleValueMapValidator{},
+				},
+				MarkdownDescription: fmt.Sprintf("A key-value pair applied to the lead artifact of a package. Packages with this property will be %s.", c.action),
+			},
+		},
+		Required: true,
+		Validators: []validator.Object{
+			policyConditionsValidator{requireCondition: true},
+		},
+	}
+}
+
+// verb returns the action of the policy in the infinitive, e.g. "delete"
+func (c policySearchCriteria) verb() string {
+	return strings.TrimSuffix(c.action, "d")
+}
+
+// policyIncludeAllProjectsSchema is the `include_all_projects` attribute of the policy search criteria.
+func policyIncludeAllProjectsSchema(withDefault bool) schema.BoolAttribute {
+	includeAllProjects := schema.BoolAttribute{
+		Optional: true,
+		MarkdownDescription: "Set this value to `true` if you want the policy to run on all Artifactory projects. The default value is `false`.\n\n" +
+			"~>This parameter is relevant only on the global level, for Platform Admins.",
+	}
+	if withDefault {
+		includeAllProjects.Computed = true
+		includeAllProjects.Default = booldefault.StaticBool(false)
+	}
+
+	return includeAllProjects
+}
+
+// policyIncludedProjectsSchema is the `included_projects` attribute of the policy search criteria.
+func policyIncludedProjectsSchema(required bool) schema.SetAttribute {
+	return schema.SetAttribute{
+		ElementType: types.StringType,
+		Required:    required,
+		Optional:    !required,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(0),
+		},
+		MarkdownDescription: "Enter the project keys for the projects on which you want the policy to run. To include repositories that are not assigned to any project, enter the project key `default`.\n\n" +
+			"~>This parameter is relevant only on the global level, for Platform Admins.",
+	}
+}
+
+// policyConditionsValidator validates the conditions of a `search_criteria` object. Conditions that are not
+// attributes of the object are ignored, so the same rules apply to every policy type.
+type policyConditionsValidator struct {
+	// requireCondition fails the validation when no condition is set
+	requireCondition bool
+}
+
+func (v policyConditionsValidator) Description(ctx context.Context) string {
+	return "Validates policy conditions: time-based and/or properties-based conditions can be combined; version-based condition (keep_last_n_versions) is mutually exclusive with all other condition types"
+}
+
+func (v policyConditionsValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates policy conditions: time-based and/or properties-based conditions can be combined; version-based condition (`keep_last_n_versions`) is mutually exclusive with all other condition types"
+}
+
+func (v policyConditionsValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	// If the object is null or unknown, skip validation
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attrs := req.ConfigValue.Attributes()
+
+	// If any condition-related attribute is unknown (e.g., when using variables),
+	// skip validation to avoid false positives during terraform validate
+	for _, key := range []string{
+		"created_before_in_days",
+		"last_downloaded_before_in_days",
+		"created_before_in_months",
+		"last_downloaded_before_in_months",
+		"keep_last_n_versions",
+		"included_properties",
+	} {
+		if v, ok := attrs[key]; ok && v.IsUnknown() {
+			return
+		}
+	}
+
+	// Helper function to get int64 value
+	getInt64 := func(key string) types.Int64 {
+		if v, ok := attrs[key]; ok && !v.IsNull() {
+			if val, ok := v.(types.Int64); ok {
+				return val
+			}
+		}
+		return types.Int64Null()
+	}
+
+	isZero := func(v types.Int64) bool { return !v.IsNull() && v.ValueInt64() == 0 }
+	isSet := func(v types.Int64) bool { return !v.IsNull() && v.ValueInt64() > 0 }
+
+	// Time-based conditions (days) - for Artifactory 7.111.2+
+	createdBeforeInDays := getInt64("created_before_in_days")
+	lastDownloadedBeforeInDays := getInt64("last_downloaded_before_in_days")
+
+	// Time-based conditions (months) - for Artifactory < 7.111.2
+	createdBeforeInMonths := getInt64("created_before_in_months")
+	lastDownloadedBeforeInMonths := getInt64("last_downloaded_before_in_months")
+
+	// Version-based condition (available in both versions)
+	keepLastNVersions := getInt64("keep_last_n_versions")
+
+	// Check for zero values in time-based conditions
+	if isZero(createdBeforeInDays) || isZero(lastDownloadedBeforeInDays) || isZero(createdBeforeInMonths) || isZero(lastDownloadedBeforeInMonths) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Policy Configuration",
+			"Time-based conditions must have a value greater than 0. Zero values are not allowed for `created_before_in_days`, `last_downloaded_before_in_days`, `created_before_in_months`, or `last_downloaded_before_in_months`.",
+		)
+		return
+	}
+
+	// Check for zero values in version-based condition
+	if isZero(keepLastNVersions) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Policy Configuration",
+			"Version-based condition (keep_last_n_versions) must have a value greater than 0. Zero values are not allowed.",
+		)
+		return
+	}
+
+	timeBasedDaysSet := isSet(createdBeforeInDays) || isSet(lastDownloadedBeforeInDays)
+	timeBasedMonthsSet := isSet(createdBeforeInMonths) || isSet(lastDownloadedBeforeInMonths)
+	keepVersionBasedSet := isSet(keepLastNVersions)
+
+	// Properties-based conditions (only included_properties)
+	propertiesBasedSet := false
+	if m, ok := attrs["included_properties"].(types.Map); ok && !m.IsNull() {
+		propertiesBasedSet = len(m.Elements()) > 0
+	}
+
+	// Check for mixed usage of days and months (invalid)
+	if timeBasedDaysSet && timeBasedMonthsSet {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Policy Configuration",
+			"Cannot use both days-based conditions (`created_before_in_days`, `last_downloaded_before_in_days`) and months-based conditions (`created_before_in_months`, `last_downloaded_before_in_months`) together. Use either days-based or months-based conditions based on your Artifactory version.",
+		)
+		return
+	}
+
+	timeBasedSet := timeBasedDaysSet || timeBasedMonthsSet
+
+	// Must specify at least one condition
+	if v.requireCondition && !timeBasedSet && !keepVersionBasedSet && !propertiesBasedSet {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Policy Configuration",
+			"A policy must specify at least one condition: time-based conditions (days-based or months-based), version-based condition (`keep_last_n_versions`), or properties-based condition (`included_properties`). Time-based and properties-based conditions can be combined (AND semantics) starting from Artifactory 7.129.",
+		)
+		return
+	}
+
+	// Version-based condition is mutually exclusive with time-based and properties-based conditions
+	if keepVersionBasedSet && (timeBasedSet || propertiesBasedSet) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Policy Configuration",
+			"Version-based condition (`keep_last_n_versions`) cannot be combined with time-based conditions or properties-based condition (`included_properties`). Use `keep_last_n_versions` alone, or use time-based and/or properties-based conditions without `keep_last_n_versions`.",
+		)
+		return
+	}
+}
+
+type singleKeySingleValueMapValidator struct{}
+
+func (v singleKeySingleValueMapValidator) Description(ctx context.Context) string {
+	return "Must have exactly one key and that key must have exactly one string value"
+}
+
+func (v singleKeySingleValueMapValidator) MarkdownDescription(ctx context.Context) string {
+	return "Must have exactly one key and that key must have exactly one string value"
+}
+
+func (v singleKeySingleValueMapValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	m := req.ConfigValue.Elements()
+	if len(m) != 1 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Properties",
+			"Properties-based conditions must have exactly one key.",
+		)
+		return
+	}
+
+	for _, v := range m {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		if l, ok := v.(types.List); ok {
+			if len(l.Elements()) != 1 {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Invalid Properties",
+					"The property value must be a list with exactly one string value.",
+				)
+			}
+		}
+	}
+}
+
+// validateProjectLevelPolicyKey checks that the key of a project-level policy starts with the project key.
+// Unknown values are skipped, they are checked again during plan.
+func validateProjectLevelPolicyKey(key, projectKey types.String, diags *diag.Diagnostics) {
+	if projectKey.IsNull() || projectKey.IsUnknown() || projectKey.ValueString() == "" || key.IsNull() || key.IsUnknown() {
+		return
+	}
+
+	expectedPrefix := projectKey.ValueString() + "-"
+	if !strings.HasPrefix(key.ValueString(), expectedPrefix) {
+		diags.AddAttributeError(
+			path.Root("key"),
+			"Invalid Project-Level Policy Key",
+			fmt.Sprintf("Project-level policy key must start with the project key prefix. Expected key to start with '%s', but got '%s'. Consider using a key like '%s<policy-name>'.", expectedPrefix, key.ValueString(), expectedPrefix),
+		)
+	}
+}
+
+// validateProjectLevelSearchCriteria checks that the search criteria of a project-level policy doesn't select
+// other projects.
+func validateProjectLevelSearchCriteria(projectKey types.String, searchCriteria types.Object, diags *diag.Diagnostics) {
+	if projectKey.IsNull() || projectKey.IsUnknown() || projectKey.ValueString() == "" || searchCriteria.IsNull() || searchCriteria.IsUnknown() {
+		return
+	}
+
+	attrs := searchCriteria.Attributes()
+
+	// include_all_projects must be false for project-level policies
+	if includeAllProjects, ok := attrs["include_all_projects"].(types.Bool); ok && includeAllProjects.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("search_criteria").AtName("include_all_projects"),
+			"Invalid Project-Level Policy Configuration",
+			"Project-level policies (when `project_key` is specified) cannot include all projects. Set `include_all_projects` to `false`.",
+		)
+	}
+
+	// included_projects should be empty for project-level policies
+	if includedProjects, ok := attrs["included_projects"].(types.Set); ok && !includedProjects.IsUnknown() && len(includedProjects.Elements()) > 0 {
+		diags.AddAttributeError(
+			path.Root("search_criteria").AtName("included_projects"),
+			"Invalid Project-Level Policy Configuration",
+			"Project-level policies (when `project_key` is specified) should have an empty `included_projects` array `[]`. The policy will automatically apply to the specified project.",
+		)
+	}
+}
+
+// validatePolicyConditionsVersion checks that the Artifactory server supports the conditions of the search
+// criteria. Unknown values are skipped, they are checked again during plan.
+func validatePolicyConditionsVersion(meta util.ProviderMetadata, searchCriteria types.Object, diags *diag.Diagnostics) {
+	if searchCriteria.IsNull() || searchCriteria.IsUnknown() {
+		return
+	}
+
+	attrs := searchCriteria.Attributes()
+	isSet := func(key string) bool {
+		v, ok := attrs[key].(types.Int64)
+		return ok && !v.IsNull() && !v.IsUnknown() && v.ValueInt64() > 0
+	}
+
+	for _, key := range []string{"created_before_in_days", "last_downloaded_before_in_days"} {
+		if isSet(key) {
+			capability.PolicyConditionsInDays.Validate(meta, path.Root("search_criteria").AtName(key), diags)
+		}
+	}
+
+	timeBasedSet := isSet("created_before_in_days") || isSet("last_downloaded_before_in_days") ||
+		isSet("created_before_in_months") || isSet("last_downloaded_before_in_months")
+	if properties, ok := attrs["included_properties"].(types.Map); ok && timeBasedSet && len(properties.Elements()) > 0 {
+		capability.PolicyTimeAndPropertiesConditions.Validate(meta, path.Root("search_criteria").AtName("included_properties"), diags)
+	}
+}
+
+func normalizeEmptyAPIString(apiValue string, priorValue types.String) types.String {
+	if apiValue == "" && priorValue.IsNull() {
+		return types.StringNull()
+	}
+
+	return types.StringValue(apiValue)
+}
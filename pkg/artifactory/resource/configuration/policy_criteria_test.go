@@ -0,0 +1,165 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+func TestPolicySearchCriteriaRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	criteria := PolicySearchCriteriaAPIModel{
+		PackageTypes:        []string{"docker"},
+		Repos:               []string{"docker-local"},
+		ExcludedRepos:       &[]string{"docker-keep"},
+		IncludedPackages:    []string{"**"},
+		IncludeAllProjects:  lo.ToPtr(false),
+		IncludedProjects:    &[]string{"default"},
+		CreatedBeforeInDays: lo.ToPtr(int64(30)),
+		IncludedProperties:  map[string][]string{"stage": {"dev"}},
+	}
+
+	object, diags := policySearchCriteriaToObject(ctx, criteria)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags.Errors())
+	}
+
+	if !object.Attributes()["excluded_packages"].IsNull() {
+		t.Error("expected null excluded_packages")
+	}
+	if !object.Attributes()["excluded_properties"].IsNull() {
+		t.Error("expected null excluded_properties")
+	}
+
+	got, diags := PolicySearchCriteriaFromObject(ctx, object)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags.Errors())
+	}
+
+	if !reflect.DeepEqual(got, criteria) {
+		t.Errorf("expected %+v, got %+v", criteria, got)
+	}
+}
+
+func TestPolicyConditionsValidator(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"created_before_in_days":   types.Int64Type,
+		"created_before_in_months": types.Int64Type,
+		"keep_last_n_versions":     types.Int64Type,
+		"included_properties":      policyPropertiesType,
+	}
+
+	criteria := func(values map[string]attr.Value) types.Object {
+		attrs := map[string]attr.Value{
+			"created_before_in_days":   types.Int64Null(),
+			"created_before_in_months": types.Int64Null(),
+			"keep_last_n_versions":     types.Int64Null(),
+			"included_properties":      types.MapNull(policyPropertiesType.ElemType),
+		}
+		for k, v := range values {
+			attrs[k] = v
+		}
+		return types.ObjectValueMust(attrTypes, attrs)
+	}
+
+	properties := types.MapValueMust(policyPropertiesType.ElemType, map[string]attr.Value{
+		"stage": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("dev")}),
+	})
+
+	testCases := []struct {
+		name             string
+		requireCondition bool
+		criteria         types.Object
+		expectedError    string
+	}{
+		{
+			name:             "time-based",
+			requireCondition: true,
+			criteria:         criteria(map[string]attr.Value{"created_before_in_days": types.Int64Value(30)}),
+		},
+		{
+			name:             "time-based and properties",
+			requireCondition: true,
+			criteria:         criteria(map[string]attr.Value{"created_before_in_days": types.Int64Value(30), "included_properties": properties}),
+		},
+		{
+			name:             "unknown condition",
+			requireCondition: true,
+			criteria:         criteria(map[string]attr.Value{"keep_last_n_versions": types.Int64Unknown()}),
+		},
+		{
+			name:             "no condition",
+			requireCondition: true,
+			criteria:         criteria(nil),
+			expectedError:    "A policy must specify at least one condition",
+		},
+		{
+			name:             "no condition not required",
+			requireCondition: false,
+			criteria:         criteria(nil),
+		},
+		{
+			name:             "zero time-based condition",
+			requireCondition: false,
+			criteria:         criteria(map[string]attr.Value{"created_before_in_months": types.Int64Value(0)}),
+			expectedError:    "Time-based conditions must have a value greater than 0",
+		},
+		{
+			name:             "days and months",
+			requireCondition: true,
+			criteria:         criteria(map[string]attr.Value{"created_before_in_days": types.Int64Value(30), "created_before_in_months": types.Int64Value(1)}),
+			expectedError:    "Cannot use both days-based conditions",
+		},
+		{
+			name:             "version-based and properties",
+			requireCondition: true,
+			criteria:         criteria(map[string]attr.Value{"keep_last_n_versions": types.Int64Value(3), "included_properties": properties}),
+			expectedError:    "cannot be combined",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := validator.ObjectRequest{
+				Path:        path.Root("search_criteria"),
+				ConfigValue: tc.criteria,
+			}
+			resp := &validator.ObjectResponse{}
+
+			policyConditionsValidator{requireCondition: tc.requireCondition}.ValidateObject(context.Background(), req, resp)
+
+			if tc.expectedError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics.Errors())
+				}
+				return
+			}
+
+			if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tc.expectedError) {
+				t.Fatalf("expected error %q, got %s", tc.expectedError, resp.Diagnostics.Errors())
+			}
+		})
+	}
+}
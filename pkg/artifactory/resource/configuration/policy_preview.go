@@ -0,0 +1,510 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	pathutil "path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+)
+
+const (
+	policyPreviewRepositoriesEndpoint = "artifactory/api/repositories"
+	policyPreviewAQLEndpoint          = "artifactory/api/search/aql"
+
+	// PolicyPreviewDefaultSampleSize is the default number of packages returned in a policy preview
+	PolicyPreviewDefaultSampleSize = 10
+	// PolicyPreviewMaxArtifacts is the maximum number of artifacts searched by a policy preview
+	PolicyPreviewMaxArtifacts = 10000
+)
+
+const (
+	PolicyTypeCleanup = "cleanup"
+	PolicyTypeArchive = "archive"
+)
+
+// PolicyTypes are the policy types that can be previewed.
+var PolicyTypes = []string{PolicyTypeCleanup, PolicyTypeArchive}
+
+var policyEndpoints = map[string]string{
+	PolicyTypeCleanup: PackageCleanupPolicyEndpointPath,
+	PolicyTypeArchive: "artifactory/api/archive/v2/packages/policies/{policyKey}",
+}
+
+// policyPreviewRclasses are the repository classes whose content is cleaned up or archived by a policy.
+var policyPreviewRclasses = []string{"local", "federated"}
+
+// PolicyPreviewPackage is a package version matched by a policy preview.
+type PolicyPreviewPackage struct {
+	Repo           string
+	Path           string
+	ArtifactCount  int64
+	SizeBytes      int64
+	Created        string
+	LastDownloaded string
+}
+
+// PolicyPreview is the result of evaluating a policy search criteria against the instance.
+type PolicyPreview struct {
+	Repos         []string
+	PackageCount  int64
+	ArtifactCount int64
+	SizeBytes     int64
+	// Truncated is true when the search reached PolicyPreviewMaxArtifacts, the counts are then lower bounds
+	Truncated bool
+	// Packages is a sample of the matched packages, the largest first
+	Packages []PolicyPreviewPackage
+}
+
+type policyPreviewRepository struct {
+	Key         string `json:"key"`
+	Type        string `json:"type"`
+	PackageType string `json:"packageType"`
+}
+
+type policyPreviewItemProperty struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type policyPreviewItem struct {
+	Repo       string                      `json:"repo"`
+	Path       string                      `json:"path"`
+	Name       string                      `json:"name"`
+	Size       int64                       `json:"size"`
+	Created    string                      `json:"created"`
+	Properties []policyPreviewItemProperty `json:"properties"`
+	Stats      []struct {
+		Downloaded string `json:"downloaded"`
+	} `json:"stats"`
+}
+
+type policyPreviewAQLResult struct {
+	Results []policyPreviewItem `json:"results"`
+}
+
+// PreviewPolicy estimates which packages a cleanup or archive policy with the given search criteria would affect.
+//
+// Artifactory has no dry-run API for these policies, so the criteria are evaluated with AQL: a package version
+// is the folder containing the matched artifacts, the package name patterns are matched against that folder path,
+// and `keep_last_n_versions` keeps the most recently created folders of each parent folder. The result is an
+// estimate, Artifactory evaluates the policy with its package metadata.
+func PreviewPolicy(ctx context.Context, client *resty.Client, criteria PolicySearchCriteriaAPIModel, sampleSize int) (PolicyPreview, error) {
+	preview := PolicyPreview{}
+
+	repos, err := policyPreviewRepos(client, criteria)
+	if err != nil {
+		return preview, err
+	}
+	preview.Repos = repos
+
+	if len(repos) == 0 {
+		return preview, nil
+	}
+
+	query, err := policyPreviewQuery(repos, criteria)
+	if err != nil {
+		return preview, err
+	}
+
+	tflog.Debug(ctx, "PreviewPolicy", map[string]interface{}{
+		"query": query,
+	})
+
+	var result policyPreviewAQLResult
+	var jfrogErrors util.JFrogErrors
+	response, err := client.R().
+		SetHeader("Content-Type", "text/plain").
+		SetBody(query).
+		SetResult(&result).
+		SetError(&jfrogErrors).
+		Post(policyPreviewAQLEndpoint)
+	if err != nil {
+		return preview, err
+	}
+	if response.IsError() {
+		return preview, fmt.Errorf("failed to search artifacts: %s", jfrogErrors.String())
+	}
+
+	preview.Truncated = len(result.Results) >= PolicyPreviewMaxArtifacts
+
+	packages := policyPreviewPackages(result.Results, criteria)
+
+	for _, p := range packages {
+		preview.PackageCount++
+		preview.ArtifactCount += p.ArtifactCount
+		preview.SizeBytes += p.SizeBytes
+	}
+
+	sort.SliceStable(packages, func(i, j int) bool {
+		return packages[i].SizeBytes > packages[j].SizeBytes
+	})
+	preview.Packages = packages[:min(sampleSize, len(packages))]
+
+	return preview, nil
+}
+
+// GetPolicySearchCriteria returns the search criteria of an existing cleanup or archive policy.
+func GetPolicySearchCriteria(client *resty.Client, policyType, key string) (PolicySearchCriteriaAPIModel, error) {
+	// both policy types share the same API model
+	var policy PackageCleanupPolicyAPIModel
+	var jfrogErrors util.JFrogErrors
+	response, err := client.R().
+		SetPathParam("policyKey", key).
+		SetResult(&policy).
+		SetError(&jfrogErrors).
+		Get(policyEndpoints[policyType])
+	if err != nil {
+		return policy.SearchCriteria, err
+	}
+	if response.StatusCode() == http.StatusNotFound {
+		return policy.SearchCriteria, fmt.Errorf("%s policy %s not found", policyType, key)
+	}
+	if response.IsError() {
+		return policy.SearchCriteria, fmt.Errorf("failed to get %s policy %s: %s", policyType, key, jfrogErrors.String())
+	}
+
+	return policy.SearchCriteria, nil
+}
+
+// policyPreviewRepos returns the keys of the local and federated repositories matching the repository patterns
+// and package types of the search criteria.
+func policyPreviewRepos(client *resty.Client, criteria PolicySearchCriteriaAPIModel) ([]string, error) {
+	var repos []policyPreviewRepository
+	var jfrogErrors util.JFrogErrors
+	response, err := client.R().
+		SetResult(&repos).
+		SetError(&jfrogErrors).
+		Get(policyPreviewRepositoriesEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	if response.IsError() {
+		return nil, fmt.Errorf("failed to get repositories: %s", jfrogErrors.String())
+	}
+
+	var projectRepos []string
+	if criteria.IncludedProjects != nil && len(*criteria.IncludedProjects) > 0 {
+		for _, project := range *criteria.IncludedProjects {
+			var reposOfProject []policyPreviewRepository
+			response, err := client.R().
+				SetQueryParam("project", project).
+				SetResult(&reposOfProject).
+				SetError(&jfrogErrors).
+				Get(policyPreviewRepositoriesEndpoint)
+			if err != nil {
+				return nil, err
+			}
+			if response.IsError() {
+				return nil, fmt.Errorf("failed to get repositories of project %s: %s", project, jfrogErrors.String())
+			}
+
+			projectRepos = append(projectRepos, lo.Map(reposOfProject, func(r policyPreviewRepository, _ int) string { return r.Key })...)
+		}
+	}
+
+	packageTypes := lo.Map(criteria.PackageTypes, func(packageType string, _ int) string {
+		// yum is the policy package type of RPM repositories
+		if packageType == yumPolicyPackageType {
+			return "rpm"
+		}
+		return strings.ToLower(packageType)
+	})
+
+	matched := lo.FilterMap(repos, func(repo policyPreviewRepository, _ int) (string, bool) {
+		if !slices.Contains(policyPreviewRclasses, strings.ToLower(repo.Type)) {
+			return "", false
+		}
+
+		if len(packageTypes) > 0 && !slices.Contains(packageTypes, strings.ToLower(repo.PackageType)) {
+			return "", false
+		}
+
+		if criteria.ExcludedRepos != nil && slices.Contains(*criteria.ExcludedRepos, repo.Key) {
+			return "", false
+		}
+
+		if projectRepos != nil && !slices.Contains(projectRepos, repo.Key) {
+			return "", false
+		}
+
+		return repo.Key, lo.SomeBy(criteria.Repos, func(pattern string) bool {
+			return policyPreviewPatternRegex(pattern).MatchString(repo.Key)
+		})
+	})
+
+	sort.Strings(matched)
+
+	return matched, nil
+}
+
+// policyPreviewPatternRegex converts a policy pattern, where `**` matches any path and `*` any name in a path
+// segment, into a regular expression.
+func policyPreviewPatternRegex(pattern string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			sb.WriteString("[^/]*")
+		case pattern[i] == '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		}
+	}
+
+	sb.WriteString("$")
+
+	return regexp.MustCompile(sb.String())
+}
+
+// policyPreviewQuery returns the AQL query for the artifacts of the repositories matching the time and
+// included properties conditions. The package and excluded properties conditions are evaluated on the results.
+// The query is limited to PolicyPreviewMaxArtifacts artifacts as it runs on every plan.
+func policyPreviewQuery(repos []string, criteria PolicySearchCriteriaAPIModel) (string, error) {
+	conditions := []interface{}{
+		map[string]interface{}{
+			"$or": lo.Map(repos, func(repo string, _ int) map[string]interface{} {
+				return map[string]interface{}{"repo": repo}
+			}),
+		},
+		map[string]interface{}{"type": "file"},
+	}
+
+	relativeTime := func(months, days *int64) string {
+		if days != nil && *days > 0 {
+			return fmt.Sprintf("%dd", *days)
+		}
+		if months != nil && *months > 0 {
+			return fmt.Sprintf("%dmo", *months)
+		}
+		return ""
+	}
+
+	if createdBefore := relativeTime(criteria.CreatedBeforeInMonths, criteria.CreatedBeforeInDays); createdBefore != "" {
+		conditions = append(conditions, map[string]interface{}{
+			"created": map[string]string{"$before": createdBefore},
+		})
+	}
+
+	if downloadedBefore := relativeTime(criteria.LastDownloadedBeforeInMonths, criteria.LastDownloadedBeforeInDays); downloadedBefore != "" {
+		// artifacts never downloaded have no download date, the policy then uses their creation date
+		conditions = append(conditions, map[string]interface{}{
+			"$or": []interface{}{
+				map[string]interface{}{
+					"stat.downloaded": map[string]string{"$before": downloadedBefore},
+				},
+				map[string]interface{}{
+					"$and": []interface{}{
+						map[string]interface{}{"stat.downloaded": map[string]interface{}{"$eq": nil}},
+						map[string]interface{}{"created": map[string]string{"$before": downloadedBefore}},
+					},
+				},
+			},
+		})
+	}
+
+	for _, key := range lo.Keys(criteria.IncludedProperties) {
+		for _, value := range criteria.IncludedProperties[key] {
+			conditions = append(conditions, map[string]interface{}{
+				"@" + key: map[string]string{"$eq": value},
+			})
+		}
+	}
+
+	query, err := json.Marshal(map[string]interface{}{"$and": conditions})
+	if err != nil {
+		return "", err
+	}
+
+	include := []string{`"repo"`, `"path"`, `"name"`, `"size"`, `"created"`, `"stat.downloaded"`}
+	if len(criteria.ExcludedProperties) > 0 {
+		include = append(include, `"property"`)
+	}
+
+	return fmt.Sprintf("items.find(%s).include(%s).limit(%d)", query, strings.Join(include, ","), PolicyPreviewMaxArtifacts), nil
+}
+
+// policyPreviewPackages groups the artifacts by package version (folder) and applies the package name,
+// excluded properties and `keep_last_n_versions` conditions.
+func policyPreviewPackages(items []policyPreviewItem, criteria PolicySearchCriteriaAPIModel) []PolicyPreviewPackage {
+	included := lo.Map(criteria.IncludedPackages, func(pattern string, _ int) *regexp.Regexp {
+		return policyPreviewPatternRegex(pattern)
+	})
+
+	var excluded []*regexp.Regexp
+	if criteria.ExcludedPackages != nil {
+		excluded = lo.Map(*criteria.ExcludedPackages, func(pattern string, _ int) *regexp.Regexp {
+			return policyPreviewPatternRegex(pattern)
+		})
+	}
+
+	// packages are excluded as a whole when one of their artifacts has an excluded property
+	excludedPackages := map[string]bool{}
+	packages := map[string]*PolicyPreviewPackage{}
+	var keys []string
+
+	for _, item := range items {
+		key := item.Repo + "/" + item.Path
+
+		if policyPreviewHasProperty(item, criteria.ExcludedProperties) {
+			excludedPackages[key] = true
+		}
+
+		if len(included) > 0 && !lo.SomeBy(included, func(r *regexp.Regexp) bool { return r.MatchString(item.Path) }) {
+			continue
+		}
+
+		if lo.SomeBy(excluded, func(r *regexp.Regexp) bool { return r.MatchString(item.Path) }) {
+			continue
+		}
+
+		p, ok := packages[key]
+		if !ok {
+			p = &PolicyPreviewPackage{Repo: item.Repo, Path: item.Path}
+			packages[key] = p
+			keys = append(keys, key)
+		}
+
+		p.ArtifactCount++
+		p.SizeBytes += item.Size
+		// AQL dates are ISO 8601 with the same time zone, so they can be compared as strings
+		if item.Created > p.Created {
+			p.Created = item.Created
+		}
+		for _, stat := range item.Stats {
+			if stat.Downloaded > p.LastDownloaded {
+				p.LastDownloaded = stat.Downloaded
+			}
+		}
+	}
+
+	result := lo.FilterMap(keys, func(key string, _ int) (PolicyPreviewPackage, bool) {
+		return *packages[key], !excludedPackages[key]
+	})
+
+	if criteria.KeepLastNVersions == nil || *criteria.KeepLastNVersions <= 0 {
+		return result
+	}
+
+	keep := int(*criteria.KeepLastNVersions)
+	versions := lo.GroupBy(result, func(p PolicyPreviewPackage) string {
+		return p.Repo + "/" + pathutil.Dir(p.Path)
+	})
+
+	result = []PolicyPreviewPackage{}
+	for _, parent := range lo.Keys(versions) {
+		packageVersions := versions[parent]
+		sort.SliceStable(packageVersions, func(i, j int) bool {
+			return packageVersions[i].Created > packageVersions[j].Created
+		})
+
+		if len(packageVersions) > keep {
+			result = append(result, packageVersions[keep:]...)
+		}
+	}
+
+	return result
+}
+
+func policyPreviewHasProperty(item policyPreviewItem, properties map[string][]string) bool {
+	return lo.SomeBy(item.Properties, func(property policyPreviewItemProperty) bool {
+		return slices.Contains(properties[property.Key], property.Value)
+	})
+}
+
+// PolicyPreviewSummary is the human readable summary of a policy preview, e.g. for plan warnings.
+func PolicyPreviewSummary(preview PolicyPreview, action string) string {
+	summary := fmt.Sprintf(
+		"An estimated %d package version(s), %d artifact(s), %d byte(s) in %d repositories would be %s.",
+		preview.PackageCount,
+		preview.ArtifactCount,
+		preview.SizeBytes,
+		len(preview.Repos),
+		action,
+	)
+
+	if preview.Truncated {
+		summary += fmt.Sprintf(" Only the first %d artifacts were searched, the actual numbers are higher.", PolicyPreviewMaxArtifacts)
+	}
+
+	if len(preview.Packages) > 0 {
+		summary += "\n\nLargest package versions:\n" + strings.Join(
+			lo.Map(preview.Packages, func(p PolicyPreviewPackage, _ int) string {
+				return fmt.Sprintf("- %s/%s (%d artifact(s), %d byte(s))", p.Repo, p.Path, p.ArtifactCount, p.SizeBytes)
+			}),
+			"\n",
+		)
+	}
+
+	return summary
+}
+
+func previewOnPlanSchema(action string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		MarkdownDescription: fmt.Sprintf("When set to `true`, `terraform plan` shows a warning with an estimate of the packages that the policy would %s: "+
+			"the number of package versions and artifacts, their total size and the largest package versions. "+
+			"See the `artifactory_cleanup_policy_preview` data source for how the estimate is computed. Default value is `false`.", action),
+	}
+}
+
+// addPolicyPreviewWarning adds the policy preview of the planned search criteria as a plan warning.
+// Errors are reported as warnings as well, the preview must not prevent managing the policy.
+func addPolicyPreviewWarning(ctx context.Context, client *resty.Client, searchCriteria types.Object, action string, diags *diag.Diagnostics) {
+	if client == nil {
+		return
+	}
+
+	// the preview is deferred until the search criteria is known
+	tfValue, err := searchCriteria.ToTerraformValue(ctx)
+	if err != nil || !tfValue.IsFullyKnown() {
+		return
+	}
+
+	criteria, d := PolicySearchCriteriaFromObject(ctx, searchCriteria)
+	diags.Append(d...)
+	if d.HasError() {
+		return
+	}
+
+	preview, err := PreviewPolicy(ctx, client, criteria, PolicyPreviewDefaultSampleSize)
+	if err != nil {
+		diags.AddAttributeWarning(path.Root("preview_on_plan"), "Unable to preview policy", err.Error())
+		return
+	}
+
+	diags.AddAttributeWarning(path.Root("search_criteria"), "Policy preview", PolicyPreviewSummary(preview, action))
+}
@@ -0,0 +1,110 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPolicyPreviewPatternRegex(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		value   string
+		match   bool
+	}{
+		{"**", "com/example/app/1.0", true},
+		{"docker-*", "docker-local", true},
+		{"docker-*", "npm-local", false},
+		{"com/*/app/**", "com/example/app/1.0", true},
+		{"com/*/app/**", "com/example/nested/app/1.0", false},
+		{"lib?", "lib1", true},
+		{"my.repo", "myXrepo", false},
+	}
+
+	for _, tc := range testCases {
+		if got := policyPreviewPatternRegex(tc.pattern).MatchString(tc.value); got != tc.match {
+			t.Errorf("pattern %q on %q: expected %t, got %t", tc.pattern, tc.value, tc.match, got)
+		}
+	}
+}
+
+func TestPolicyPreviewQuery(t *testing.T) {
+	days := int64(30)
+	months := int64(6)
+
+	query, err := policyPreviewQuery([]string{"generic-local"}, PolicySearchCriteriaAPIModel{
+		CreatedBeforeInDays:          &days,
+		LastDownloadedBeforeInMonths: &months,
+		IncludedProperties:           map[string][]string{"build.name": {"app"}},
+		ExcludedProperties:           map[string][]string{"keep": {"true"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, expected := range []string{
+		`{"repo":"generic-local"}`,
+		`"created":{"$before":"30d"}`,
+		`"stat.downloaded":{"$before":"6mo"}`,
+		`{"$and":[{"stat.downloaded":{"$eq":null}},{"created":{"$before":"6mo"}}]}`,
+		`"@build.name":{"$eq":"app"}`,
+		`"property"`,
+		`.limit(10000)`,
+	} {
+		if !strings.Contains(query, expected) {
+			t.Errorf("expected query to contain %s, got %s", expected, query)
+		}
+	}
+}
+
+func TestPolicyPreviewPackages(t *testing.T) {
+	item := func(path, name, created string, size int64, properties ...policyPreviewItemProperty) policyPreviewItem {
+		return policyPreviewItem{Repo: "maven-local", Path: path, Name: name, Created: created, Size: size, Properties: properties}
+	}
+
+	items := []policyPreviewItem{
+		item("com/example/app/1.0", "app-1.0.jar", "2025-01-01T00:00:00.000Z", 10),
+		item("com/example/app/1.0", "app-1.0.pom", "2025-01-01T00:00:00.000Z", 1),
+		item("com/example/app/2.0", "app-2.0.jar", "2025-02-01T00:00:00.000Z", 20),
+		item("com/example/app/3.0", "app-3.0.jar", "2025-03-01T00:00:00.000Z", 30),
+		item("com/example/lib/1.0", "lib-1.0.jar", "2025-01-01T00:00:00.000Z", 5, policyPreviewItemProperty{Key: "keep", Value: "true"}),
+		item("org/other/1.0", "other-1.0.jar", "2025-01-01T00:00:00.000Z", 7),
+	}
+
+	excludedPackages := []string{"org/**"}
+	packages := policyPreviewPackages(items, PolicySearchCriteriaAPIModel{
+		IncludedPackages:   []string{"**"},
+		ExcludedPackages:   &excludedPackages,
+		ExcludedProperties: map[string][]string{"keep": {"true"}},
+	})
+
+	if len(packages) != 3 {
+		t.Fatalf("expected 3 package versions, got %d: %v", len(packages), packages)
+	}
+	if packages[0].Path != "com/example/app/1.0" || packages[0].ArtifactCount != 2 || packages[0].SizeBytes != 11 {
+		t.Errorf("unexpected first package version: %v", packages[0])
+	}
+
+	keep := int64(2)
+	packages = policyPreviewPackages(items, PolicySearchCriteriaAPIModel{
+		IncludedPackages:  []string{"com/example/app/*"},
+		KeepLastNVersions: &keep,
+	})
+
+	if len(packages) != 1 || packages[0].Path != "com/example/app/1.0" {
+		t.Errorf("expected only the oldest version to be affected, got %v", packages)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// PolicyExecution is one run of a cleanup or archive policy.
+type PolicyExecution struct {
+	ExecutionID string `json:"executionId"`
+	Status      string `json:"status"`
+	StartTime   string `json:"startTime"`
+	EndTime     string `json:"endTime"`
+	// ItemsCount is the number of items removed (cleanup) or archived (archive)
+	ItemsCount int64 `json:"itemsCount"`
+	// TotalSize is the size in bytes of the items removed or archived
+	TotalSize int64 `json:"totalSize"`
+}
+
+type policyExecutionsAPIModel struct {
+	Executions []PolicyExecution `json:"executions"`
+}
+
+// RunPolicy triggers an immediate run of a cleanup or archive policy. The run is asynchronous,
+// its outcome is available from GetPolicyExecutions.
+func RunPolicy(client *resty.Client, policyType, key string) error {
+	var jfrogErrors util.JFrogErrors
+	response, err := client.R().
+		SetPathParam("policyKey", key).
+		SetError(&jfrogErrors).
+		Post(policyEndpoints[policyType] + "/run")
+	if err != nil {
+		return err
+	}
+	if response.IsError() {
+		return fmt.Errorf("failed to run %s policy %s: %s", policyType, key, jfrogErrors.String())
+	}
+
+	return nil
+}
+
+// GetPolicyExecutions returns the most recent runs of a cleanup or archive policy, the latest first.
+func GetPolicyExecutions(client *resty.Client, policyType, key string, limit int) ([]PolicyExecution, error) {
+	var executions policyExecutionsAPIModel
+	var jfrogErrors util.JFrogErrors
+	response, err := client.R().
+		SetPathParam("policyKey", key).
+		SetQueryParam("limit", strconv.Itoa(limit)).
+		SetResult(&executions).
+		SetError(&jfrogErrors).
+		Get(policyEndpoints[policyType] + "/executions")
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode() == http.StatusNotFound {
+		return nil, fmt.Errorf("%s policy %s not found", policyType, key)
+	}
+	if response.IsError() {
+		return nil, fmt.Errorf("failed to get executions of %s policy %s: %s", policyType, key, jfrogErrors.String())
+	}
+
+	if len(executions.Executions) > limit {
+		return executions.Executions[:limit], nil
+	}
+
+	return executions.Executions, nil
+}
+
+var runTriggerSchema = schema.StringAttribute{
+	Optional: true,
+	MarkdownDescription: "Any value, e.g. a timestamp or a version. When set on creation, or changed, the policy is run immediately after it is " +
+		"saved, in addition to its `cron_expression` schedule. The run is asynchronous, use the `artifactory_cleanup_policy_executions` " +
+		"data source to get its outcome. A failure to start the run is reported as a warning. Removing the value doesn't run the policy.",
+}
+
+// runPolicyOnTrigger runs the policy when `run_trigger` is set and differs from the prior value. The policy has
+// already been saved, so a failure to run it is only reported as a warning and doesn't taint the resource.
+func runPolicyOnTrigger(client *resty.Client, policyType, key string, runTrigger, priorRunTrigger types.String, diags *diag.Diagnostics) {
+	if runTrigger.IsNull() || runTrigger.IsUnknown() || runTrigger.Equal(priorRunTrigger) {
+		return
+	}
+
+	if err := RunPolicy(client, policyType, key); err != nil {
+		diags.AddAttributeWarning(
+			path.Root("run_trigger"),
+			"Unable to run policy",
+			fmt.Sprintf("The policy was saved but could not be run, change `run_trigger` to retry: %s", err),
+		)
+	}
+}
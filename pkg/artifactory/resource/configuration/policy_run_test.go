@@ -0,0 +1,118 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func policyRunServer(t *testing.T, handler http.HandlerFunc) *resty.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return resty.New().SetBaseURL(server.URL)
+}
+
+func TestRunPolicy(t *testing.T) {
+	var method, requestPath string
+	client := policyRunServer(t, func(w http.ResponseWriter, r *http.Request) {
+		method, requestPath = r.Method, r.URL.Path
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	if err := RunPolicy(client, PolicyTypeArchive, "my-policy"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if method != http.MethodPost || requestPath != "/artifactory/api/archive/v2/packages/policies/my-policy/run" {
+		t.Errorf("unexpected request: %s %s", method, requestPath)
+	}
+}
+
+func TestGetPolicyExecutions(t *testing.T) {
+	var requestPath, limit string
+	client := policyRunServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requestPath, limit = r.URL.Path, r.URL.Query().Get("limit")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"executions": [
+				{"executionId": "2", "status": "RUNNING", "startTime": "2025-01-02T00:00:00Z"},
+				{"executionId": "1", "status": "COMPLETED", "startTime": "2025-01-01T00:00:00Z", "endTime": "2025-01-01T00:01:00Z", "itemsCount": 3, "totalSize": 1024},
+				{"executionId": "0", "status": "FAILED"}
+			]
+		}`))
+	})
+
+	executions, err := GetPolicyExecutions(client, PolicyTypeArchive, "my-policy", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if requestPath != "/artifactory/api/archive/v2/packages/policies/my-policy/executions" || limit != "2" {
+		t.Errorf("unexpected request: %s?limit=%s", requestPath, limit)
+	}
+
+	expected := PolicyExecution{
+		ExecutionID: "1",
+		Status:      "COMPLETED",
+		StartTime:   "2025-01-01T00:00:00Z",
+		EndTime:     "2025-01-01T00:01:00Z",
+		ItemsCount:  3,
+		TotalSize:   1024,
+	}
+	if len(executions) != 2 || executions[0].ExecutionID != "2" || executions[1] != expected {
+		t.Errorf("unexpected executions: %+v", executions)
+	}
+}
+
+func TestGetPolicyExecutionsNotFound(t *testing.T) {
+	client := policyRunServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if _, err := GetPolicyExecutions(client, PolicyTypeArchive, "my-policy", 10); err == nil {
+		t.Fatal("expected an error for a missing policy")
+	}
+}
+
+func TestRunPolicyOnTriggerFailureIsWarning(t *testing.T) {
+	requests := 0
+	client := policyRunServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	diags := diag.Diagnostics{}
+	runPolicyOnTrigger(client, PolicyTypeArchive, "my-policy", types.StringValue("1"), types.StringNull(), &diags)
+
+	if requests != 1 || diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("expected a single run reported as a warning, got %d requests and %v", requests, diags)
+	}
+
+	diags = diag.Diagnostics{}
+	runPolicyOnTrigger(client, PolicyTypeArchive, "my-policy", types.StringValue("1"), types.StringValue("1"), &diags)
+
+	if requests != 1 || len(diags) != 0 {
+		t.Errorf("expected no run for an unchanged trigger, got %d requests and %v", requests, diags)
+	}
+}
@@ -16,14 +16,11 @@ package configuration
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -33,257 +30,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
 )
 
-// Custom validator for search_criteria to enforce validation rules
-type searchCriteriaValidator struct{}
-
-func (v searchCriteriaValidator) Description(ctx context.Context) string {
-	return "Validates policy conditions: time-based and/or properties-based conditions can be combined; version-based condition (keep_last_n_versions) is mutually exclusive with all other condition types"
-}
-
-func (v searchCriteriaValidator) MarkdownDescription(ctx context.Context) string {
-	return "Validates policy conditions: time-based and/or properties-based conditions can be combined; version-based condition (`keep_last_n_versions`) is mutually exclusive with all other condition types"
-}
-
-func (v searchCriteriaValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
-	// Get the object value
-	obj := req.ConfigValue
-
-	// If the object is null or unknown, skip validation
-	if obj.IsNull() || obj.IsUnknown() {
-		return
-	}
-
-	// Get the attributes
-	attrs := obj.Attributes()
-
-	// Helper function to check if a value is unknown
-	isUnknown := func(key string) bool {
-		if v, ok := attrs[key]; ok {
-			return v.IsUnknown()
-		}
-		return false
-	}
-
-	// Helper function to get int64 value
-	getInt64 := func(key string) types.Int64 {
-		if v, ok := attrs[key]; ok && !v.IsNull() && !v.IsUnknown() {
-			if val, ok := v.(types.Int64); ok {
-				return val
-			}
-		}
-		return types.Int64Null()
-	}
-
-	// Check for time-based conditions (days) - for Artifactory 7.111.2+
-	createdBeforeInDays := getInt64("created_before_in_days")
-	lastDownloadedBeforeInDays := getInt64("last_downloaded_before_in_days")
-
-	// Check for time-based conditions (months) - for Artifactory < 7.111.2
-	createdBeforeInMonths := getInt64("created_before_in_months")
-	lastDownloadedBeforeInMonths := getInt64("last_downloaded_before_in_months")
-
-	// Version-based condition (available in both versions)
-	keepLastNVersions := getInt64("keep_last_n_versions")
-
-	// Helper function to check if properties are unknown
-	checkPropertiesUnknown := func(key string) bool {
-		if v, ok := attrs[key]; ok {
-			return v.IsUnknown()
-		}
-		return false
-	}
-
-	// If any condition-related attribute is unknown (e.g., when using variables),
-	// skip validation to avoid false positives during terraform validate
-	if isUnknown("created_before_in_days") ||
-		isUnknown("last_downloaded_before_in_days") ||
-		isUnknown("created_before_in_months") ||
-		isUnknown("last_downloaded_before_in_months") ||
-		isUnknown("keep_last_n_versions") ||
-		checkPropertiesUnknown("included_properties") {
-		return
-	}
-
-	// Helper function to check if properties are set
-	checkPropertiesSet := func(key string) bool {
-		if v, ok := attrs[key]; ok && !v.IsNull() && !v.IsUnknown() {
-			if m, ok := v.(types.Map); ok {
-				return len(m.Elements()) > 0
-			}
-		}
-		return false
-	}
-
-	// Check if days attributes are set (7.111.2+)
-	createdDaysSet := !createdBeforeInDays.IsNull() && !createdBeforeInDays.IsUnknown() && createdBeforeInDays.ValueInt64() > 0
-	downloadedDaysSet := !lastDownloadedBeforeInDays.IsNull() && !lastDownloadedBeforeInDays.IsUnknown() && lastDownloadedBeforeInDays.ValueInt64() > 0
-	timeBasedDaysSet := createdDaysSet || downloadedDaysSet
-
-	// Check if months attributes are set (< 7.111.2)
-	createdMonthsSet := !createdBeforeInMonths.IsNull() && !createdBeforeInMonths.IsUnknown() && createdBeforeInMonths.ValueInt64() > 0
-	downloadedMonthsSet := !lastDownloadedBeforeInMonths.IsNull() && !lastDownloadedBeforeInMonths.IsUnknown() && lastDownloadedBeforeInMonths.ValueInt64() > 0
-	timeBasedMonthsSet := createdMonthsSet || downloadedMonthsSet
-
-	// Version-based condition
-	keepSet := !keepLastNVersions.IsNull() && !keepLastNVersions.IsUnknown() && keepLastNVersions.ValueInt64() > 0
-
-	// Check for zero values in time-based conditions
-	if (!createdBeforeInDays.IsNull() && !createdBeforeInDays.IsUnknown() && createdBeforeInDays.ValueInt64() == 0) ||
-		(!lastDownloadedBeforeInDays.IsNull() && !lastDownloadedBeforeInDays.IsUnknown() && lastDownloadedBeforeInDays.ValueInt64() == 0) ||
-		(!createdBeforeInMonths.IsNull() && !createdBeforeInMonths.IsUnknown() && createdBeforeInMonths.ValueInt64() == 0) ||
-		(!lastDownloadedBeforeInMonths.IsNull() && !lastDownloadedBeforeInMonths.IsUnknown() && lastDownloadedBeforeInMonths.ValueInt64() == 0) {
-		resp.Diagnostics.AddAttributeError(
-			req.Path,
-			"Invalid Policy Configuration",
-			"Time-based conditions must have a value greater than 0. Zero values are not allowed for `created_before_in_days`, `last_downloaded_before_in_days`, `created_before_in_months`, or `last_downloaded_before_in_months`.",
-		)
-		return
-	}
-
-	// Check for zero values in version-based condition
-	if !keepLastNVersions.IsNull() && !keepLastNVersions.IsUnknown() && keepLastNVersions.ValueInt64() == 0 {
-		resp.Diagnostics.AddAttributeError(
-			req.Path,
-			"Invalid Policy Configuration",
-			"Version-based condition (keep_last_n_versions) must have a value greater than 0. Zero values are not allowed.",
-		)
-		return
-	}
-
-	// Properties-based conditions (only included_properties)
-	includedPropertiesSet := checkPropertiesSet("included_properties")
-	propertiesBasedSet := includedPropertiesSet
-
-	// Check for mixed usage of days and months (invalid)
-	if timeBasedDaysSet && timeBasedMonthsSet {
-		resp.Diagnostics.AddAttributeError(
-			req.Path,
-			"Invalid Policy Configuration",
-			"Cannot use both days-based conditions (`created_before_in_days`, `last_downloaded_before_in_days`) and months-based conditions (`created_before_in_months`, `last_downloaded_before_in_months`) together. Use either days-based or months-based conditions based on your Artifactory version.",
-		)
-		return
-	}
-
-	// Check for time-based conditions (either days or months)
-	timeBasedSet := timeBasedDaysSet || timeBasedMonthsSet
-
-	// Must specify at least one condition
-	if !timeBasedSet && !keepSet && !propertiesBasedSet {
-		resp.Diagnostics.AddAttributeError(
-			req.Path,
-			"Invalid Policy Configuration",
-			"A policy must specify at least one condition: time-based conditions (days-based or months-based), version-based condition (`keep_last_n_versions`), or properties-based condition (`included_properties`). Time-based and properties-based conditions can be combined.",
-		)
-		return
-	}
-
-	// Version-based condition is mutually exclusive with time-based and properties-based conditions
-	if keepSet && (timeBasedSet || propertiesBasedSet) {
-		resp.Diagnostics.AddAttributeError(
-			req.Path,
-			"Invalid Policy Configuration",
-			"Version-based condition (`keep_last_n_versions`) cannot be combined with time-based conditions or properties-based condition (`included_properties`). Use `keep_last_n_versions` alone, or use time-based and/or properties-based conditions without `keep_last_n_versions`.",
-		)
-		return
-	}
-}
-
-type singleKeySingleValueMapValidator struct{}
-
-func (v singleKeySingleValueMapValidator) Description(ctx context.Context) string {
-	return "Must have exactly one key and that key must have exactly one string value"
-}
-
-func (v singleKeySingleValueMapValidator) MarkdownDescription(ctx context.Context) string {
-	return "Must have exactly one key and that key must have exactly one string value"
-}
-
-func (v singleKeySingleValueMapValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
-	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
-		return
-	}
-
-	m := req.ConfigValue.Elements()
-	if len(m) != 1 {
-		resp.Diagnostics.AddAttributeError(
-			req.Path,
-			"Invalid Properties",
-			"Properties-based conditions must have exactly one key.",
-		)
-		return
-	}
-
-	for _, v := range m {
-		if v.IsNull() || v.IsUnknown() {
-			continue
-		}
-		if l, ok := v.(types.List); ok {
-			if len(l.Elements()) != 1 {
-				resp.Diagnostics.AddAttributeError(
-					req.Path,
-					"Invalid Properties",
-					"The property value must be a list with exactly one string value.",
-				)
-			}
-		}
-	}
-}
-
-var yumPolicyPackageType = "yum" // Only used by cleanup and archive policies as RPM
-
-var archivePolicySupportedPackageType = []string{
-	repository.AlpinePackageType,
-	repository.AnsiblePackageType,
-	repository.CargoPackageType,
-	repository.ChefPackageType,
-	repository.CocoapodsPackageType,
-	repository.ComposerPackageType,
-	repository.ConanPackageType,
-	repository.CondaPackageType,
-	repository.DebianPackageType,
-	repository.DockerPackageType,
-	repository.GemsPackageType,
-	repository.GenericPackageType,
-	repository.GoPackageType,
-	repository.GradlePackageType,
-	repository.HelmPackageType,
-	repository.HelmOCIPackageType,
-	repository.HuggingFacePackageType,
-	repository.MachineLearningType,
-	repository.MavenPackageType,
-	repository.NPMPackageType,
-	repository.NugetPackageType,
-	repository.OCIPackageType,
-	repository.OpkgPackageType,
-	repository.PuppetPackageType,
-	repository.PyPiPackageType,
-	repository.SBTPackageType,
-	repository.SwiftPackageType,
-	repository.TerraformPackageType,
-	repository.TerraformBackendPackageType,
-	repository.VagrantPackageType,
-	repository.RPMPackageType,
-	yumPolicyPackageType,
-}
-
 func NewArchivePolicyResource() resource.Resource {
 	return &ArchivePolicyResource{
 		JFrogResource: util.JFrogResource{
-			TypeName:                "artifactory_archive_policy",
-			ValidArtifactoryVersion: "7.102.0",
-			DocumentEndpoint:        "artifactory/api/archive/v2/packages/policies/{policyKey}",
+			TypeName:         "artifactory_archive_policy",
+			DocumentEndpoint: "artifactory/api/archive/v2/packages/policies/{policyKey}",
 		},
 		EnablementEndpoint: "artifactory/api/archive/v2/packages/policies/{policyKey}/enablement",
 	}
 }
 
 var _ resource.Resource = (*ArchivePolicyResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*ArchivePolicyResource)(nil)
 
 type ArchivePolicyResource struct {
 	util.JFrogResource
@@ -299,72 +65,13 @@ type ArchivePolicyResourceModel struct {
 	Enabled           types.Bool   `tfsdk:"enabled"`
 	SkipTrashcan      types.Bool   `tfsdk:"skip_trashcan"`
 	ProjectKey        types.String `tfsdk:"project_key"`
+	PreviewOnPlan     types.Bool   `tfsdk:"preview_on_plan"`
+	RunTrigger        types.String `tfsdk:"run_trigger"`
 	SearchCriteria    types.Object `tfsdk:"search_criteria"`
 }
 
 func (r ArchivePolicyResourceModel) toAPIModel(ctx context.Context, apiModel *ArchivePolicyAPIModel) diag.Diagnostics {
-	diags := diag.Diagnostics{}
-
-	attrs := r.SearchCriteria.Attributes()
-
-	// Helper function to safely get int64 pointer
-	getInt64Pointer := func(key string) *int64 {
-		if v, ok := attrs[key]; ok && !v.IsNull() && !v.IsUnknown() {
-			if val, ok := v.(types.Int64); ok {
-				return val.ValueInt64Pointer()
-			}
-		}
-		return nil
-	}
-
-	searchCriteria := ArchivePolicySearchCriteriaAPIModel{
-		IncludeAllProjects:           attrs["include_all_projects"].(types.Bool).ValueBoolPointer(),
-		CreatedBeforeInMonths:        getInt64Pointer("created_before_in_months"),
-		LastDownloadedBeforeInMonths: getInt64Pointer("last_downloaded_before_in_months"),
-		CreatedBeforeInDays:          getInt64Pointer("created_before_in_days"),
-		LastDownloadedBeforeInDays:   getInt64Pointer("last_downloaded_before_in_days"),
-		KeepLastNVersions:            getInt64Pointer("keep_last_n_versions"),
-	}
-
-	diags.Append(attrs["package_types"].(types.Set).ElementsAs(ctx, &searchCriteria.PackageTypes, false)...)
-	diags.Append(attrs["repos"].(types.Set).ElementsAs(ctx, &searchCriteria.Repos, false)...)
-	diags.Append(attrs["excluded_repos"].(types.Set).ElementsAs(ctx, &searchCriteria.ExcludedRepos, false)...)
-	diags.Append(attrs["included_packages"].(types.Set).ElementsAs(ctx, &searchCriteria.IncludedPackages, false)...)
-	diags.Append(attrs["excluded_packages"].(types.Set).ElementsAs(ctx, &searchCriteria.ExcludedPackages, false)...)
-	diags.Append(attrs["included_projects"].(types.Set).ElementsAs(ctx, &searchCriteria.IncludedProjects, false)...)
-
-	if v, ok := attrs["included_properties"]; ok && !v.IsNull() && !v.IsUnknown() {
-		if m, ok := v.(types.Map); ok {
-			searchCriteria.IncludedProperties = make(map[string][]string)
-			for k, val := range m.Elements() {
-				if l, ok := val.(types.List); ok && !l.IsNull() && !l.IsUnknown() {
-					var values []string
-					for _, lv := range l.Elements() {
-						if s, ok := lv.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
-							values = append(values, s.ValueString())
-						}
-					}
-					searchCriteria.IncludedProperties[k] = values
-				}
-			}
-		}
-	}
-	if v, ok := attrs["excluded_properties"]; ok && !v.IsNull() && !v.IsUnknown() {
-		if m, ok := v.(types.Map); ok {
-			searchCriteria.ExcludedProperties = make(map[string][]string)
-			for k, val := range m.Elements() {
-				if l, ok := val.(types.List); ok && !l.IsNull() && !l.IsUnknown() {
-					var values []string
-					for _, lv := range l.Elements() {
-						if s, ok := lv.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
-							values = append(values, s.ValueString())
-						}
-					}
-					searchCriteria.ExcludedProperties[k] = values
-				}
-			}
-		}
-	}
+	searchCriteria, diags := PolicySearchCriteriaFromObject(ctx, r.SearchCriteria)
 
 	*apiModel = ArchivePolicyAPIModel{
 		Key:               r.Key.ValueString(),
@@ -384,139 +91,13 @@ func (r *ArchivePolicyResourceModel) fromAPIModel(ctx context.Context, apiModel
 
 	r.Key = types.StringValue(apiModel.Key)
 	r.Description = types.StringValue(apiModel.Description)
-	r.CronExpression = types.StringValue(apiModel.CronExpression)
+	r.CronExpression = normalizeEmptyAPIString(apiModel.CronExpression, r.CronExpression)
 	r.DurationInMinutes = types.Int64Value(apiModel.DurationInMinutes)
 	r.Enabled = types.BoolValue(apiModel.Enabled)
 	r.SkipTrashcan = types.BoolValue(apiModel.SkipTrashcan)
 
-	packageTypes, ds := types.SetValueFrom(ctx, types.StringType, apiModel.SearchCriteria.PackageTypes)
-	if ds.HasError() {
-		diags.Append(ds...)
-	}
-
-	repos, ds := types.SetValueFrom(ctx, types.StringType, apiModel.SearchCriteria.Repos)
-	if ds.HasError() {
-		diags.Append(ds...)
-	}
-
-	excludedRepos := types.SetNull(types.StringType)
-	if apiModel.SearchCriteria.ExcludedRepos != nil {
-		set, ds := types.SetValueFrom(ctx, types.StringType, apiModel.SearchCriteria.ExcludedRepos)
-		if ds.HasError() {
-			diags.Append(ds...)
-		}
-		excludedRepos = set
-	}
-
-	includedPackages, ds := types.SetValueFrom(ctx, types.StringType, apiModel.SearchCriteria.IncludedPackages)
-	if ds.HasError() {
-		diags.Append(ds...)
-	}
-
-	excludedPackages := types.SetNull(types.StringType)
-	if apiModel.SearchCriteria.ExcludedPackages != nil {
-		set, ds := types.SetValueFrom(ctx, types.StringType, apiModel.SearchCriteria.ExcludedPackages)
-		if ds.HasError() {
-			diags.Append(ds...)
-		}
-		excludedPackages = set
-	}
-
-	includedProjects, ds := types.SetValueFrom(ctx, types.StringType, apiModel.SearchCriteria.IncludedProjects)
-	if ds.HasError() {
-		diags.Append(ds...)
-	}
-
-	includedProperties := types.MapNull(types.ListType{ElemType: types.StringType})
-	if apiModel.SearchCriteria.IncludedProperties != nil {
-		m := map[string]attr.Value{}
-		for k, v := range apiModel.SearchCriteria.IncludedProperties {
-			lv, ds := types.ListValueFrom(ctx, types.StringType, v)
-			if ds.HasError() {
-				diags.Append(ds...)
-			}
-			m[k] = lv
-		}
-		includedProperties, _ = types.MapValue(types.ListType{ElemType: types.StringType}, m)
-	}
-
-	excludedProperties := types.MapNull(types.ListType{ElemType: types.StringType})
-	if apiModel.SearchCriteria.ExcludedProperties != nil {
-		m := map[string]attr.Value{}
-		for k, v := range apiModel.SearchCriteria.ExcludedProperties {
-			lv, ds := types.ListValueFrom(ctx, types.StringType, v)
-			if ds.HasError() {
-				diags.Append(ds...)
-			}
-			m[k] = lv
-		}
-		excludedProperties, _ = types.MapValue(types.ListType{ElemType: types.StringType}, m)
-	}
-
-	// Handle time-based attributes with proper null checking
-	createdBeforeInMonths := types.Int64Null()
-	if apiModel.SearchCriteria.CreatedBeforeInMonths != nil {
-		createdBeforeInMonths = types.Int64PointerValue(apiModel.SearchCriteria.CreatedBeforeInMonths)
-	}
-
-	lastDownloadedBeforeInMonths := types.Int64Null()
-	if apiModel.SearchCriteria.LastDownloadedBeforeInMonths != nil {
-		lastDownloadedBeforeInMonths = types.Int64PointerValue(apiModel.SearchCriteria.LastDownloadedBeforeInMonths)
-	}
-
-	// Always set day-based attributes to ensure they are known values
-	createdBeforeInDays := types.Int64Null()
-	if apiModel.SearchCriteria.CreatedBeforeInDays != nil {
-		createdBeforeInDays = types.Int64PointerValue(apiModel.SearchCriteria.CreatedBeforeInDays)
-	}
-
-	lastDownloadedBeforeInDays := types.Int64Null()
-	if apiModel.SearchCriteria.LastDownloadedBeforeInDays != nil {
-		lastDownloadedBeforeInDays = types.Int64PointerValue(apiModel.SearchCriteria.LastDownloadedBeforeInDays)
-	}
-
-	keepLastNVersions := types.Int64Null()
-	if apiModel.SearchCriteria.KeepLastNVersions != nil {
-		keepLastNVersions = types.Int64PointerValue(apiModel.SearchCriteria.KeepLastNVersions)
-	}
-
-	searchCriteria, ds := types.ObjectValue(
-		map[string]attr.Type{
-			"package_types":                    types.SetType{ElemType: types.StringType},
-			"repos":                            types.SetType{ElemType: types.StringType},
-			"excluded_repos":                   types.SetType{ElemType: types.StringType},
-			"included_packages":                types.SetType{ElemType: types.StringType},
-			"excluded_packages":                types.SetType{ElemType: types.StringType},
-			"include_all_projects":             types.BoolType,
-			"included_projects":                types.SetType{ElemType: types.StringType},
-			"created_before_in_months":         types.Int64Type,
-			"last_downloaded_before_in_months": types.Int64Type,
-			"created_before_in_days":           types.Int64Type,
-			"last_downloaded_before_in_days":   types.Int64Type,
-			"keep_last_n_versions":             types.Int64Type,
-			"included_properties":              types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
-			"excluded_properties":              types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
-		},
-		map[string]attr.Value{
-			"package_types":                    packageTypes,
-			"repos":                            repos,
-			"excluded_repos":                   excludedRepos,
-			"included_packages":                includedPackages,
-			"excluded_packages":                excludedPackages,
-			"include_all_projects":             types.BoolPointerValue(apiModel.SearchCriteria.IncludeAllProjects),
-			"included_projects":                includedProjects,
-			"created_before_in_months":         createdBeforeInMonths,
-			"last_downloaded_before_in_months": lastDownloadedBeforeInMonths,
-			"created_before_in_days":           createdBeforeInDays,
-			"last_downloaded_before_in_days":   lastDownloadedBeforeInDays,
-			"keep_last_n_versions":             keepLastNVersions,
-			"included_properties":              includedProperties,
-			"excluded_properties":              excludedProperties,
-		},
-	)
-	if ds.HasError() {
-		diags.Append(ds...)
-	}
+	searchCriteria, ds := policySearchCriteriaToObject(ctx, apiModel.SearchCriteria)
+	diags.Append(ds...)
 
 	r.SearchCriteria = searchCriteria
 
@@ -524,31 +105,14 @@ func (r *ArchivePolicyResourceModel) fromAPIModel(ctx context.Context, apiModel
 }
 
 type ArchivePolicyAPIModel struct {
-	Key               string                              `json:"key"`
-	Description       string                              `json:"description,omitempty"`
-	CronExpression    string                              `json:"cronExp"`
-	DurationInMinutes int64                               `json:"durationInMinutes"`
-	Enabled           bool                                `json:"enabled,omitempty"`
-	SkipTrashcan      bool                                `json:"skipTrashcan"`
-	ProjectKey        string                              `json:"projectKey"`
-	SearchCriteria    ArchivePolicySearchCriteriaAPIModel `json:"searchCriteria"`
-}
-
-type ArchivePolicySearchCriteriaAPIModel struct {
-	PackageTypes                 []string            `json:"packageTypes"`
-	Repos                        []string            `json:"repos"`
-	ExcludedRepos                *[]string           `json:"excludedRepos,omitempty"`
-	IncludedPackages             []string            `json:"includedPackages"`
-	ExcludedPackages             *[]string           `json:"excludedPackages,omitempty"`
-	IncludeAllProjects           *bool               `json:"includeAllProjects,omitempty"`
-	IncludedProjects             *[]string           `json:"includedProjects,omitempty"`
-	CreatedBeforeInMonths        *int64              `json:"createdBeforeInMonths,omitempty"`
-	LastDownloadedBeforeInMonths *int64              `json:"lastDownloadedBeforeInMonths,omitempty"`
-	CreatedBeforeInDays          *int64              `json:"createdBeforeInDays,omitempty"`
-	LastDownloadedBeforeInDays   *int64              `json:"lastDownloadedBeforeInDays,omitempty"`
-	KeepLastNVersions            *int64              `json:"keepLastNVersions,omitempty"`
-	ExcludedProperties           map[string][]string `json:"excludedProperties,omitempty"`
-	IncludedProperties           map[string][]string `json:"includedProperties,omitempty"`
+	Key               string                       `json:"key"`
+	Description       string                       `json:"description,omitempty"`
+	CronExpression    string                       `json:"cronExp"`
+	DurationInMinutes int64                        `json:"durationInMinutes"`
+	Enabled           bool                         `json:"enabled,omitempty"`
+	SkipTrashcan      bool                         `json:"skipTrashcan"`
+	ProjectKey        string                       `json:"projectKey"`
+	SearchCriteria    PolicySearchCriteriaAPIModel `json:"searchCriteria"`
 }
 
 type ArchivePolicyEnablementAPIModel struct {
@@ -607,117 +171,13 @@ func (r *ArchivePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 				Description: "This attribute is used only for project-level archive V2 policies, it is not used for global-level policies.",
 			},
-			"search_criteria": schema.SingleNestedAttribute{
-				Attributes: map[string]schema.Attribute{
-					"package_types": schema.SetAttribute{
-						ElementType: types.StringType,
-						Required:    true,
-						Validators: []validator.Set{
-							setvalidator.SizeAtLeast(1),
-							setvalidator.ValueStringsAre(
-								stringvalidator.OneOf(archivePolicySupportedPackageType...),
-							),
-						},
-						MarkdownDescription: fmt.Sprintf("The package types that are archived by the policy. Support: %s.", strings.Join(archivePolicySupportedPackageType, ", ")),
-					},
-					"repos": schema.SetAttribute{
-						ElementType: types.StringType,
-						Required:    true,
-						Validators: []validator.Set{
-							setvalidator.SizeAtLeast(1),
-						},
-						MarkdownDescription: "Specify one or more patterns for the repository name(s) on which you want the archive policy to run. You can also specify explicit repository names. Specifying at least one pattern or explicit name is required. Only packages in repositories that match the pattern or explicit name will be archived. For including all repos use `**`. Example: `repos = [\"**\"]`",
-					},
-					"excluded_repos": schema.SetAttribute{
-						ElementType: types.StringType,
-						Optional:    true,
-						Validators: []validator.Set{
-							setvalidator.SizeAtLeast(1),
-						},
-						MarkdownDescription: "Specify patterns for repository names or explicit repository names that you want excluded from the archive policy.",
-					},
-					"included_packages": schema.SetAttribute{
-						ElementType: types.StringType,
-						Required:    true,
-						Validators: []validator.Set{
-							setvalidator.SizeBetween(1, 1),
-						},
-						MarkdownDescription: "Specify a pattern for a package name or an explicit package name. It accept only single element which can be specific package or pattern, and for including all packages use `**`. Example: `included_packages = [\"**\"]`",
-					},
-					"excluded_packages": schema.SetAttribute{
-						ElementType: types.StringType,
-						Optional:    true,
-						Validators: []validator.Set{
-							setvalidator.SizeAtLeast(1),
-						},
-						MarkdownDescription: "Specify explicit package names that you want excluded from the policy. Only Name explicit names (and not patterns) are accepted.",
-					},
-					"include_all_projects": schema.BoolAttribute{
-						Optional:    true,
-						Description: "Set this value to `true` if you want the policy to run on all Artifactory projects. The default value is `false`.\n\n~>This attribute is relevant only on the global level, for Platform Admins.",
-					},
-					"included_projects": schema.SetAttribute{
-						ElementType: types.StringType,
-						Required:    true,
-						Validators: []validator.Set{
-							setvalidator.SizeAtLeast(0),
-						},
-						MarkdownDescription: "List of projects on which you want this policy to run. To include repositories that are not assigned to any project, enter the project key `default`.\n\n" +
-							"~>This setting is relevant only on the global level, for Platform Admins.",
-					},
-					"created_before_in_months": schema.Int64Attribute{
-						Optional:            true,
-						Computed:            true,
-						MarkdownDescription: "The archive policy will archive packages based on how long ago they were created. For example, if this parameter is 2 then packages created more than 2 months ago will be archived as part of the policy.",
-						DeprecationMessage:  "Use `created_before_in_days` instead of `created_before_in_months`. Renamed to `created_before_in_days` starting in version 7.111.2.",
-					},
-					"last_downloaded_before_in_months": schema.Int64Attribute{
-						Optional: true,
-						Computed: true,
-						MarkdownDescription: "The archive policy will archive packages based on how long ago they were downloaded. For example, if this parameter is 5 then packages downloaded more than 5 months ago will be archived as part of the policy.\n\n" +
-							"~>JFrog recommends using the `last_downloaded_before_in_months` condition to ensure that packages currently in use are not archived.",
-						DeprecationMessage: "Use `last_downloaded_before_in_days` instead of `last_downloaded_before_in_months`. Renamed to `last_downloaded_before_in_days` starting in version 7.111.2.",
-					},
-					"created_before_in_days": schema.Int64Attribute{
-						Optional:            true,
-						Computed:            true,
-						MarkdownDescription: "The archive policy will archive packages based on how long ago they were created. For example, if this parameter is 2 then packages created more than 2 days ago will be archived as part of the policy.",
-					},
-					"last_downloaded_before_in_days": schema.Int64Attribute{
-						Optional: true,
-						Computed: true,
-						MarkdownDescription: "The archive policy will archive packages based on how long ago they were downloaded. For example, if this parameter is 5 then packages downloaded more than 5 days ago will be archived as part of the policy.\n\n" +
-							"~>JFrog recommends using the `last_downloaded_before_in_days` condition to ensure that packages currently in use are not archived.",
-					},
-					"keep_last_n_versions": schema.Int64Attribute{
-						Optional: true,
-						Computed: true,
-						MarkdownDescription: "Set a value for the number of latest versions to keep. The archive policy will remove all versions before the number you select here. The latest version is always excluded.\n\n" +
-							"~>Versions are determined by creation date.\n\n" +
-							"~>Not all package types support this condition. If you include a package type in your policy that is not compatible with this condition, a validation error (400) is returned. For information on which package types support this condition, see [here]().",
-					},
-					"excluded_properties": schema.MapAttribute{
-						ElementType: types.ListType{ElemType: types.StringType},
-						Optional:    true,
-						Validators: []validator.Map{
-							singleKeySingleValueMapValidator{},
-						},
-						MarkdownDescription: "A key-value pair applied to the lead artifact of a package. Packages with this property will be excluded from archival.",
-					},
-					"included_properties": schema.MapAttribute{
-						ElementType: types.ListType{ElemType: types.StringType},
-						Optional:    true,
-						Validators: []validator.Map{
-							singleKeySingleValueMapValidator{},
-						},
-						MarkdownDescription: "A key-value pair applied to the lead artifact of a package. Packages with this property will be archived.",
-					},
-				},
-				Required: true,
-				Validators: []validator.Object{
-					searchCriteriaValidator{},
-				},
-			},
+			"preview_on_plan": previewOnPlanSchema("archive"),
+			"run_trigger":     runTriggerSchema,
+			"search_criteria": policySearchCriteria{
+				policyName:   "archive policy",
+				action:       "archived",
+				packageTypes: archivePolicySupportedPackageType,
+			}.schema(),
 		},
 		Description: "Provides an Artifactory Archive Policy resource. This resource enable system administrators to define and customize policies based on specific criteria for removing unused binaries from across their JFrog platform. " +
 			"See [Retention Policies](https://jfrog.com/help/r/jfrog-platform-administration-documentation/retention-policies) for more details.\n\n",
@@ -729,7 +189,7 @@ func (r *ArchivePolicyResource) Configure(ctx context.Context, req resource.Conf
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), r.TypeName)
 }
 
 func (r ArchivePolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -740,12 +200,40 @@ func (r ArchivePolicyResource) ValidateConfig(ctx context.Context, req resource.
 		return
 	}
 
+	// Validate project_key constraints for project-level policies. When using expressions
+	// like `for_each`, the key may be unknown during validation and is checked during plan.
+	validateProjectLevelPolicyKey(data.Key, data.ProjectKey, &resp.Diagnostics)
+	validateProjectLevelSearchCriteria(data.ProjectKey, data.SearchCriteria, &resp.Diagnostics)
+
+	capability.ArchivePolicy.Validate(r.ProviderData, path.Empty(), &resp.Diagnostics)
+	validatePolicyConditionsVersion(r.ProviderData, data.SearchCriteria, &resp.Diagnostics)
+
 	// Schema-level validation handles the condition validation rules
-	// This function can be used for additional validation if needed in the future
+}
+
+func (r ArchivePolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on resource destruction
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ArchivePolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate project-level policy key prefix during plan when values are resolved.
+	// This catches dynamic keys (e.g., from for_each) that are unknown during ValidateConfig.
+	validateProjectLevelPolicyKey(plan.Key, plan.ProjectKey, &resp.Diagnostics)
+
+	if plan.PreviewOnPlan.ValueBool() {
+		addPolicyPreviewWarning(ctx, r.ProviderData.Client, plan.SearchCriteria, "archived", &resp.Diagnostics)
+	}
 }
 
 func (r *ArchivePolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ArchivePolicyResourceModel
 
@@ -827,10 +315,15 @@ func (r *ArchivePolicyResource) Create(ctx context.Context, req resource.CreateR
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runPolicyOnTrigger(r.ProviderData.Client, PolicyTypeArchive, plan.Key.ValueString(), plan.RunTrigger, types.StringNull(), &resp.Diagnostics)
 }
 
 func (r *ArchivePolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ArchivePolicyResourceModel
 	// Read Terraform prior state data into the model
@@ -878,7 +371,7 @@ func (r *ArchivePolicyResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *ArchivePolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ArchivePolicyResourceModel
 	var state ArchivePolicyResourceModel
@@ -975,10 +468,15 @@ func (r *ArchivePolicyResource) Update(ctx context.Context, req resource.UpdateR
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runPolicyOnTrigger(r.ProviderData.Client, PolicyTypeArchive, plan.Key.ValueString(), plan.RunTrigger, state.RunTrigger, &resp.Diagnostics)
 }
 
 func (r *ArchivePolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ArchivePolicyResourceModel
 
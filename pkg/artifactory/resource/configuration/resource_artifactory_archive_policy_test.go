@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
 )
@@ -702,7 +703,7 @@ func TestAccArchivePolicy_invalid_project_level_policy(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      config,
-				ExpectError: regexp.MustCompile(".*Cannot include all projects for a project level policy.*"),
+				ExpectError: regexp.MustCompile("(?i)cannot include all projects"),
 			},
 		},
 	})
@@ -1029,18 +1030,7 @@ func TestAccArchivePolicy_valid_combined_time_and_properties(t *testing.T) {
 		t.Skipf("JFROG_ARCHIVE_POLICY_ENABLED env var is not set to 'true'")
 	}
 
-	client := acctest.GetTestResty(t)
-	version, err := util.GetArtifactoryVersion(client)
-	if err != nil {
-		t.Fatal(err)
-	}
-	valid, err := util.CheckVersion(version, "7.129.0")
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !valid {
-		t.Skipf("Artifactory version %s is earlier than 7.129.0 which is required for combined time + property conditions", version)
-	}
+	acctest.SkipIfNotSupported(t, capability.PolicyTimeAndPropertiesConditions)
 
 	_, _, policyName := testutil.MkNames("test-combined-policy", "artifactory_archive_policy")
 
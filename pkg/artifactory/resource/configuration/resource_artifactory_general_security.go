@@ -27,6 +27,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 
@@ -47,6 +49,14 @@ type GeneralSecurityResource struct {
 	util.JFrogResource
 }
 
+func (r *GeneralSecurityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.JFrogResource.Configure(ctx, req, resp)
+	if r.ProviderData != nil {
+		meta := httplog.ForResource(*r.ProviderData, r.TypeName)
+		r.ProviderData = &meta
+	}
+}
+
 type GeneralSecurityResourceModel struct {
 	ID                    types.String `tfsdk:"id"`
 	EnableAnonymousAccess types.Bool   `tfsdk:"enable_anonymous_access"`
@@ -114,7 +124,7 @@ func (r *GeneralSecurityResource) Schema(ctx context.Context, req resource.Schem
 }
 
 func (r *GeneralSecurityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan GeneralSecurityResourceModel
 
@@ -151,7 +161,7 @@ func (r *GeneralSecurityResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *GeneralSecurityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state GeneralSecurityResourceModel
 	// Read Terraform prior state data into the model
@@ -185,7 +195,7 @@ func (r *GeneralSecurityResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *GeneralSecurityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan GeneralSecurityResourceModel
 
@@ -222,7 +232,7 @@ func (r *GeneralSecurityResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *GeneralSecurityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	resp.Diagnostics.AddWarning(
 		"Security configuration cannot be deleted",
@@ -32,6 +32,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	"gopkg.in/ldap.v2"
@@ -250,11 +252,11 @@ func (r *ArtifactoryLdapSettingResource) Configure(ctx context.Context, req reso
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), r.TypeName)
 }
 
 func (r *ArtifactoryLdapSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var data *ArtifactoryLdapSettingResourceModel
 	// Read Terraform plan data into the model
@@ -309,7 +311,7 @@ func (r *ArtifactoryLdapSettingResource) Create(ctx context.Context, req resourc
 }
 
 func (r *ArtifactoryLdapSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var data *ArtifactoryLdapSettingResourceModel
 	// Read Terraform prior state data into the model
@@ -354,7 +356,7 @@ func (r *ArtifactoryLdapSettingResource) Read(ctx context.Context, req resource.
 }
 
 func (r *ArtifactoryLdapSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var data ArtifactoryLdapSettingResourceModel
 
@@ -413,7 +415,7 @@ func (r *ArtifactoryLdapSettingResource) Update(ctx context.Context, req resourc
 }
 
 func (r *ArtifactoryLdapSettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var data ArtifactoryLdapSettingResourceModel
 
@@ -448,6 +450,58 @@ func (r *ArtifactoryLdapSettingResource) ImportState(ctx context.Context, req re
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// MoveState supports `moved` blocks from the deprecated `artifactory_ldap_setting` resource.
+// Both resources manage the same LDAP setting and share their attribute names.
+func (r *ArtifactoryLdapSettingResource) MoveState(ctx context.Context) []resource.StateMover {
+	sourceAttributes := map[string]schema.Attribute{
+		"id":                           schema.StringAttribute{Optional: true},
+		"key":                          schema.StringAttribute{Optional: true},
+		"enabled":                      schema.BoolAttribute{Optional: true},
+		"ldap_url":                     schema.StringAttribute{Optional: true},
+		"user_dn_pattern":              schema.StringAttribute{Optional: true},
+		"auto_create_user":             schema.BoolAttribute{Optional: true},
+		"email_attribute":              schema.StringAttribute{Optional: true},
+		"ldap_poisoning_protection":    schema.BoolAttribute{Optional: true},
+		"allow_user_to_access_profile": schema.BoolAttribute{Optional: true},
+		"paging_support_enabled":       schema.BoolAttribute{Optional: true},
+		"search_filter":                schema.StringAttribute{Optional: true},
+		"search_base":                  schema.StringAttribute{Optional: true},
+		"search_sub_tree":              schema.BoolAttribute{Optional: true},
+		"manager_dn":                   schema.StringAttribute{Optional: true},
+		"manager_password":             schema.StringAttribute{Optional: true, Sensitive: true},
+	}
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &schema.Schema{Attributes: sourceAttributes},
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "artifactory_ldap_setting" || req.SourceState == nil {
+					return
+				}
+
+				var data ArtifactoryLdapSettingResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &data)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// The SDKv2 resource leaves unset strings null, the v2 resource defaults them
+				data.Id = data.Key
+				for _, s := range []*types.String{&data.UserDnPattern, &data.SearchFilter, &data.SearchBase, &data.ManagerDn, &data.ManagerPassword} {
+					if s.IsNull() {
+						*s = types.StringValue("")
+					}
+				}
+				if data.EmailAttribute.IsNull() || data.EmailAttribute.ValueString() == "" {
+					data.EmailAttribute = types.StringValue("mail")
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
 func (r *ArtifactoryLdapSettingResourceModel) ToState(ctx context.Context, ldap ArtifactoryLdapSettingResourceAPIModel) diag.Diagnostics {
 	r.Id = types.StringValue(ldap.Key)
 	r.Key = types.StringValue(ldap.Key)
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
 	"github.com/jfrog/terraform-provider-shared/testutil"
@@ -126,6 +127,65 @@ func TestAccLdapSettingV2_full_with_search(t *testing.T) {
 	})
 }
 
+func TestAccLdapSettingV2_moved(t *testing.T) {
+	_, _, key := testutil.MkNames("ldap-", "artifactory_ldap_setting")
+	fqrn := fmt.Sprintf("artifactory_ldap_setting_v2.%s", key)
+
+	const ldapSetting = `
+	resource "artifactory_ldap_setting" "{{ .key }}" {
+		key = "{{ .key }}"
+		enabled = true
+		ldap_url = "ldap://ldaptestldap"
+		user_dn_pattern = "uid={0},ou=People"
+		email_attribute = "mail_attr"
+	}
+	`
+
+	const movedLdapSetting = `
+	resource "artifactory_ldap_setting_v2" "{{ .key }}" {
+		key = "{{ .key }}"
+		enabled = true
+		ldap_url = "ldap://ldaptestldap"
+		user_dn_pattern = "uid={0},ou=People"
+		email_attribute = "mail_attr"
+	}
+
+	moved {
+		from = artifactory_ldap_setting.{{ .key }}
+		to   = artifactory_ldap_setting_v2.{{ .key }}
+	}
+	`
+
+	params := map[string]interface{}{
+		"key": key,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccLdapSettingV2Destroy(fqrn),
+
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate("TestLdap", ldapSetting, params),
+			},
+			{
+				Config: util.ExecuteTemplate("TestLdap", movedLdapSetting, params),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "id", key),
+					resource.TestCheckResourceAttr(fqrn, "user_dn_pattern", "uid={0},ou=People"),
+					resource.TestCheckResourceAttr(fqrn, "email_attribute", "mail_attr"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLdapSettingV2_update(t *testing.T) {
 	_, fqrn, key := testutil.MkNames("ldap-", "artifactory_ldap_setting_v2")
 
@@ -88,8 +88,8 @@ func TestPackageCleanupPolicyFromAPIModelSetsNonEmptyCronExpression(t *testing.T
 	}
 }
 
-func packageCleanupPolicyTestSearchCriteria() PackageCleanupPolicySearchCriteriaAPIModel {
-	return PackageCleanupPolicySearchCriteriaAPIModel{
+func packageCleanupPolicyTestSearchCriteria() PolicySearchCriteriaAPIModel {
+	return PolicySearchCriteriaAPIModel{
 		PackageTypes: []string{"docker"},
 		Repos:        []string{"example-repo"},
 	}
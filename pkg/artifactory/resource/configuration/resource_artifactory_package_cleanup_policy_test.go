@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/configuration"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
@@ -717,18 +718,7 @@ func TestAccPackageCleanupPolicy_all_package_types(t *testing.T) {
 }
 
 func TestAccPackageCleanupPolicy_days_based_conditions(t *testing.T) {
-	client := acctest.GetTestResty(t)
-	version, err := util.GetArtifactoryVersion(client)
-	if err != nil {
-		t.Fatal(err)
-	}
-	valid, err := util.CheckVersion(version, "7.111.2")
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !valid {
-		t.Skipf("Artifactory version %s is earlier than 7.111.2", version)
-	}
+	acctest.SkipIfNotSupported(t, capability.PolicyConditionsInDays)
 
 	_, fqrn, policyName := testutil.MkNames("test-package-cleanup-policy", "artifactory_package_cleanup_policy")
 
@@ -780,18 +770,7 @@ func TestAccPackageCleanupPolicy_days_based_conditions(t *testing.T) {
 }
 
 func TestAccPackageCleanupPolicy_with_variable_last_downloaded_before_in_days(t *testing.T) {
-	client := acctest.GetTestResty(t)
-	version, err := util.GetArtifactoryVersion(client)
-	if err != nil {
-		t.Fatal(err)
-	}
-	valid, err := util.CheckVersion(version, "7.111.2")
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !valid {
-		t.Skipf("Artifactory version %s is earlier than 7.111.2", version)
-	}
+	acctest.SkipIfNotSupported(t, capability.PolicyConditionsInDays)
 
 	_, fqrn, policyName := testutil.MkNames("test-package-cleanup-policy", "artifactory_package_cleanup_policy")
 
@@ -1436,18 +1415,7 @@ func TestAccPackageCleanupPolicy_default_duration_in_minutes(t *testing.T) {
 }
 
 func TestAccPackageCleanupPolicy_with_variable_created_before_in_days(t *testing.T) {
-	client := acctest.GetTestResty(t)
-	version, err := util.GetArtifactoryVersion(client)
-	if err != nil {
-		t.Fatal(err)
-	}
-	valid, err := util.CheckVersion(version, "7.111.2")
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !valid {
-		t.Skipf("Artifactory version %s is earlier than 7.111.2", version)
-	}
+	acctest.SkipIfNotSupported(t, capability.PolicyConditionsInDays)
 
 	_, fqrn, policyName := testutil.MkNames("test-package-cleanup-policy", "artifactory_package_cleanup_policy")
 
@@ -1502,18 +1470,7 @@ func TestAccPackageCleanupPolicy_with_variable_created_before_in_days(t *testing
 }
 
 func TestAccPackageCleanupPolicy_with_variable_keep_last_n_versions(t *testing.T) {
-	client := acctest.GetTestResty(t)
-	version, err := util.GetArtifactoryVersion(client)
-	if err != nil {
-		t.Fatal(err)
-	}
-	valid, err := util.CheckVersion(version, "7.111.2")
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !valid {
-		t.Skipf("Artifactory version %s is earlier than 7.111.2", version)
-	}
+	acctest.SkipIfNotSupported(t, capability.PolicyConditionsInDays)
 
 	_, fqrn, policyName := testutil.MkNames("test-package-cleanup-policy", "artifactory_package_cleanup_policy")
 
@@ -1634,18 +1591,7 @@ func TestAccPackageCleanupPolicy_with_variable_duration_in_minutes(t *testing.T)
 }
 
 func TestAccPackageCleanupPolicy_with_variable_no_default_should_fail(t *testing.T) {
-	client := acctest.GetTestResty(t)
-	version, err := util.GetArtifactoryVersion(client)
-	if err != nil {
-		t.Fatal(err)
-	}
-	valid, err := util.CheckVersion(version, "7.111.2")
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !valid {
-		t.Skipf("Artifactory version %s is earlier than 7.111.2", version)
-	}
+	acctest.SkipIfNotSupported(t, capability.PolicyConditionsInDays)
 
 	_, _, policyName := testutil.MkNames("test-package-cleanup-policy", "artifactory_package_cleanup_policy")
 
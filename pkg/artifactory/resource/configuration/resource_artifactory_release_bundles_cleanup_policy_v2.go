@@ -33,6 +33,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
@@ -46,9 +49,8 @@ const (
 func NewResourceBundleCleanupPolicyV2Resource() resource.Resource {
 	return &ResourceBundleCleanupPolicyV2Resource{
 		JFrogResource: util.JFrogResource{
-			TypeName:                "artifactory_release_bundle_v2_cleanup_policy",
-			ValidArtifactoryVersion: "7.104.2",
-			DocumentEndpoint:        "artifactory/api/cleanup/bundles/policies/{policyKey}",
+			TypeName:         "artifactory_release_bundle_v2_cleanup_policy",
+			DocumentEndpoint: "artifactory/api/cleanup/bundles/policies/{policyKey}",
 		},
 		EnablementEndpoint: "artifactory/api/cleanup/bundles/policies/{policyKey}/enablement",
 	}
@@ -59,6 +61,22 @@ type ResourceBundleCleanupPolicyV2Resource struct {
 	EnablementEndpoint string
 }
 
+func (r *ResourceBundleCleanupPolicyV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.JFrogResource.Configure(ctx, req, resp)
+	if r.ProviderData != nil {
+		meta := httplog.ForResource(*r.ProviderData, r.TypeName)
+		r.ProviderData = &meta
+	}
+}
+
+func (r ResourceBundleCleanupPolicyV2Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if r.ProviderData == nil {
+		return
+	}
+
+	capability.ReleaseBundleCleanupPolicyV2.Validate(*r.ProviderData, path.Empty(), &resp.Diagnostics)
+}
+
 type ResourceBundleCleanupPolicyV2ResourceModel struct {
 	Key               types.String `tfsdk:"key"`
 	Description       types.String `tfsdk:"description"`
@@ -119,7 +137,7 @@ func (r *ResourceBundleCleanupPolicyV2ResourceModel) fromAPIModel(ctx context.Co
 
 	r.Key = types.StringValue(apiModel.Key)
 	r.Description = types.StringValue(apiModel.Description)
-	r.CronExpression = types.StringValue(apiModel.CronExpression)
+	r.CronExpression = normalizeEmptyAPIString(apiModel.CronExpression, r.CronExpression)
 	r.DurationInMinutes = types.Int64Value(apiModel.DurationInMinutes)
 	r.ItemType = types.StringValue(apiModel.ItemType)
 	r.Enabled = types.BoolValue(apiModel.Enabled)
@@ -277,16 +295,8 @@ func (r *ResourceBundleCleanupPolicyV2Resource) Schema(ctx context.Context, req
 						ElementType:         types.StringType,
 						MarkdownDescription: "A list of environments to exclude from the cleanup process. To exclude all, set to **",
 					},
-					"include_all_projects": schema.BoolAttribute{
-						Optional:    true,
-						Description: "Set this value to `true` if you want the policy to run on all Artifactory projects. The default value is `false`.\n\n~>This attribute is relevant only on the global level, for Platform Admins.",
-					},
-					"included_projects": schema.SetAttribute{
-						ElementType: types.StringType,
-						Optional:    true,
-						MarkdownDescription: "List of projects on which you want this policy to run. To include repositories that are not assigned to any project, enter the project key `default`.\n\n" +
-							"~>This setting is relevant only on the global level, for Platform Admins.",
-					},
+					"include_all_projects": policyIncludeAllProjectsSchema(false),
+					"included_projects":    policyIncludedProjectsSchema(false),
 					"created_before_in_months": schema.Int64Attribute{
 						Optional:            true,
 						Computed:            true,
@@ -295,6 +305,9 @@ func (r *ResourceBundleCleanupPolicyV2Resource) Schema(ctx context.Context, req
 					},
 				},
 				Required: true,
+				Validators: []validator.Object{
+					policyConditionsValidator{},
+				},
 			},
 		},
 		Description: "Provides an Artifactory Release Bundles Cleanup Policy resource. The following APIs are used to configure and maintain JFrog cleanup policies for Release Bundles V2. " +
@@ -303,7 +316,7 @@ func (r *ResourceBundleCleanupPolicyV2Resource) Schema(ctx context.Context, req
 }
 
 func (r *ResourceBundleCleanupPolicyV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ResourceBundleCleanupPolicyV2ResourceModel
 
@@ -364,7 +377,7 @@ func (r *ResourceBundleCleanupPolicyV2Resource) Create(ctx context.Context, req
 }
 
 func (r *ResourceBundleCleanupPolicyV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ResourceBundleCleanupPolicyV2ResourceModel
 	// Read Terraform prior state data into the model
@@ -412,7 +425,7 @@ func (r *ResourceBundleCleanupPolicyV2Resource) Read(ctx context.Context, req re
 }
 
 func (r *ResourceBundleCleanupPolicyV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ResourceBundleCleanupPolicyV2ResourceModel
 	var state ResourceBundleCleanupPolicyV2ResourceModel
@@ -488,7 +501,7 @@ func (r *ResourceBundleCleanupPolicyV2Resource) Update(ctx context.Context, req
 }
 
 func (r *ResourceBundleCleanupPolicyV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ResourceBundleCleanupPolicyV2ResourceModel
 
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
 )
@@ -103,18 +104,7 @@ func TestAccReleaseBundleV2Cleanup_invalid_key(t *testing.T) {
 }
 
 func TestAccReleaseBundleV2Cleanup_full(t *testing.T) {
-	client := acctest.GetTestResty(t)
-	version, err := util.GetArtifactoryVersion(client)
-	if err != nil {
-		t.Fatal(err)
-	}
-	valid, err := util.CheckVersion(version, "7.104.2")
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !valid {
-		t.Skipf("Artifactory version %s is earlier than 7.104.2", version)
-	}
+	acctest.SkipIfNotSupported(t, capability.ReleaseBundleCleanupPolicyV2)
 
 	_, fqrn, policyName := testutil.MkNames("test-release-bundle-v2", "artifactory_release_bundle_v2_cleanup_policy")
 
@@ -260,18 +250,7 @@ func testAccReleaseBundleV2CleanupPolicyDestroy(id string) func(*terraform.State
 }
 
 func TestAccReleaseBundleV2Cleanup_with_variable_duration_in_minutes(t *testing.T) {
-	client := acctest.GetTestResty(t)
-	version, err := util.GetArtifactoryVersion(client)
-	if err != nil {
-		t.Fatal(err)
-	}
-	valid, err := util.CheckVersion(version, "7.104.2")
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !valid {
-		t.Skipf("Artifactory version %s is earlier than 7.104.2", version)
-	}
+	acctest.SkipIfNotSupported(t, capability.ReleaseBundleCleanupPolicyV2)
 
 	_, fqrn, policyName := testutil.MkNames("test-release-bundle-v2", "artifactory_release_bundle_v2_cleanup_policy")
 
@@ -328,18 +307,7 @@ func TestAccReleaseBundleV2Cleanup_with_variable_duration_in_minutes(t *testing.
 }
 
 func TestAccReleaseBundleV2Cleanup_with_variable_duration_in_minutes_no_default_should_fail(t *testing.T) {
-	client := acctest.GetTestResty(t)
-	version, err := util.GetArtifactoryVersion(client)
-	if err != nil {
-		t.Fatal(err)
-	}
-	valid, err := util.CheckVersion(version, "7.104.2")
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !valid {
-		t.Skipf("Artifactory version %s is earlier than 7.104.2", version)
-	}
+	acctest.SkipIfNotSupported(t, capability.ReleaseBundleCleanupPolicyV2)
 
 	_, _, policyName := testutil.MkNames("test-release-bundle-v2", "artifactory_release_bundle_v2_cleanup_policy")
 
@@ -392,18 +360,7 @@ func TestAccReleaseBundleV2Cleanup_with_variable_duration_in_minutes_no_default_
 }
 
 func TestAccReleaseBundleV2Cleanup_with_variable_created_before_in_months(t *testing.T) {
-	client := acctest.GetTestResty(t)
-	version, err := util.GetArtifactoryVersion(client)
-	if err != nil {
-		t.Fatal(err)
-	}
-	valid, err := util.CheckVersion(version, "7.104.2")
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !valid {
-		t.Skipf("Artifactory version %s is earlier than 7.104.2", version)
-	}
+	acctest.SkipIfNotSupported(t, capability.ReleaseBundleCleanupPolicyV2)
 
 	_, fqrn, policyName := testutil.MkNames("test-release-bundle-v2", "artifactory_release_bundle_v2_cleanup_policy")
 
@@ -461,18 +418,7 @@ func TestAccReleaseBundleV2Cleanup_with_variable_created_before_in_months(t *tes
 }
 
 func TestAccReleaseBundleV2Cleanup_with_variable_created_before_in_months_no_default_should_fail(t *testing.T) {
-	client := acctest.GetTestResty(t)
-	version, err := util.GetArtifactoryVersion(client)
-	if err != nil {
-		t.Fatal(err)
-	}
-	valid, err := util.CheckVersion(version, "7.104.2")
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !valid {
-		t.Skipf("Artifactory version %s is earlier than 7.104.2", version)
-	}
+	acctest.SkipIfNotSupported(t, capability.ReleaseBundleCleanupPolicyV2)
 
 	_, _, policyName := testutil.MkNames("test-release-bundle-v2", "artifactory_release_bundle_v2_cleanup_policy")
 
@@ -0,0 +1,150 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+const (
+	releaseBundleV2StatusPending   = "PENDING"
+	releaseBundleV2StatusCompleted = "COMPLETED"
+	releaseBundleV2StatusFailed    = "FAILED"
+
+	defaultReleaseBundleV2CreateTimeout = 30 * time.Minute
+)
+
+// releaseBundleV2PollInterval is a variable so unit tests can shorten it.
+var releaseBundleV2PollInterval = 5 * time.Second
+
+type ReleaseBundleV2MessageAPIModel struct {
+	Text string `json:"text"`
+}
+
+type ReleaseBundleV2StatusAPIModel struct {
+	Status   string                           `json:"status"`
+	Messages []ReleaseBundleV2MessageAPIModel `json:"messages"`
+}
+
+func (m ReleaseBundleV2StatusAPIModel) errorDetails() string {
+	texts := lo.FilterMap(m.Messages, func(message ReleaseBundleV2MessageAPIModel, _ int) (string, bool) {
+		return message.Text, message.Text != ""
+	})
+	if len(texts) == 0 {
+		return "no error details were returned by the server"
+	}
+
+	return strings.Join(texts, "\n")
+}
+
+var timeoutsBlock = schema.SingleNestedBlock{
+	Attributes: map[string]schema.Attribute{
+		"create": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				durationValidator{},
+			},
+			MarkdownDescription: fmt.Sprintf("How long to wait for the operation to reach `%s` status, as a Go duration string (e.g. `30s`, `1h`). Default is `%s`.", releaseBundleV2StatusCompleted, defaultReleaseBundleV2CreateTimeout),
+		},
+	},
+	MarkdownDescription: "Operation timeouts. The request is submitted asynchronously and its status is polled until it completes, fails, or the timeout is reached.",
+}
+
+func createTimeout(timeouts types.Object) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if timeouts.IsNull() || timeouts.IsUnknown() {
+		return defaultReleaseBundleV2CreateTimeout, diags
+	}
+
+	create, ok := timeouts.Attributes()["create"].(types.String)
+	if !ok || create.IsNull() || create.IsUnknown() {
+		return defaultReleaseBundleV2CreateTimeout, diags
+	}
+
+	timeout, err := time.ParseDuration(create.ValueString())
+	if err != nil {
+		diags.AddError("Invalid timeouts.create", err.Error())
+	}
+
+	return timeout, diags
+}
+
+// waitForReleaseBundleV2Status polls getStatus until it reports COMPLETED or
+// FAILED, or until timeout elapses. The last known status is always returned
+// so callers can record it in state.
+func waitForReleaseBundleV2Status(ctx context.Context, timeout time.Duration, getStatus func() (ReleaseBundleV2StatusAPIModel, error)) (ReleaseBundleV2StatusAPIModel, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(releaseBundleV2PollInterval)
+	defer ticker.Stop()
+
+	last := ReleaseBundleV2StatusAPIModel{Status: releaseBundleV2StatusPending}
+	for {
+		status, err := getStatus()
+		if err != nil {
+			return last, err
+		}
+		last = status
+
+		switch status.Status {
+		case releaseBundleV2StatusCompleted:
+			return status, nil
+		case releaseBundleV2StatusFailed:
+			return status, fmt.Errorf("operation failed: %s", status.errorDetails())
+		}
+
+		select {
+		case <-ctx.Done():
+			return last, fmt.Errorf("timed out after %s waiting for status %s, last status was %s", timeout, releaseBundleV2StatusCompleted, last.Status)
+		case <-ticker.C:
+		}
+	}
+}
+
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a valid Go duration string, e.g. 30s or 1h"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("%q is not a positive duration: %s", req.ConfigValue.ValueString(), v.Description(ctx)),
+		)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitForReleaseBundleV2Status(t *testing.T) {
+	releaseBundleV2PollInterval = time.Millisecond
+	defer func() { releaseBundleV2PollInterval = 5 * time.Second }()
+
+	statuses := func(values ...ReleaseBundleV2StatusAPIModel) func() (ReleaseBundleV2StatusAPIModel, error) {
+		return func() (ReleaseBundleV2StatusAPIModel, error) {
+			status := values[0]
+			if len(values) > 1 {
+				values = values[1:]
+			}
+			return status, nil
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		timeout        time.Duration
+		getStatus      func() (ReleaseBundleV2StatusAPIModel, error)
+		expectedStatus string
+		expectedError  string
+	}{
+		{
+			name:    "completed",
+			timeout: time.Second,
+			getStatus: statuses(
+				ReleaseBundleV2StatusAPIModel{Status: "PROCESSING"},
+				ReleaseBundleV2StatusAPIModel{Status: releaseBundleV2StatusCompleted},
+			),
+			expectedStatus: releaseBundleV2StatusCompleted,
+		},
+		{
+			name:    "failed",
+			timeout: time.Second,
+			getStatus: statuses(
+				ReleaseBundleV2StatusAPIModel{Status: "PROCESSING"},
+				ReleaseBundleV2StatusAPIModel{
+					Status:   releaseBundleV2StatusFailed,
+					Messages: []ReleaseBundleV2MessageAPIModel{{Text: "artifact not found"}},
+				},
+			),
+			expectedStatus: releaseBundleV2StatusFailed,
+			expectedError:  "artifact not found",
+		},
+		{
+			name:           "timed out",
+			timeout:        10 * time.Millisecond,
+			getStatus:      statuses(ReleaseBundleV2StatusAPIModel{Status: "PROCESSING"}),
+			expectedStatus: "PROCESSING",
+			expectedError:  "timed out",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status, err := waitForReleaseBundleV2Status(context.Background(), tc.timeout, tc.getStatus)
+
+			if status.Status != tc.expectedStatus {
+				t.Errorf("expected status %s, got %s", tc.expectedStatus, status.Status)
+			}
+
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Fatalf("expected error %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/build"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const (
+	releaseBundleV2AQLEndpoint = "artifactory/api/search/aql"
+
+	// buildSelectorsSourceType selects builds by name pattern and promotion status. It is resolved by the
+	// provider into `resolved_builds` and sent to Artifactory as a `builds` source.
+	buildSelectorsSourceType = "build_selectors"
+)
+
+var resolvedBuildAttrTypes = map[string]attr.Type{
+	"name":                 types.StringType,
+	"number":               types.StringType,
+	"repository":           types.StringType,
+	"include_dependencies": types.BoolType,
+}
+
+var resolvedBuildsType = types.ListType{ElemType: types.ObjectType{AttrTypes: resolvedBuildAttrTypes}}
+
+type releaseBundleV2BuildAQLResult struct {
+	Results []struct {
+		Name   string `json:"build.name"`
+		Number string `json:"build.number"`
+	} `json:"results"`
+}
+
+// searchAQL runs the AQL query and decodes its results into result.
+func searchAQL(ctx context.Context, client *resty.Client, query string, result any) error {
+	tflog.Debug(ctx, "searchAQL", map[string]interface{}{
+		"query": query,
+	})
+
+	var jfrogErrors util.JFrogErrors
+	response, err := client.R().
+		SetHeader("Content-Type", "text/plain").
+		SetBody(query).
+		SetResult(result).
+		SetError(&jfrogErrors).
+		Post(releaseBundleV2AQLEndpoint)
+	if err != nil {
+		return err
+	}
+	if response.IsError() {
+		return fmt.Errorf("%s", jfrogErrors.String())
+	}
+
+	return nil
+}
+
+// findLatestBuild returns the name and number of the most recently created build whose name matches the
+// pattern and, when set, whose latest promotion has the given status. AQL matches builds with any past
+// promotion of that status, so the last status in the build-info of each of them is checked as well.
+func findLatestBuild(ctx context.Context, client *resty.Client, namePattern, status, projectKey string) (string, string, error) {
+	criteria := map[string]any{
+		"name": map[string]string{"$match": namePattern},
+	}
+	if status != "" {
+		criteria["promotion.status"] = status
+	}
+
+	criteriaJSON, err := json.Marshal(criteria)
+	if err != nil {
+		return "", "", err
+	}
+
+	query := fmt.Sprintf(`builds.find(%s).include("name","number","created").sort({"$desc":["created"]})`, criteriaJSON)
+	if status == "" {
+		query += ".limit(1)"
+	}
+
+	var result releaseBundleV2BuildAQLResult
+	if err := searchAQL(ctx, client, query, &result); err != nil {
+		return "", "", fmt.Errorf("failed to search builds: %w", err)
+	}
+
+	for _, candidate := range result.Results {
+		if status == "" {
+			return candidate.Name, candidate.Number, nil
+		}
+
+		buildInfo, err := build.GetBuildInfo(client, candidate.Name, candidate.Number, projectKey)
+		if err != nil {
+			return "", "", err
+		}
+		if buildInfo != nil && len(buildInfo.Statuses) > 0 && strings.EqualFold(buildInfo.Statuses[len(buildInfo.Statuses)-1].Status, status) {
+			return candidate.Name, candidate.Number, nil
+		}
+	}
+
+	if status != "" {
+		return "", "", fmt.Errorf("no build matching %q with latest promotion status %q found", namePattern, status)
+	}
+	return "", "", fmt.Errorf("no build matching %q found", namePattern)
+}
+
+// resolveBuildSelectors resolves each `source.build_selectors` element into the latest matching build.
+// It returns an unknown list while any selector is unknown, so the resolution is retried during apply.
+func resolveBuildSelectors(ctx context.Context, client *resty.Client, selectors types.List, projectKey string) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if selectors.IsUnknown() {
+		return types.ListUnknown(resolvedBuildsType.ElemType), diags
+	}
+
+	builds := make([]attr.Value, 0, len(selectors.Elements()))
+	for i, elem := range selectors.Elements() {
+		if elem.IsUnknown() {
+			return types.ListUnknown(resolvedBuildsType.ElemType), diags
+		}
+
+		attrs := elem.(types.Object).Attributes()
+		name := attrs["name"].(types.String)
+		status := attrs["status"].(types.String)
+		if name.IsUnknown() || status.IsUnknown() {
+			return types.ListUnknown(resolvedBuildsType.ElemType), diags
+		}
+
+		buildName, buildNumber, err := findLatestBuild(ctx, client, name.ValueString(), status.ValueString(), projectKey)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("source").AtName(buildSelectorsSourceType).AtListIndex(i),
+				"Unable to Resolve Build Selector",
+				err.Error(),
+			)
+			continue
+		}
+
+		build, d := types.ObjectValue(
+			resolvedBuildAttrTypes,
+			map[string]attr.Value{
+				"name":                 types.StringValue(buildName),
+				"number":               types.StringValue(buildNumber),
+				"repository":           attrs["repository"],
+				"include_dependencies": attrs["include_dependencies"],
+			},
+		)
+		diags.Append(d...)
+		builds = append(builds, build)
+	}
+
+	if diags.HasError() {
+		return types.ListNull(resolvedBuildsType.ElemType), diags
+	}
+
+	resolved, d := types.ListValue(resolvedBuildsType.ElemType, builds)
+	diags.Append(d...)
+
+	return resolved, diags
+}
@@ -0,0 +1,60 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// A build once promoted with the status but promoted again since is not selected.
+func TestFindLatestBuildChecksLatestPromotion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/artifactory/api/search/aql":
+			_, _ = w.Write([]byte(`{"results":[{"build.name":"my-app","build.number":"3"},{"build.name":"my-app","build.number":"2"}]}`))
+		case "/artifactory/api/build/my-app/3":
+			_, _ = w.Write([]byte(`{"buildInfo":{"name":"my-app","number":"3","statuses":[{"status":"released"},{"status":"rolled-back"}]}}`))
+		case "/artifactory/api/build/my-app/2":
+			_, _ = w.Write([]byte(`{"buildInfo":{"name":"my-app","number":"2","statuses":[{"status":"staged"},{"status":"Released"}]}}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := resty.New().SetBaseURL(server.URL)
+
+	name, number, err := findLatestBuild(context.Background(), client, "my-*", "released", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if name != "my-app" || number != "2" {
+		t.Errorf("expected build my-app number 2, got %s number %s", name, number)
+	}
+
+	_, _, err = findLatestBuild(context.Background(), client, "my-*", "staged", "")
+	if err == nil || !strings.Contains(err.Error(), `latest promotion status "staged"`) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
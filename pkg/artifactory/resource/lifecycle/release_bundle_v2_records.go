@@ -0,0 +1,200 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const (
+	ReleaseBundleV2RecordsEndpoint   = "lifecycle/api/v2/release_bundle/records/{name}"
+	ReleaseBundleV2ArtifactsEndpoint = "lifecycle/api/v2/release_bundle/records/{name}/{version}/artifacts"
+
+	releaseBundleV2PageSize = 250
+)
+
+type ReleaseBundleV2VersionAPIModel struct {
+	Status        string `json:"status"`
+	ReleaseStatus string `json:"release_status"`
+	RepositoryKey string `json:"repository_key"`
+	Name          string `json:"release_bundle_name"`
+	Version       string `json:"release_bundle_version"`
+	ServiceID     string `json:"service_id"`
+	CreatedBy     string `json:"created_by"`
+	Created       string `json:"created"`
+}
+
+type releaseBundleV2VersionsAPIModel struct {
+	ReleaseBundles []ReleaseBundleV2VersionAPIModel `json:"release_bundles"`
+	Total          int                              `json:"total"`
+}
+
+type ReleaseBundleV2ArtifactAPIModel struct {
+	Path                 string `json:"path"`
+	SHA256               string `json:"sha256"`
+	Size                 int64  `json:"size"`
+	PackageType          string `json:"package_type"`
+	SourceRepositoryKey  string `json:"source_repository_key"`
+	SourceRepositoryPath string `json:"source_repository_path"`
+}
+
+type releaseBundleV2ArtifactsAPIModel struct {
+	Artifacts []ReleaseBundleV2ArtifactAPIModel `json:"artifacts"`
+	Total     int                               `json:"total"`
+}
+
+type ReleaseBundleV2PromotionRecordAPIModel struct {
+	Status        string `json:"status"`
+	RepositoryKey string `json:"repository_key"`
+	Name          string `json:"release_bundle_name"`
+	Version       string `json:"release_bundle_version"`
+	Environment   string `json:"environment"`
+	ServiceID     string `json:"service_id"`
+	CreatedBy     string `json:"created_by"`
+	Created       string `json:"created"`
+	CreatedMillis int64  `json:"created_millis"`
+}
+
+type releaseBundleV2PromotionsAPIModel struct {
+	Promotions []ReleaseBundleV2PromotionRecordAPIModel `json:"promotions"`
+	Total      int                                      `json:"total"`
+}
+
+func releaseBundleV2Request(client *resty.Client, projectKey string) *resty.Request {
+	request := client.R()
+	if projectKey != "" {
+		request.SetQueryParam("project", projectKey)
+	}
+
+	return request
+}
+
+// isLastReleaseBundleV2Page reports whether paging is done. Total is only trusted when the
+// API returns it, otherwise paging continues until a short page.
+func isLastReleaseBundleV2Page(pageLen, fetched, total int) bool {
+	return pageLen < releaseBundleV2PageSize || (total > 0 && fetched >= total)
+}
+
+// GetReleaseBundleV2Versions returns all versions of a Release Bundle, the latest first.
+func GetReleaseBundleV2Versions(client *resty.Client, name, projectKey string) ([]ReleaseBundleV2VersionAPIModel, error) {
+	var versions []ReleaseBundleV2VersionAPIModel
+
+	for offset := 0; ; offset += releaseBundleV2PageSize {
+		var page releaseBundleV2VersionsAPIModel
+		var jfrogErrors util.JFrogErrors
+		response, err := releaseBundleV2Request(client, projectKey).
+			SetPathParam("name", name).
+			SetQueryParams(map[string]string{
+				"offset":    strconv.Itoa(offset),
+				"limit":     strconv.Itoa(releaseBundleV2PageSize),
+				"order_by":  "created",
+				"order_asc": "false",
+			}).
+			SetResult(&page).
+			SetError(&jfrogErrors).
+			Get(ReleaseBundleV2RecordsEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		if response.StatusCode() == http.StatusNotFound {
+			return nil, fmt.Errorf("release bundle %s not found", name)
+		}
+		if response.IsError() {
+			return nil, fmt.Errorf("failed to get versions of release bundle %s: %s", name, jfrogErrors.String())
+		}
+
+		versions = append(versions, page.ReleaseBundles...)
+		if isLastReleaseBundleV2Page(len(page.ReleaseBundles), len(versions), page.Total) {
+			return versions, nil
+		}
+	}
+}
+
+// GetReleaseBundleV2Artifacts returns the artifacts of a Release Bundle version.
+func GetReleaseBundleV2Artifacts(client *resty.Client, name, version, projectKey string) ([]ReleaseBundleV2ArtifactAPIModel, error) {
+	var artifacts []ReleaseBundleV2ArtifactAPIModel
+
+	for offset := 0; ; offset += releaseBundleV2PageSize {
+		var page releaseBundleV2ArtifactsAPIModel
+		var jfrogErrors util.JFrogErrors
+		response, err := releaseBundleV2Request(client, projectKey).
+			SetPathParams(map[string]string{
+				"name":    name,
+				"version": version,
+			}).
+			SetQueryParams(map[string]string{
+				"offset": strconv.Itoa(offset),
+				"limit":  strconv.Itoa(releaseBundleV2PageSize),
+			}).
+			SetResult(&page).
+			SetError(&jfrogErrors).
+			Get(ReleaseBundleV2ArtifactsEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		if response.StatusCode() == http.StatusNotFound {
+			return nil, fmt.Errorf("release bundle %s version %s not found", name, version)
+		}
+		if response.IsError() {
+			return nil, fmt.Errorf("failed to get artifacts of release bundle %s version %s: %s", name, version, jfrogErrors.String())
+		}
+
+		artifacts = append(artifacts, page.Artifacts...)
+		if isLastReleaseBundleV2Page(len(page.Artifacts), len(artifacts), page.Total) {
+			return artifacts, nil
+		}
+	}
+}
+
+// GetReleaseBundleV2Promotions returns the promotion records of a Release Bundle version.
+func GetReleaseBundleV2Promotions(client *resty.Client, name, version, projectKey string) ([]ReleaseBundleV2PromotionRecordAPIModel, error) {
+	var promotions []ReleaseBundleV2PromotionRecordAPIModel
+
+	for offset := 0; ; offset += releaseBundleV2PageSize {
+		var page releaseBundleV2PromotionsAPIModel
+		var jfrogErrors util.JFrogErrors
+		response, err := releaseBundleV2Request(client, projectKey).
+			SetPathParams(map[string]string{
+				"name":    name,
+				"version": version,
+			}).
+			SetQueryParams(map[string]string{
+				"offset": strconv.Itoa(offset),
+				"limit":  strconv.Itoa(releaseBundleV2PageSize),
+			}).
+			SetResult(&page).
+			SetError(&jfrogErrors).
+			Get(ReleaseBundleV2PromotionEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		if response.StatusCode() == http.StatusNotFound {
+			return nil, fmt.Errorf("release bundle %s version %s not found", name, version)
+		}
+		if response.IsError() {
+			return nil, fmt.Errorf("failed to get promotions of release bundle %s version %s: %s", name, version, jfrogErrors.String())
+		}
+
+		promotions = append(promotions, page.Promotions...)
+		if isLastReleaseBundleV2Page(len(page.Promotions), len(promotions), page.Total) {
+			return promotions, nil
+		}
+	}
+}
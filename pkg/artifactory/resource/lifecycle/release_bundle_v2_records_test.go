@@ -0,0 +1,101 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestGetReleaseBundleV2PromotionsPages(t *testing.T) {
+	const total = releaseBundleV2PageSize + 10
+
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/lifecycle/api/v2/promotion/records/my-bundle/1.0" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		page := releaseBundleV2PromotionsAPIModel{Total: total}
+		for i := offset; i < min(offset+limit, total); i++ {
+			page.Promotions = append(page.Promotions, ReleaseBundleV2PromotionRecordAPIModel{
+				Environment:   "DEV",
+				CreatedMillis: int64(i),
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	promotions, err := GetReleaseBundleV2Promotions(resty.New().SetBaseURL(server.URL), "my-bundle", "1.0", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(promotions) != total {
+		t.Errorf("expected %d promotions, got %d", total, len(promotions))
+	}
+	if len(offsets) != 2 || offsets[0] != "0" || offsets[1] != strconv.Itoa(releaseBundleV2PageSize) {
+		t.Errorf("expected two pages, got offsets %v", offsets)
+	}
+}
+
+func TestGetReleaseBundleV2PromotionsPagesWithoutTotal(t *testing.T) {
+	const count = releaseBundleV2PageSize + 10
+
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		var page releaseBundleV2PromotionsAPIModel
+		for i := offset; i < min(offset+limit, count); i++ {
+			page.Promotions = append(page.Promotions, ReleaseBundleV2PromotionRecordAPIModel{
+				Environment:   "DEV",
+				CreatedMillis: int64(i),
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	promotions, err := GetReleaseBundleV2Promotions(resty.New().SetBaseURL(server.URL), "my-bundle", "1.0", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(promotions) != count {
+		t.Errorf("expected %d promotions, got %d", count, len(promotions))
+	}
+	if pages != 2 {
+		t.Errorf("expected two pages, got %d", pages)
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+var resolvedArtifactAttrTypes = map[string]attr.Type{
+	"path":   types.StringType,
+	"sha256": types.StringType,
+}
+
+var resolvedArtifactsType = types.ListType{ElemType: types.ObjectType{AttrTypes: resolvedArtifactAttrTypes}}
+
+// packageVersionProperties are the properties Artifactory sets on the files of a package version, by package
+// type. The files of the other supported types are found by their path.
+var packageVersionProperties = map[string][2]string{
+	"conan":  {"conan.package.name", "conan.package.version"},
+	"debian": {"deb.name", "deb.version"},
+	"gems":   {"gem.name", "gem.version"},
+	"helm":   {"chart.name", "chart.version"},
+	"npm":    {"npm.name", "npm.version"},
+	"nuget":  {"nuget.id", "nuget.version"},
+	"pypi":   {"pypi.name", "pypi.version"},
+	"rpm":    {"rpm.metadata.name", "rpm.metadata.version"},
+}
+
+type releaseBundleV2ItemAPIModel struct {
+	Repo   string `json:"repo"`
+	Path   string `json:"path"`
+	Name   string `json:"name"`
+	SHA256 string `json:"sha256"`
+}
+
+type releaseBundleV2ItemAQLResult struct {
+	Results []releaseBundleV2ItemAPIModel `json:"results"`
+}
+
+type resolvedArtifact struct {
+	Path   string
+	SHA256 string
+}
+
+// findItems returns the files matching the AQL items criteria.
+func findItems(ctx context.Context, client *resty.Client, criteria map[string]any) ([]resolvedArtifact, error) {
+	criteriaJSON, err := json.Marshal(criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`items.find(%s).include("repo","path","name","sha256")`, criteriaJSON)
+
+	var result releaseBundleV2ItemAQLResult
+	if err := searchAQL(ctx, client, query, &result); err != nil {
+		return nil, fmt.Errorf("failed to search artifacts: %w", err)
+	}
+
+	return lo.Map(result.Results, func(item releaseBundleV2ItemAPIModel, _ int) resolvedArtifact {
+		return resolvedArtifact{
+			Path:   path.Join(item.Repo, item.Path, item.Name),
+			SHA256: item.SHA256,
+		}
+	}), nil
+}
+
+// packageItemsCriteria returns the AQL items criteria matching the files of a package version, nil when
+// they can't be listed for the package type.
+func packageItemsCriteria(packageType, name, version, repositoryKey string) (map[string]any, error) {
+	criteria := map[string]any{"repo": repositoryKey}
+
+	if properties, ok := packageVersionProperties[packageType]; ok {
+		criteria["@"+properties[0]] = name
+		criteria["@"+properties[1]] = version
+		return criteria, nil
+	}
+
+	switch packageType {
+	case "docker", "oci":
+		criteria["path"] = path.Join(name, version)
+	case "maven", "gradle":
+		groupID, artifactID, ok := strings.Cut(name, ":")
+		if !ok {
+			return nil, fmt.Errorf("%s package name %q must be formatted as `<groupId>:<artifactId>`", packageType, name)
+		}
+		criteria["path"] = path.Join(strings.ReplaceAll(groupID, ".", "/"), artifactID, version)
+	case "go":
+		criteria["path"] = path.Join(name, "@v")
+		criteria["name"] = map[string]string{"$match": version + ".*"}
+	default:
+		return nil, nil
+	}
+
+	return criteria, nil
+}
+
+// resolvedArtifactsValue returns the artifacts sorted by path, without duplicates.
+func resolvedArtifactsValue(artifacts []resolvedArtifact) (types.List, diag.Diagnostics) {
+	artifacts = lo.UniqBy(artifacts, func(a resolvedArtifact) string { return a.Path })
+	slices.SortFunc(artifacts, func(a, b resolvedArtifact) int { return strings.Compare(a.Path, b.Path) })
+
+	var diags diag.Diagnostics
+	values := make([]attr.Value, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		value, d := types.ObjectValue(
+			resolvedArtifactAttrTypes,
+			map[string]attr.Value{
+				"path":   types.StringValue(artifact.Path),
+				"sha256": types.StringValue(artifact.SHA256),
+			},
+		)
+		diags.Append(d...)
+		values = append(values, value)
+	}
+
+	resolved, d := types.ListValue(resolvedArtifactsType.ElemType, values)
+	diags.Append(d...)
+
+	return resolved, diags
+}
+
+// resolveBuildArtifacts lists the artifacts of the resolved builds, and their dependencies when included.
+func resolveBuildArtifacts(ctx context.Context, client *resty.Client, resolvedBuilds types.List) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if resolvedBuilds.IsUnknown() {
+		return types.ListUnknown(resolvedArtifactsType.ElemType), diags
+	}
+
+	var artifacts []resolvedArtifact
+	for _, elem := range resolvedBuilds.Elements() {
+		attrs := elem.(types.Object).Attributes()
+		name := attrs["name"].(types.String).ValueString()
+		number := attrs["number"].(types.String).ValueString()
+
+		domains := []string{"artifact"}
+		if attrs["include_dependencies"].(types.Bool).ValueBool() {
+			domains = append(domains, "dependency")
+		}
+
+		for _, domain := range domains {
+			items, err := findItems(ctx, client, map[string]any{
+				domain + ".module.build.name":   name,
+				domain + ".module.build.number": number,
+			})
+			if err != nil {
+				diags.AddAttributeError(
+					fwpath.Root("resolved_artifacts"),
+					"Unable to Resolve Build Artifacts",
+					fmt.Sprintf("build %s number %s: %s", name, number, err.Error()),
+				)
+				return types.ListNull(resolvedArtifactsType.ElemType), diags
+			}
+
+			artifacts = append(artifacts, items...)
+		}
+	}
+
+	resolved, d := resolvedArtifactsValue(artifacts)
+	diags.Append(d...)
+
+	return resolved, diags
+}
+
+// resolvePackageArtifacts lists the files of each `source.packages` element. It returns an unknown list
+// while any package is unknown, so the resolution is retried during apply.
+func resolvePackageArtifacts(ctx context.Context, client *resty.Client, packages types.Set) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if packages.IsUnknown() {
+		return types.ListUnknown(resolvedArtifactsType.ElemType), diags
+	}
+
+	var artifacts []resolvedArtifact
+	for _, elem := range packages.Elements() {
+		if elem.IsUnknown() {
+			return types.ListUnknown(resolvedArtifactsType.ElemType), diags
+		}
+
+		attrs := elem.(types.Object).Attributes()
+		for _, value := range attrs {
+			if value.IsUnknown() {
+				return types.ListUnknown(resolvedArtifactsType.ElemType), diags
+			}
+		}
+
+		packageType := attrs["type"].(types.String).ValueString()
+		name := attrs["name"].(types.String).ValueString()
+		version := attrs["version"].(types.String).ValueString()
+		repositoryKey := attrs["repository_key"].(types.String).ValueString()
+
+		criteria, err := packageItemsCriteria(packageType, name, version, repositoryKey)
+		if err != nil {
+			diags.AddAttributeError(
+				fwpath.Root("source").AtName("packages"),
+				"Unable to Resolve Package Artifacts",
+				fmt.Sprintf("package %s version %s: %s", name, version, err.Error()),
+			)
+			continue
+		}
+		if criteria == nil {
+			diags.AddAttributeWarning(
+				fwpath.Root("source").AtName("packages"),
+				"Package Artifacts Not Resolved",
+				fmt.Sprintf("The artifacts of %s packages can't be listed during plan, the artifacts of package %s version %s are not in `resolved_artifacts` but are still collected by Artifactory.", packageType, name, version),
+			)
+			continue
+		}
+
+		items, err := findItems(ctx, client, criteria)
+		if err == nil && len(items) == 0 {
+			err = fmt.Errorf("no artifact found in repository %s", repositoryKey)
+		}
+		if err != nil {
+			diags.AddAttributeError(
+				fwpath.Root("source").AtName("packages"),
+				"Unable to Resolve Package Artifacts",
+				fmt.Sprintf("package %s version %s: %s", name, version, err.Error()),
+			)
+			continue
+		}
+
+		artifacts = append(artifacts, items...)
+	}
+
+	if diags.HasError() {
+		return types.ListNull(resolvedArtifactsType.ElemType), diags
+	}
+
+	resolved, d := resolvedArtifactsValue(artifacts)
+	diags.Append(d...)
+
+	return resolved, diags
+}
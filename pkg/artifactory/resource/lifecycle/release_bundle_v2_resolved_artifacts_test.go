@@ -0,0 +1,126 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPackageItemsCriteria(t *testing.T) {
+	testCases := []struct {
+		packageType string
+		name        string
+		version     string
+		expected    map[string]any
+	}{
+		{"npm", "my-lib", "1.0.0", map[string]any{"repo": "repo", "@npm.name": "my-lib", "@npm.version": "1.0.0"}},
+		{"docker", "my-image", "1.0", map[string]any{"repo": "repo", "path": "my-image/1.0"}},
+		{"maven", "org.example:my-lib", "1.0", map[string]any{"repo": "repo", "path": "org/example/my-lib/1.0"}},
+		{"go", "github.com/example/mod", "v1.0.0", map[string]any{"repo": "repo", "path": "github.com/example/mod/@v", "name": map[string]string{"$match": "v1.0.0.*"}}},
+		{"cargo", "my-crate", "1.0.0", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.packageType, func(t *testing.T) {
+			criteria, err := packageItemsCriteria(tc.packageType, tc.name, tc.version, "repo")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.expected == nil && criteria != nil || tc.expected != nil && !reflect.DeepEqual(criteria, tc.expected) {
+				t.Errorf("expected criteria %v, got %v", tc.expected, criteria)
+			}
+		})
+	}
+
+	if _, err := packageItemsCriteria("maven", "my-lib", "1.0", "repo"); err == nil || !strings.Contains(err.Error(), "<groupId>:<artifactId>") {
+		t.Errorf("expected name format error, got %v", err)
+	}
+}
+
+func TestResolvePackageArtifacts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case strings.Contains(string(body), `"@npm.name":"my-lib"`):
+			_ = json.NewEncoder(w).Encode(releaseBundleV2ItemAQLResult{Results: []releaseBundleV2ItemAPIModel{
+				{Repo: "npm-local", Path: "my-lib/-", Name: "my-lib-1.0.0.tgz", SHA256: "abc"},
+			}})
+		case strings.Contains(string(body), `"path":"my-image/1.0"`):
+			_ = json.NewEncoder(w).Encode(releaseBundleV2ItemAQLResult{Results: []releaseBundleV2ItemAPIModel{
+				{Repo: "docker-local", Path: "my-image/1.0", Name: "manifest.json", SHA256: "def"},
+			}})
+		default:
+			_, _ = w.Write([]byte(`{"results":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	packageAttrTypes := map[string]attr.Type{
+		"name":           types.StringType,
+		"version":        types.StringType,
+		"type":           types.StringType,
+		"repository_key": types.StringType,
+	}
+	packagesValue := func(packages ...[4]string) types.Set {
+		elems := make([]attr.Value, 0, len(packages))
+		for _, p := range packages {
+			elems = append(elems, types.ObjectValueMust(packageAttrTypes, map[string]attr.Value{
+				"name":           types.StringValue(p[0]),
+				"version":        types.StringValue(p[1]),
+				"type":           types.StringValue(p[2]),
+				"repository_key": types.StringValue(p[3]),
+			}))
+		}
+		return types.SetValueMust(types.ObjectType{AttrTypes: packageAttrTypes}, elems)
+	}
+
+	client := resty.New().SetBaseURL(server.URL)
+
+	resolved, diags := resolvePackageArtifacts(context.Background(), client, packagesValue(
+		[4]string{"my-lib", "1.0.0", "npm", "npm-local"},
+		[4]string{"my-image", "1.0", "docker", "docker-local"},
+	))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	paths := []string{}
+	for _, elem := range resolved.Elements() {
+		paths = append(paths, elem.(types.Object).Attributes()["path"].(types.String).ValueString())
+	}
+	if !reflect.DeepEqual(paths, []string{"docker-local/my-image/1.0/manifest.json", "npm-local/my-lib/-/my-lib-1.0.0.tgz"}) {
+		t.Errorf("unexpected resolved artifacts %v", paths)
+	}
+
+	_, diags = resolvePackageArtifacts(context.Background(), client, packagesValue(
+		[4]string{"missing", "1.0.0", "npm", "npm-local"},
+	))
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "no artifact found") {
+		t.Errorf("expected no artifact found error, got %v", diags)
+	}
+}
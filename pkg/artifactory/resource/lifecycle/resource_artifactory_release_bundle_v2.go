@@ -20,6 +20,7 @@ import (
 	"net/http"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -32,6 +33,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
@@ -41,9 +44,11 @@ import (
 const (
 	ReleaseBundleV2Endpoint        = "lifecycle/api/v2/release_bundle"
 	ReleaseBundleV2VersionEndpoint = "lifecycle/api/v2/release_bundle/records/{name}/{version}"
+	ReleaseBundleV2StatusEndpoint  = "lifecycle/api/v2/release_bundle/statuses/{name}/{version}"
 )
 
 var _ resource.Resource = &ReleaseBundleV2Resource{}
+var _ resource.ResourceWithModifyPlan = &ReleaseBundleV2Resource{}
 
 func NewReleaseBundleV2Resource() resource.Resource {
 	return &ReleaseBundleV2Resource{
@@ -64,9 +69,13 @@ type ReleaseBundleV2ResourceModel struct {
 	SkipDockerManifestResolution types.Bool   `tfsdk:"skip_docker_manifest_resolution"`
 	SourceType                   types.String `tfsdk:"source_type"`
 	Source                       types.Object `tfsdk:"source"`
+	ResolvedBuilds               types.List   `tfsdk:"resolved_builds"`
+	ResolvedArtifacts            types.List   `tfsdk:"resolved_artifacts"`
 	Created                      types.String `tfsdk:"created"`
 	CreatedBy                    types.String `tfsdk:"created_by"`
 	ServiceID                    types.String `tfsdk:"service_id"`
+	Status                       types.String `tfsdk:"status"`
+	Timeouts                     types.Object `tfsdk:"timeouts"`
 }
 
 func (m ReleaseBundleV2ResourceModel) toAPIModel(_ context.Context, apiModel *ReleaseBundleV2RequestAPIModel) (diags diag.Diagnostics) {
@@ -76,6 +85,7 @@ func (m ReleaseBundleV2ResourceModel) toAPIModel(_ context.Context, apiModel *Re
 		Artifacts:      []ReleaseBundleV2SourceArtifactAPIModel{},
 		Builds:         []ReleaseBundleV2SourceBuildAPIModel{},
 		ReleaseBundles: []ReleaseBundleV2SourceReleaseBundleAPIModel{},
+		Packages:       []ReleaseBundleV2SourcePackageAPIModel{},
 	}
 
 	sourceAttrs := m.Source.Attributes()
@@ -169,6 +179,50 @@ func (m ReleaseBundleV2ResourceModel) toAPIModel(_ context.Context, apiModel *Re
 		)
 
 		source.ReleaseBundles = releaseBundles
+
+	case "packages":
+		packagesSet, ok := sourceAttrs[sourceType]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("source").AtName(sourceType),
+				"failed to access source attribute value",
+				"",
+			)
+		}
+
+		packages := lo.Map(
+			packagesSet.(types.Set).Elements(),
+			func(elem attr.Value, _ int) ReleaseBundleV2SourcePackageAPIModel {
+				attrs := elem.(types.Object).Attributes()
+
+				return ReleaseBundleV2SourcePackageAPIModel{
+					Name:          attrs["name"].(types.String).ValueString(),
+					Version:       attrs["version"].(types.String).ValueString(),
+					Type:          attrs["type"].(types.String).ValueString(),
+					RepositoryKey: attrs["repository_key"].(types.String).ValueString(),
+				}
+			},
+		)
+
+		source.Packages = packages
+
+	case buildSelectorsSourceType:
+		builds := lo.Map(
+			m.ResolvedBuilds.Elements(),
+			func(elem attr.Value, _ int) ReleaseBundleV2SourceBuildAPIModel {
+				attrs := elem.(types.Object).Attributes()
+
+				return ReleaseBundleV2SourceBuildAPIModel{
+					Repository:          attrs["repository"].(types.String).ValueString(),
+					Name:                attrs["name"].(types.String).ValueString(),
+					Number:              attrs["number"].(types.String).ValueString(),
+					IncludeDependencies: attrs["include_dependencies"].(types.Bool).ValueBool(),
+				}
+			},
+		)
+
+		source.Builds = builds
+		sourceType = "builds"
 	}
 
 	*apiModel = ReleaseBundleV2RequestAPIModel{
@@ -195,6 +249,7 @@ type ReleaseBundleV2SourceAPIModel struct {
 	Artifacts      []ReleaseBundleV2SourceArtifactAPIModel      `json:"artifacts,omitempty"`
 	Builds         []ReleaseBundleV2SourceBuildAPIModel         `json:"builds,omitempty"`
 	ReleaseBundles []ReleaseBundleV2SourceReleaseBundleAPIModel `json:"release_bundles,omitempty"`
+	Packages       []ReleaseBundleV2SourcePackageAPIModel       `json:"packages,omitempty"`
 }
 
 type ReleaseBundleV2SourceArtifactAPIModel struct {
@@ -217,6 +272,13 @@ type ReleaseBundleV2SourceReleaseBundleAPIModel struct {
 	ReleaseBundleVersion string `json:"release_bundle_version"`
 }
 
+type ReleaseBundleV2SourcePackageAPIModel struct {
+	Name          string `json:"package_name"`
+	Version       string `json:"package_version"`
+	Type          string `json:"package_type"`
+	RepositoryKey string `json:"repository_key"`
+}
+
 type ReleaseBundleV2ResponseAPIModel struct {
 	RepositoryKey string `json:"repository_key"`
 	Name          string `json:"release_bundle_name"`
@@ -281,12 +343,12 @@ func (r *ReleaseBundleV2Resource) Schema(ctx context.Context, req resource.Schem
 				Required: true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
-					stringvalidator.OneOf("aql", "artifacts", "builds", "release_bundles"),
+					stringvalidator.OneOf("aql", "artifacts", "builds", "release_bundles", "packages", buildSelectorsSourceType),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
-				Description: "Source type. Valid values: `aql`, `artifacts`, `builds`, `release_bundles`",
+				Description: "Source type. Valid values: `aql`, `artifacts`, `builds`, `release_bundles`, `packages`, `build_selectors`",
 			},
 			"source": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -297,6 +359,8 @@ func (r *ReleaseBundleV2Resource) Schema(ctx context.Context, req resource.Schem
 								path.MatchRelative().AtParent().AtName("artifacts"),
 								path.MatchRelative().AtParent().AtName("builds"),
 								path.MatchRelative().AtParent().AtName("release_bundles"),
+								path.MatchRelative().AtParent().AtName("packages"),
+								path.MatchRelative().AtParent().AtName("build_selectors"),
 							),
 						},
 						MarkdownDescription: "The contents of the AQL query.",
@@ -326,6 +390,8 @@ func (r *ReleaseBundleV2Resource) Schema(ctx context.Context, req resource.Schem
 								path.MatchRelative().AtParent().AtName("aql"),
 								path.MatchRelative().AtParent().AtName("builds"),
 								path.MatchRelative().AtParent().AtName("release_bundles"),
+								path.MatchRelative().AtParent().AtName("packages"),
+								path.MatchRelative().AtParent().AtName("build_selectors"),
 							),
 						},
 						MarkdownDescription: "Source type to create a Release Bundle v2 version by collecting source artifacts from a list of path/checksum pairs.",
@@ -376,6 +442,8 @@ func (r *ReleaseBundleV2Resource) Schema(ctx context.Context, req resource.Schem
 								path.MatchRelative().AtParent().AtName("aql"),
 								path.MatchRelative().AtParent().AtName("artifacts"),
 								path.MatchRelative().AtParent().AtName("release_bundles"),
+								path.MatchRelative().AtParent().AtName("packages"),
+								path.MatchRelative().AtParent().AtName("build_selectors"),
 							),
 						},
 						MarkdownDescription: "Source type to create a Release Bundle v2 version by collecting source artifacts from one or multiple builds (also known as build-info).",
@@ -420,14 +488,127 @@ func (r *ReleaseBundleV2Resource) Schema(ctx context.Context, req resource.Schem
 								path.MatchRelative().AtParent().AtName("aql"),
 								path.MatchRelative().AtParent().AtName("artifacts"),
 								path.MatchRelative().AtParent().AtName("builds"),
+								path.MatchRelative().AtParent().AtName("packages"),
+								path.MatchRelative().AtParent().AtName("build_selectors"),
 							),
 						},
 						MarkdownDescription: "Source type to create a Release Bundle v2 version by collecting source artifacts from existing Release Bundle versions. Must match `source_type` attribute value.",
 					},
+					"packages": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+									MarkdownDescription: "Name of the package.",
+								},
+								"version": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+									MarkdownDescription: "Version of the package.",
+								},
+								"type": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+									MarkdownDescription: "Package type, e.g. `docker`, `npm` or `maven`.",
+								},
+								"repository_key": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+									MarkdownDescription: "Key of the repository containing the package.",
+								},
+							},
+						},
+						Optional: true,
+						Validators: []validator.Set{
+							setvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("aql"),
+								path.MatchRelative().AtParent().AtName("artifacts"),
+								path.MatchRelative().AtParent().AtName("builds"),
+								path.MatchRelative().AtParent().AtName("release_bundles"),
+								path.MatchRelative().AtParent().AtName("build_selectors"),
+							),
+						},
+						MarkdownDescription: "Source type to create a Release Bundle v2 version by collecting the artifacts of package versions. The artifacts of the package versions are listed during plan into `resolved_artifacts` for `conan`, `debian`, `docker`, `gems`, `go`, `gradle`, `helm`, `maven` (name formatted as `<groupId>:<artifactId>`), `npm`, `nuget`, `oci`, `pypi` and `rpm` packages, and a package version without any artifact fails the plan. The package versions are sent as configured and their artifacts are collected by Artifactory when the Release Bundle version is created. Must match `source_type` attribute value.",
+					},
+					"build_selectors": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+									MarkdownDescription: "Name of the build. Supports `*` and `?` wildcards, e.g. `my-app-*`. The most recently created matching build is selected.",
+								},
+								"status": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+									MarkdownDescription: "Only select builds whose latest promotion has this status, e.g. `released`.",
+								},
+								"repository": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+									MarkdownDescription: "The repository key of the build. If omitted, the system uses the default built-in repository, `artifactory-build-info`.",
+								},
+								"include_dependencies": schema.BoolAttribute{
+									Optional:            true,
+									Computed:            true,
+									Default:             booldefault.StaticBool(false),
+									MarkdownDescription: "Determines whether to include build dependencies in the Release Bundle. The default value is `false`.",
+								},
+							},
+						},
+						Optional: true,
+						Validators: []validator.List{
+							listvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("aql"),
+								path.MatchRelative().AtParent().AtName("artifacts"),
+								path.MatchRelative().AtParent().AtName("builds"),
+								path.MatchRelative().AtParent().AtName("release_bundles"),
+								path.MatchRelative().AtParent().AtName("packages"),
+							),
+						},
+						MarkdownDescription: "Source type to create a Release Bundle v2 version from the latest builds matching a name pattern and promotion status. The build names and numbers are resolved during plan into `resolved_builds`, and their artifacts into `resolved_artifacts`. They are not resolved again once the Release Bundle version is created. Must match `source_type` attribute value.",
+					},
 				},
 				Required:            true,
 				MarkdownDescription: "Defines specific repositories to include in the promotion. If this property is left undefined, all repositories (except those specifically excluded) are included in the promotion. Important: If one or more repositories are specifically included, all other repositories are excluded (regardless of what is defined in `excluded_repository_keys`).",
 			},
+			"resolved_builds": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":                 schema.StringAttribute{Computed: true},
+						"number":               schema.StringAttribute{Computed: true},
+						"repository":           schema.StringAttribute{Computed: true},
+						"include_dependencies": schema.BoolAttribute{Computed: true},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "Builds selected by `source.build_selectors`, resolved during plan. Null for other source types.",
+			},
+			"resolved_artifacts": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path":   schema.StringAttribute{Computed: true},
+						"sha256": schema.StringAttribute{Computed: true},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "Artifacts of the `resolved_builds` (with their dependencies when `include_dependencies` is set) or of the `source.packages`, listed during plan and sorted by path. Null for other source types.",
+			},
 			"created": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Timestamp when the new version was created (ISO 8601 standard).",
@@ -440,6 +621,16 @@ func (r *ReleaseBundleV2Resource) Schema(ctx context.Context, req resource.Schem
 				Computed:            true,
 				MarkdownDescription: "The unique identifier of the Artifactory instance where the Release Bundle was created.",
 			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Creation status of the Release Bundle version. The version is recorded as `PENDING` as soon as it is submitted and is updated to `COMPLETED` or `FAILED` once creation finishes.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock,
 		},
 		MarkdownDescription: "This resource enables you to creates a new Release Bundle v2, uniquely identified by a combination of repository key, name, and version. For more information, see [Understanding Release Bundles v2](https://jfrog.com/help/r/jfrog-artifactory-documentation/understanding-release-bundles-v2) and [REST API](https://jfrog.com/help/r/jfrog-rest-apis/create-release-bundle-v2-version).",
 	}
@@ -462,9 +653,9 @@ func (r ReleaseBundleV2Resource) ValidateConfig(ctx context.Context, req resourc
 	}
 
 	sourceType := data.SourceType.ValueString()
-	sourceAttrs := data.Source.Attributes()
 
-	if _, ok := sourceAttrs[sourceType]; !ok {
+	// every source type is an attribute of `source`, null when not configured
+	if value, ok := data.Source.Attributes()[sourceType]; ok && value.IsNull() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("source").AtName(sourceType),
 			"Invalid Attribute Configuration",
@@ -473,16 +664,93 @@ func (r ReleaseBundleV2Resource) ValidateConfig(ctx context.Context, req resourc
 	}
 }
 
+func (r *ReleaseBundleV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ReleaseBundleV2ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve during apply when the source, or the provider configuration, is not known yet
+	if plan.SourceType.IsUnknown() || plan.Source.IsUnknown() || r.ProviderData.Client == nil {
+		return
+	}
+
+	sourceType := plan.SourceType.ValueString()
+	if sourceType != buildSelectorsSourceType && sourceType != "packages" {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_builds"), types.ListNull(resolvedBuildsType.ElemType))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_artifacts"), types.ListNull(resolvedArtifactsType.ElemType))...)
+		return
+	}
+
+	// A created version is immutable, keep the builds and artifacts it was created from
+	if !req.State.Raw.IsNull() {
+		var state ReleaseBundleV2ResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.SourceType.Equal(plan.SourceType) && state.Source.Equal(plan.Source) && (sourceType != buildSelectorsSourceType || !state.ResolvedBuilds.IsNull()) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_builds"), state.ResolvedBuilds)...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_artifacts"), state.ResolvedArtifacts)...)
+			return
+		}
+	}
+
+	resolvedBuilds, resolvedArtifacts, diags := r.resolveSource(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_builds"), resolvedBuilds)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_artifacts"), resolvedArtifacts)...)
+}
+
+// resolveSource resolves the builds selected by `source.build_selectors` and the artifacts of the builds or of
+// `source.packages`. Both are null for the other source types.
+func (r *ReleaseBundleV2Resource) resolveSource(ctx context.Context, plan ReleaseBundleV2ResourceModel) (types.List, types.List, diag.Diagnostics) {
+	resolvedBuilds := types.ListNull(resolvedBuildsType.ElemType)
+	resolvedArtifacts := types.ListNull(resolvedArtifactsType.ElemType)
+	var diags diag.Diagnostics
+
+	switch plan.SourceType.ValueString() {
+	case buildSelectorsSourceType:
+		var d diag.Diagnostics
+		resolvedBuilds, d = resolveBuildSelectors(ctx, r.ProviderData.Client, plan.Source.Attributes()[buildSelectorsSourceType].(types.List), plan.ProjectKey.ValueString())
+		diags.Append(d...)
+		if diags.HasError() {
+			return resolvedBuilds, resolvedArtifacts, diags
+		}
+
+		resolvedArtifacts, d = resolveBuildArtifacts(ctx, r.ProviderData.Client, resolvedBuilds)
+		diags.Append(d...)
+
+	case "packages":
+		var d diag.Diagnostics
+		resolvedArtifacts, d = resolvePackageArtifacts(ctx, r.ProviderData.Client, plan.Source.Attributes()["packages"].(types.Set))
+		diags.Append(d...)
+	}
+
+	return resolvedBuilds, resolvedArtifacts, diags
+}
+
 func (r *ReleaseBundleV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), r.TypeName)
 }
 
 func (r *ReleaseBundleV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ReleaseBundleV2ResourceModel
 
@@ -492,15 +760,32 @@ func (r *ReleaseBundleV2Resource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// Sources that were unknown during plan are resolved now
+	if plan.ResolvedBuilds.IsUnknown() || plan.ResolvedArtifacts.IsUnknown() {
+		resolvedBuilds, resolvedArtifacts, diags := r.resolveSource(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.ResolvedBuilds = resolvedBuilds
+		plan.ResolvedArtifacts = resolvedArtifacts
+	}
+
 	var releaseBundle ReleaseBundleV2RequestAPIModel
 	resp.Diagnostics.Append(plan.toAPIModel(ctx, &releaseBundle)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	timeout, diags := createTimeout(plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request := r.ProviderData.Client.R().
 		SetHeader("X-JFrog-Signing-Key-Name", plan.KeyPairName.ValueString()).
-		SetQueryParam("async", "false")
+		SetQueryParam("async", "true")
 
 	if !plan.ProjectKey.IsNull() {
 		request.SetQueryParam("project", plan.ProjectKey.ValueString())
@@ -525,13 +810,55 @@ func (r *ReleaseBundleV2Resource) Create(ctx context.Context, req resource.Creat
 	plan.Created = types.StringValue(result.Created)
 	plan.CreatedBy = types.StringNull()
 	plan.ServiceID = types.StringNull()
+	plan.Status = types.StringValue(releaseBundleV2StatusPending)
 
-	// Save data into Terraform state
+	// Record the submitted version before polling so it is tracked in state
+	// even if creation fails or times out.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := waitForReleaseBundleV2Status(ctx, timeout, func() (ReleaseBundleV2StatusAPIModel, error) {
+		return r.getStatus(plan)
+	})
+	plan.Status = types.StringValue(status.Status)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, fmt.Sprintf("Release Bundle %s version %s: %s", plan.Name.ValueString(), plan.Version.ValueString(), err.Error()))
+	}
+}
+
+func (r *ReleaseBundleV2Resource) getStatus(model ReleaseBundleV2ResourceModel) (ReleaseBundleV2StatusAPIModel, error) {
+	var status ReleaseBundleV2StatusAPIModel
+
+	request := r.ProviderData.Client.R()
+
+	if !model.ProjectKey.IsNull() {
+		request.SetQueryParam("project", model.ProjectKey.ValueString())
+	}
+
+	response, err := request.
+		SetPathParams(map[string]string{
+			"name":    model.Name.ValueString(),
+			"version": model.Version.ValueString(),
+		}).
+		SetResult(&status).
+		Get(ReleaseBundleV2StatusEndpoint)
+	if err != nil {
+		return status, err
+	}
+
+	if response.IsError() {
+		return status, fmt.Errorf("%s", response.String())
+	}
+
+	return status, nil
 }
 
 func (r *ReleaseBundleV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ReleaseBundleV2ResourceModel
 
@@ -575,11 +902,33 @@ func (r *ReleaseBundleV2Resource) Read(ctx context.Context, req resource.ReadReq
 	state.CreatedBy = types.StringValue(releaseBundle.CreatedBy)
 	state.ServiceID = types.StringValue(releaseBundle.ServiceID)
 
+	// Refresh the status of versions whose creation was interrupted.
+	if state.Status.ValueString() != releaseBundleV2StatusCompleted {
+		status, err := r.getStatus(state)
+		if err != nil {
+			utilfw.UnableToRefreshResourceError(resp, err.Error())
+			return
+		}
+		state.Status = types.StringValue(status.Status)
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ReleaseBundleV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ReleaseBundleV2ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// timeouts only affect how the provider waits, so they can be changed in place.
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
 	resp.Diagnostics.AddWarning(
 		"Update not supported",
 		"Release Bundle V2 cannnot be updated.",
@@ -587,7 +936,7 @@ func (r *ReleaseBundleV2Resource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *ReleaseBundleV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ReleaseBundleV2ResourceModel
 
@@ -613,6 +962,11 @@ func (r *ReleaseBundleV2Resource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	// A failed creation may already have been cleaned up by the server.
+	if response.StatusCode() == http.StatusNotFound {
+		return
+	}
+
 	if response.IsError() {
 		utilfw.UnableToDeleteResourceError(resp, response.String())
 		return
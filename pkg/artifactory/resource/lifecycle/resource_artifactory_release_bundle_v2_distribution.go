@@ -0,0 +1,515 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+const (
+	ReleaseBundleV2DistributeEndpoint          = "lifecycle/api/v2/distribution/distribute/{name}/{version}"
+	ReleaseBundleV2DistributionTrackerEndpoint = "lifecycle/api/v2/distribution/trackers/{name}/{version}/{tracker_id}"
+	ReleaseBundleV2RemoteDeleteEndpoint        = "lifecycle/api/v2/distribution/remote_delete/{name}/{version}"
+)
+
+var _ resource.Resource = &ReleaseBundleV2DistributionResource{}
+
+func NewReleaseBundleV2DistributionResource() resource.Resource {
+	return &ReleaseBundleV2DistributionResource{
+		TypeName: "artifactory_release_bundle_v2_distribution",
+	}
+}
+
+type ReleaseBundleV2DistributionResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ReleaseBundleV2DistributionResourceModel struct {
+	Name                   types.String `tfsdk:"name"`
+	Version                types.String `tfsdk:"version"`
+	ProjectKey             types.String `tfsdk:"project_key"`
+	DistributionRules      types.List   `tfsdk:"distribution_rules"`
+	PathMappings           types.List   `tfsdk:"path_mappings"`
+	AutoCreateMissingRepos types.Bool   `tfsdk:"auto_create_missing_repos"`
+	TrackerID              types.Int64  `tfsdk:"tracker_id"`
+	Status                 types.String `tfsdk:"status"`
+	Timeouts               types.Object `tfsdk:"timeouts"`
+}
+
+func (m ReleaseBundleV2DistributionResourceModel) distributionRulesToAPIModel(ctx context.Context) ([]ReleaseBundleV2DistributionRuleAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rules := lo.Map(
+		m.DistributionRules.Elements(),
+		func(elem attr.Value, _ int) ReleaseBundleV2DistributionRuleAPIModel {
+			attrs := elem.(types.Object).Attributes()
+
+			var countryCodes []string
+			diags.Append(attrs["country_codes"].(types.Set).ElementsAs(ctx, &countryCodes, false)...)
+
+			return ReleaseBundleV2DistributionRuleAPIModel{
+				SiteName:     attrs["site_name"].(types.String).ValueString(),
+				CityName:     attrs["city_name"].(types.String).ValueString(),
+				CountryCodes: countryCodes,
+			}
+		},
+	)
+
+	return rules, diags
+}
+
+func (m ReleaseBundleV2DistributionResourceModel) toAPIModel(ctx context.Context, apiModel *ReleaseBundleV2DistributionRequestAPIModel) (diags diag.Diagnostics) {
+	rules, d := m.distributionRulesToAPIModel(ctx)
+	diags.Append(d...)
+
+	mappings := lo.Map(
+		m.PathMappings.Elements(),
+		func(elem attr.Value, _ int) ReleaseBundleV2DistributionMappingAPIModel {
+			attrs := elem.(types.Object).Attributes()
+
+			return ReleaseBundleV2DistributionMappingAPIModel{
+				Input:  attrs["input"].(types.String).ValueString(),
+				Output: attrs["output"].(types.String).ValueString(),
+			}
+		},
+	)
+
+	*apiModel = ReleaseBundleV2DistributionRequestAPIModel{
+		AutoCreateMissingRepos: m.AutoCreateMissingRepos.ValueBool(),
+		DistributionRules:      rules,
+	}
+
+	if len(mappings) > 0 {
+		apiModel.Modifications = &ReleaseBundleV2DistributionModificationsAPIModel{
+			Mappings: mappings,
+		}
+	}
+
+	return
+}
+
+type ReleaseBundleV2DistributionRequestAPIModel struct {
+	AutoCreateMissingRepos bool                                              `json:"auto_create_missing_repositories"`
+	DistributionRules      []ReleaseBundleV2DistributionRuleAPIModel         `json:"distribution_rules"`
+	Modifications          *ReleaseBundleV2DistributionModificationsAPIModel `json:"modifications,omitempty"`
+}
+
+type ReleaseBundleV2DistributionRuleAPIModel struct {
+	SiteName     string   `json:"site_name,omitempty"`
+	CityName     string   `json:"city_name,omitempty"`
+	CountryCodes []string `json:"country_codes,omitempty"`
+}
+
+type ReleaseBundleV2DistributionModificationsAPIModel struct {
+	Mappings []ReleaseBundleV2DistributionMappingAPIModel `json:"mappings"`
+}
+
+type ReleaseBundleV2DistributionMappingAPIModel struct {
+	Input  string `json:"input"`
+	Output string `json:"output"`
+}
+
+type ReleaseBundleV2DistributionResponseAPIModel struct {
+	ID int64 `json:"id"`
+}
+
+type ReleaseBundleV2DistributionTrackerAPIModel struct {
+	ID       int64                                              `json:"id"`
+	Status   string                                             `json:"status"`
+	Messages []ReleaseBundleV2MessageAPIModel                   `json:"messages"`
+	Targets  []ReleaseBundleV2DistributionTrackerTargetAPIModel `json:"targets"`
+}
+
+type ReleaseBundleV2DistributionTrackerTargetAPIModel struct {
+	TargetName string `json:"target_name"`
+	Status     string `json:"status"`
+}
+
+// toStatusAPIModel adds the targets that failed to the tracker messages so a
+// FAILED distribution reports which edge nodes were affected.
+func (m ReleaseBundleV2DistributionTrackerAPIModel) toStatusAPIModel() ReleaseBundleV2StatusAPIModel {
+	messages := m.Messages
+	for _, target := range m.Targets {
+		if target.Status == releaseBundleV2StatusFailed {
+			messages = append(messages, ReleaseBundleV2MessageAPIModel{
+				Text: fmt.Sprintf("distribution to target %s failed", target.TargetName),
+			})
+		}
+	}
+
+	return ReleaseBundleV2StatusAPIModel{
+		Status:   m.Status,
+		Messages: messages,
+	}
+}
+
+type ReleaseBundleV2RemoteDeleteRequestAPIModel struct {
+	DryRun            bool                                      `json:"dry_run"`
+	DistributionRules []ReleaseBundleV2DistributionRuleAPIModel `json:"distribution_rules"`
+}
+
+func (r *ReleaseBundleV2DistributionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ReleaseBundleV2DistributionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of Release Bundle",
+			},
+			"version": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Version to distribute",
+			},
+			"project_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					validatorfw_string.ProjectKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Project key the Release Bundle belongs to",
+			},
+			"distribution_rules": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"site_name": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+							MarkdownDescription: "Name of the distribution target site. Supports `*` wildcards.",
+						},
+						"city_name": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+							MarkdownDescription: "Name of the city of the distribution targets. Supports `*` wildcards.",
+						},
+						"country_codes": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							MarkdownDescription: "Country codes of the distribution targets. Supports `*` wildcards.",
+						},
+					},
+				},
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Selectors for the distribution targets (edge nodes). A target is selected when it matches all the attributes of at least one rule. The same rules are used to delete the distributed version from the targets on destroy.",
+			},
+			"path_mappings": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"input": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+							MarkdownDescription: "Regular expression matching the source path of the artifacts, e.g. `(.*)/(.*)`.",
+						},
+						"output": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+							MarkdownDescription: "Target path of the artifacts on the edge nodes. Capture groups of `input` can be referenced, e.g. `$1/target/$2`.",
+						},
+					},
+				},
+				Optional: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Maps the repository paths of the Release Bundle artifacts to different paths on the distribution targets.",
+			},
+			"auto_create_missing_repos": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Create the target repositories on the edge nodes if they are missing. The default value is `false`.",
+			},
+			"tracker_id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "ID of the distribution tracker.",
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Status of the distribution. The distribution is recorded as `PENDING` as soon as it is submitted and is updated to `COMPLETED` or `FAILED` once it finishes.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock,
+		},
+		MarkdownDescription: "This resource enables you to distribute a Release Bundle v2 version to distribution targets (edge nodes). The version is deleted from the targets on destroy. For more information, see [JFrog documentation](https://jfrog.com/help/r/jfrog-artifactory-documentation/distribute-a-release-bundle-v2).",
+	}
+}
+
+func (r *ReleaseBundleV2DistributionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), r.TypeName)
+}
+
+func (r *ReleaseBundleV2DistributionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
+
+	var plan ReleaseBundleV2DistributionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var distribution ReleaseBundleV2DistributionRequestAPIModel
+	resp.Diagnostics.Append(plan.toAPIModel(ctx, &distribution)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := createTimeout(plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := r.ProviderData.Client.R()
+
+	if !plan.ProjectKey.IsNull() {
+		request.SetQueryParam("project", plan.ProjectKey.ValueString())
+	}
+
+	var result ReleaseBundleV2DistributionResponseAPIModel
+
+	response, err := request.
+		SetPathParams(map[string]string{
+			"name":    plan.Name.ValueString(),
+			"version": plan.Version.ValueString(),
+		}).
+		SetBody(distribution).
+		SetResult(&result).
+		Post(ReleaseBundleV2DistributeEndpoint)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, response.String())
+		return
+	}
+
+	plan.TrackerID = types.Int64Value(result.ID)
+	plan.Status = types.StringValue(releaseBundleV2StatusPending)
+
+	// Record the submitted distribution before polling so it is tracked in
+	// state even if it fails or times out.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := waitForReleaseBundleV2Status(ctx, timeout, func() (ReleaseBundleV2StatusAPIModel, error) {
+		tracker, _, err := r.getTracker(plan)
+		return tracker.toStatusAPIModel(), err
+	})
+	plan.Status = types.StringValue(status.Status)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, fmt.Sprintf("distribution of Release Bundle %s version %s: %s", plan.Name.ValueString(), plan.Version.ValueString(), err.Error()))
+	}
+}
+
+func (r *ReleaseBundleV2DistributionResource) getTracker(model ReleaseBundleV2DistributionResourceModel) (ReleaseBundleV2DistributionTrackerAPIModel, *resty.Response, error) {
+	var tracker ReleaseBundleV2DistributionTrackerAPIModel
+
+	request := r.ProviderData.Client.R()
+
+	if !model.ProjectKey.IsNull() {
+		request.SetQueryParam("project", model.ProjectKey.ValueString())
+	}
+
+	response, err := request.
+		SetPathParams(map[string]string{
+			"name":       model.Name.ValueString(),
+			"version":    model.Version.ValueString(),
+			"tracker_id": fmt.Sprintf("%d", model.TrackerID.ValueInt64()),
+		}).
+		SetResult(&tracker).
+		Get(ReleaseBundleV2DistributionTrackerEndpoint)
+	if err != nil {
+		return tracker, response, err
+	}
+
+	if response.IsError() {
+		return tracker, response, fmt.Errorf("%s", response.String())
+	}
+
+	return tracker, response, nil
+}
+
+func (r *ReleaseBundleV2DistributionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
+
+	var state ReleaseBundleV2DistributionResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tracker, response, err := r.getTracker(state)
+	if response != nil && response.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	state.Status = types.StringValue(tracker.Status)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ReleaseBundleV2DistributionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ReleaseBundleV2DistributionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All other attributes require replacement, so only timeouts can change here.
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ReleaseBundleV2DistributionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
+
+	var state ReleaseBundleV2DistributionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := state.distributionRulesToAPIModel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := r.ProviderData.Client.R()
+
+	if !state.ProjectKey.IsNull() {
+		request.SetQueryParam("project", state.ProjectKey.ValueString())
+	}
+
+	response, err := request.
+		SetPathParams(map[string]string{
+			"name":    state.Name.ValueString(),
+			"version": state.Version.ValueString(),
+		}).
+		SetBody(ReleaseBundleV2RemoteDeleteRequestAPIModel{
+			DryRun:            false,
+			DistributionRules: rules,
+		}).
+		Post(ReleaseBundleV2RemoteDeleteEndpoint)
+
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// The version is already gone from the targets, or was never distributed.
+	if response.StatusCode() == http.StatusNotFound {
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToDeleteResourceError(resp, response.String())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
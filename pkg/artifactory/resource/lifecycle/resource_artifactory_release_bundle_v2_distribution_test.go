@@ -0,0 +1,128 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccReleaseBundleV2Distribution_full(t *testing.T) {
+	jfrogURL := os.Getenv("JFROG_URL")
+	if !strings.HasSuffix(jfrogURL, "jfrog.io") {
+		t.Skipf("env var JFROG_URL '%s' is not a cloud instance. It also needs to have distribution enabled.", jfrogURL)
+	}
+
+	_, fqrn, resourceName := testutil.MkNames("test-release-bundle-v2-distribution", "artifactory_release_bundle_v2_distribution")
+	_, _, releaseBundleName := testutil.MkNames("test-release-bundle-v2", "artifactory_release_bundle_v2")
+
+	repoName := fmt.Sprintf("test-repo-%d", testutil.RandomInt())
+	acctest.CreateRepo(t, repoName, "local", "maven", true, true)
+
+	_, _, err := uploadTestFile(t, repoName)
+	if err != nil {
+		t.Fatalf("failed to upload file: %s", err)
+	}
+
+	keyPairName := fmt.Sprintf("test-keypair-%d", testutil.RandomInt())
+
+	const template = `
+	resource "artifactory_keypair" "{{ .keypair_name }}" {
+		pair_name = "{{ .keypair_name }}"
+		pair_type = "RSA"
+		alias = "test-alias-{{ .keypair_name }}"
+		private_key = <<EOF
+{{ .private_key }}
+EOF
+		public_key = <<EOF
+{{ .public_key }}
+EOF
+	}
+	
+	resource "artifactory_release_bundle_v2" "{{ .release_bundle_name }}" {
+		name = "{{ .release_bundle_name }}"
+		version = "1.0.0"
+		keypair_name = artifactory_keypair.{{ .keypair_name }}.pair_name
+		skip_docker_manifest_resolution = true
+		source_type = "aql"
+
+		source = {
+			aql = "items.find({\"repo\": {\"$match\": \"{{ .repo_name }}\"}})"
+		}
+	}
+
+	resource "artifactory_release_bundle_v2_distribution" "{{ .name }}" {
+		name = artifactory_release_bundle_v2.{{ .release_bundle_name }}.name
+		version = artifactory_release_bundle_v2.{{ .release_bundle_name }}.version
+		auto_create_missing_repos = true
+
+		distribution_rules = [{
+			site_name = "*"
+		}]
+
+		path_mappings = [{
+			input = "(.*)/(.*)"
+			output = "$1/distributed/$2"
+		}]
+
+		timeouts {
+			create = "10m"
+		}
+	}`
+
+	testData := map[string]string{
+		"name":                resourceName,
+		"keypair_name":        keyPairName,
+		"repo_name":           repoName,
+		"release_bundle_name": releaseBundleName,
+		"private_key":         os.Getenv("JFROG_TEST_RSA_PRIVATE_KEY"),
+		"public_key":          os.Getenv("JFROG_TEST_RSA_PUBLIC_KEY"),
+	}
+
+	config := util.ExecuteTemplate("TestAccReleaseBundleV2Distribution_full", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			acctest.DeleteRepo(t, repoName)
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["release_bundle_name"]),
+					resource.TestCheckResourceAttr(fqrn, "version", "1.0.0"),
+					resource.TestCheckResourceAttr(fqrn, "distribution_rules.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "distribution_rules.0.site_name", "*"),
+					resource.TestCheckResourceAttr(fqrn, "path_mappings.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "auto_create_missing_repos", "true"),
+					resource.TestCheckResourceAttrSet(fqrn, "tracker_id"),
+					resource.TestCheckResourceAttr(fqrn, "status", "COMPLETED"),
+				),
+			},
+		},
+	})
+}
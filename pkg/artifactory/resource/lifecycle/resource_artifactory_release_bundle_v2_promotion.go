@@ -29,6 +29,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
@@ -62,6 +64,8 @@ type ReleaseBundleV2PromotionResourceModel struct {
 	ExcludedRepositoryKeys types.Set    `tfsdk:"excluded_repository_keys"`
 	Created                types.String `tfsdk:"created"`
 	CreatedMillis          types.Int64  `tfsdk:"created_millis"`
+	Status                 types.String `tfsdk:"status"`
+	Timeouts               types.Object `tfsdk:"timeouts"`
 }
 
 func (m ReleaseBundleV2PromotionResourceModel) toAPIModel(ctx context.Context, apiModel *ReleaseBundleV2PromotionPostRequestAPIModel) (diags diag.Diagnostics) {
@@ -92,9 +96,11 @@ type ReleaseBundleV2PromotionPostResponseAPIModel struct {
 }
 
 type ReleaseBundleV2PromotionGetAPIModel struct {
-	Environment   string `json:"environment"`
-	Created       string `json:"created"`
-	CreatedMillis int64  `json:"created_millis"`
+	Environment   string                           `json:"environment"`
+	Created       string                           `json:"created"`
+	CreatedMillis int64                            `json:"created_millis"`
+	Status        string                           `json:"status"`
+	Messages      []ReleaseBundleV2MessageAPIModel `json:"messages"`
 }
 
 func (r *ReleaseBundleV2PromotionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -164,6 +170,16 @@ func (r *ReleaseBundleV2PromotionResource) Schema(ctx context.Context, req resou
 				Computed:            true,
 				MarkdownDescription: "Timestamp when the new version was created (in milliseconds).",
 			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Status of the promotion. The promotion is recorded as `PENDING` as soon as it is submitted and is updated to `COMPLETED` or `FAILED` once it finishes.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock,
 		},
 		MarkdownDescription: "This resource enables you to promote Release Bundle V2 version. For more information, see [JFrog documentation](https://jfrog.com/help/r/jfrog-artifactory-documentation/promote-a-release-bundle-v2-to-a-target-environment).",
 	}
@@ -174,7 +190,7 @@ func (r *ReleaseBundleV2PromotionResource) Configure(ctx context.Context, req re
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), r.TypeName)
 }
 
 var retryOnNotAssignedToEnvironmentError = func(response *resty.Response, _r error) bool {
@@ -184,7 +200,7 @@ var retryOnNotAssignedToEnvironmentError = func(response *resty.Response, _r err
 }
 
 func (r *ReleaseBundleV2PromotionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ReleaseBundleV2PromotionResourceModel
 
@@ -200,9 +216,15 @@ func (r *ReleaseBundleV2PromotionResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	timeout, diags := createTimeout(plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request := r.ProviderData.Client.R().
 		SetHeader("X-JFrog-Signing-Key-Name", plan.KeyPairName.ValueString()).
-		SetQueryParam("async", "false")
+		SetQueryParam("async", "true")
 
 	if !plan.ProjectKey.IsNull() {
 		request.SetQueryParam("project", plan.ProjectKey.ValueString())
@@ -231,60 +253,102 @@ func (r *ReleaseBundleV2PromotionResource) Create(ctx context.Context, req resou
 
 	plan.Created = types.StringValue(result.Created)
 	plan.CreatedMillis = types.Int64Value(result.CreatedMillis)
+	plan.Status = types.StringValue(releaseBundleV2StatusPending)
 
-	// Save data into Terraform state
+	// Record the submitted promotion before polling so it is tracked in state
+	// even if it fails or times out.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
-}
-
-func (r *ReleaseBundleV2PromotionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
-
-	var state ReleaseBundleV2PromotionResourceModel
-
-	// Read Terraform state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	status, err := waitForReleaseBundleV2Status(ctx, timeout, func() (ReleaseBundleV2StatusAPIModel, error) {
+		promotion, _, err := r.getPromotion(plan)
+		return ReleaseBundleV2StatusAPIModel{
+			Status:   promotion.Status,
+			Messages: promotion.Messages,
+		}, err
+	})
+	plan.Status = types.StringValue(status.Status)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, fmt.Sprintf("promotion of Release Bundle %s version %s to %s: %s", plan.Name.ValueString(), plan.Version.ValueString(), plan.Environment.ValueString(), err.Error()))
+	}
+}
+
+func (r *ReleaseBundleV2PromotionResource) getPromotion(model ReleaseBundleV2PromotionResourceModel) (ReleaseBundleV2PromotionGetAPIModel, *resty.Response, error) {
 	var promotion ReleaseBundleV2PromotionGetAPIModel
 
 	request := r.ProviderData.Client.R()
 
-	if !state.ProjectKey.IsNull() {
-		request.SetQueryParam("project", state.ProjectKey.ValueString())
+	if !model.ProjectKey.IsNull() {
+		request.SetQueryParam("project", model.ProjectKey.ValueString())
 	}
 
 	response, err := request.
 		SetPathParams(map[string]string{
-			"name":    state.Name.ValueString(),
-			"version": state.Version.ValueString(),
-			"created": fmt.Sprintf("%d", state.CreatedMillis.ValueInt64()),
+			"name":    model.Name.ValueString(),
+			"version": model.Version.ValueString(),
+			"created": fmt.Sprintf("%d", model.CreatedMillis.ValueInt64()),
 		}).
 		SetResult(&promotion).
 		Get(ReleaseBundleV2PromotionDetailsEndpoint)
 	if err != nil {
-		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return promotion, response, err
+	}
+
+	if response.IsError() {
+		return promotion, response, fmt.Errorf("%s", response.String())
+	}
+
+	return promotion, response, nil
+}
+
+func (r *ReleaseBundleV2PromotionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
+
+	var state ReleaseBundleV2PromotionResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if response.StatusCode() == http.StatusNotFound {
+	promotion, response, err := r.getPromotion(state)
+	if response != nil && response.StatusCode() == http.StatusNotFound {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	if response.IsError() {
-		utilfw.UnableToRefreshResourceError(resp, response.String())
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
 		return
 	}
 
 	state.Environment = types.StringValue(promotion.Environment)
+	if promotion.Status != "" {
+		state.Status = types.StringValue(promotion.Status)
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ReleaseBundleV2PromotionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ReleaseBundleV2PromotionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// timeouts only affect how the provider waits, so they can be changed in place.
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
 	resp.Diagnostics.AddWarning(
 		"Update not supported",
 		"Release Bundle V2 promotion cannnot be updated.",
@@ -292,7 +356,7 @@ func (r *ReleaseBundleV2PromotionResource) Update(ctx context.Context, req resou
 }
 
 func (r *ReleaseBundleV2PromotionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ReleaseBundleV2PromotionResourceModel
 
@@ -319,6 +383,11 @@ func (r *ReleaseBundleV2PromotionResource) Delete(ctx context.Context, req resou
 		return
 	}
 
+	// A failed creation may already have been cleaned up by the server.
+	if response.StatusCode() == http.StatusNotFound {
+		return
+	}
+
 	if response.IsError() {
 		utilfw.UnableToDeleteResourceError(resp, response.String())
 		return
@@ -17,6 +17,7 @@ package lifecycle_test
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
@@ -388,6 +389,213 @@ EOF
 	})
 }
 
+func TestAccReleaseBundleV2_full_build_selectors(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("test-release-bundle-v2", "artifactory_release_bundle_v2")
+
+	repoName := fmt.Sprintf("test-repo-%d", testutil.RandomInt())
+	acctest.CreateRepo(t, repoName, "local", "maven", true, true)
+
+	_, _, err := uploadTestFile(t, repoName)
+	if err != nil {
+		t.Fatalf("failed to upload file: %s", err)
+	}
+
+	keyPairName := fmt.Sprintf("test-keypair-%d", testutil.RandomInt())
+	buildName := fmt.Sprintf("test-build-%d", testutil.RandomInt())
+
+	const template = `
+	resource "artifactory_keypair" "{{ .keypair_name }}" {
+		pair_name = "{{ .keypair_name }}"
+		pair_type = "RSA"
+		alias = "test-alias-{{ .keypair_name }}"
+		private_key = <<EOF
+{{ .private_key }}
+EOF
+		public_key = <<EOF
+{{ .public_key }}
+EOF
+}
+
+	resource "artifactory_release_bundle_v2" "{{ .name }}" {
+		name = "{{ .name }}"
+		version = "1.0.0"
+		keypair_name = artifactory_keypair.{{ .keypair_name }}.pair_name
+		skip_docker_manifest_resolution = true
+		source_type = "build_selectors"
+
+		source = {
+			build_selectors = [{
+				name = "{{ .build_name }}*"
+			}]
+		}
+	}`
+
+	testData := map[string]string{
+		"name":         resourceName,
+		"keypair_name": keyPairName,
+		"build_name":   buildName,
+		"private_key":  os.Getenv("JFROG_TEST_RSA_PRIVATE_KEY"),
+		"public_key":   os.Getenv("JFROG_TEST_RSA_PUBLIC_KEY"),
+	}
+
+	config := util.ExecuteTemplate("TestAccReleaseBundleV2_full_build_selectors", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			for _, number := range []string{"1", "2"} {
+				if err := uploadBuild(t, buildName, number, ""); err != nil {
+					t.Fatalf("failed to upload build: %s", err)
+				}
+			}
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			acctest.DeleteRepo(t, repoName)
+
+			if err := deleteBuild(t, buildName, ""); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "source_type", "build_selectors"),
+					resource.TestCheckResourceAttr(fqrn, "source.build_selectors.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "resolved_builds.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "resolved_builds.0.name", testData["build_name"]),
+					resource.TestCheckResourceAttr(fqrn, "resolved_builds.0.number", "2"),
+					resource.TestCheckResourceAttrSet(fqrn, "resolved_artifacts.#"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccReleaseBundleV2_source_type_without_source(t *testing.T) {
+	_, _, resourceName := testutil.MkNames("test-release-bundle-v2", "artifactory_release_bundle_v2")
+
+	config := util.ExecuteTemplate("TestAccReleaseBundleV2_source_type_without_source", `
+	resource "artifactory_release_bundle_v2" "{{ .name }}" {
+		name = "{{ .name }}"
+		version = "1.0.0"
+		keypair_name = "my-keypair"
+		source_type = "build_selectors"
+
+		source = {
+			packages = [{
+				name = "my-package"
+				version = "1.0.0"
+				type = "maven"
+				repository_key = "my-maven-local"
+			}]
+		}
+	}`, map[string]string{
+		"name": resourceName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Expected source.build_selectors to be configured`),
+			},
+		},
+	})
+}
+
+func TestAccReleaseBundleV2_full_packages(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("test-release-bundle-v2", "artifactory_release_bundle_v2")
+
+	repoName := fmt.Sprintf("test-repo-%d", testutil.RandomInt())
+	acctest.CreateRepo(t, repoName, "local", "maven", true, true)
+
+	_, _, err := uploadTestFile(t, repoName)
+	if err != nil {
+		t.Fatalf("failed to upload file: %s", err)
+	}
+
+	keyPairName := fmt.Sprintf("test-keypair-%d", testutil.RandomInt())
+
+	const template = `
+	resource "artifactory_keypair" "{{ .keypair_name }}" {
+		pair_name = "{{ .keypair_name }}"
+		pair_type = "RSA"
+		alias = "test-alias-{{ .keypair_name }}"
+		private_key = <<EOF
+{{ .private_key }}
+EOF
+		public_key = <<EOF
+{{ .public_key }}
+EOF
+}
+
+	resource "artifactory_release_bundle_v2" "{{ .name }}" {
+		name = "{{ .name }}"
+		version = "1.0.0"
+		keypair_name = artifactory_keypair.{{ .keypair_name }}.pair_name
+		skip_docker_manifest_resolution = true
+		source_type = "packages"
+
+		source = {
+			packages = [{
+				name = "org.jfrog.test:multi1"
+				version = "3.7-SNAPSHOT"
+				type = "maven"
+				repository_key = "{{ .repo_name }}"
+			}]
+		}
+	}`
+
+	testData := map[string]string{
+		"name":         resourceName,
+		"keypair_name": keyPairName,
+		"repo_name":    repoName,
+		"private_key":  os.Getenv("JFROG_TEST_RSA_PRIVATE_KEY"),
+		"public_key":   os.Getenv("JFROG_TEST_RSA_PUBLIC_KEY"),
+	}
+
+	config := util.ExecuteTemplate("TestAccReleaseBundleV2_full_packages", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			acctest.DeleteRepo(t, repoName)
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "source_type", "packages"),
+					resource.TestCheckResourceAttr(fqrn, "source.packages.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "source.packages.0.name", "org.jfrog.test:multi1"),
+					resource.TestCheckNoResourceAttr(fqrn, "resolved_builds"),
+					resource.TestCheckResourceAttr(fqrn, "resolved_artifacts.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "resolved_artifacts.0.path", fmt.Sprintf("%s/org/jfrog/test/multi1/3.7-SNAPSHOT/multi1-3.7-SNAPSHOT.jar", repoName)),
+					resource.TestCheckResourceAttrSet(fqrn, "resolved_artifacts.0.sha256"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccReleaseBundleV2_full_release_bundles(t *testing.T) {
 	_, _, resourceName1 := testutil.MkNames("test-release-bundle-v2", "artifactory_release_bundle_v2")
 	_, fqrn, resourceName2 := testutil.MkNames("test-release-bundle-v2", "artifactory_release_bundle_v2")
@@ -34,6 +34,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
@@ -384,11 +386,11 @@ func (r *LocalRepositoryMultiReplicationResource) Configure(ctx context.Context,
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), r.TypeName)
 }
 
 func (r *LocalRepositoryMultiReplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan LocalRepositoryMultiReplicationResourceModel
 
@@ -452,7 +454,7 @@ func (r *LocalRepositoryMultiReplicationResource) Create(ctx context.Context, re
 }
 
 func (r *LocalRepositoryMultiReplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state LocalRepositoryMultiReplicationResourceModel
 
@@ -494,7 +496,7 @@ func (r *LocalRepositoryMultiReplicationResource) Read(ctx context.Context, req
 }
 
 func (r *LocalRepositoryMultiReplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan LocalRepositoryMultiReplicationResourceModel
 
@@ -565,7 +567,7 @@ func (r *LocalRepositoryMultiReplicationResource) Update(ctx context.Context, re
 }
 
 func (r *LocalRepositoryMultiReplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state LocalRepositoryMultiReplicationResourceModel
 
@@ -600,3 +602,111 @@ func (r *LocalRepositoryMultiReplicationResource) Delete(ctx context.Context, re
 func (r *LocalRepositoryMultiReplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("repo_key"), req, resp)
 }
+
+type pushReplicationSourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	RepoKey                types.String `tfsdk:"repo_key"`
+	EnableEventReplication types.Bool   `tfsdk:"enable_event_replication"`
+	CronExp                types.String `tfsdk:"cron_exp"`
+	Replications           []struct {
+		URL                             types.String `tfsdk:"url"`
+		SocketTimeoutMillis             types.Int64  `tfsdk:"socket_timeout_millis"`
+		Username                        types.String `tfsdk:"username"`
+		Password                        types.String `tfsdk:"password"`
+		Enabled                         types.Bool   `tfsdk:"enabled"`
+		SyncDeletes                     types.Bool   `tfsdk:"sync_deletes"`
+		SyncProperties                  types.Bool   `tfsdk:"sync_properties"`
+		SyncStatistics                  types.Bool   `tfsdk:"sync_statistics"`
+		PathPrefix                      types.String `tfsdk:"path_prefix"`
+		Proxy                           types.String `tfsdk:"proxy"`
+		CheckBinaryExistenceInFilestore types.Bool   `tfsdk:"check_binary_existence_in_filestore"`
+	} `tfsdk:"replications"`
+}
+
+// MoveState supports `moved` blocks from the deprecated `artifactory_push_replication` resource.
+// Its `replications` blocks become `replication` blocks and `path_prefix` becomes
+// `include_path_prefix_pattern`; the remaining attributes are refreshed from Artifactory.
+func (r *LocalRepositoryMultiReplicationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                       schema.StringAttribute{Optional: true},
+					"repo_key":                 schema.StringAttribute{Optional: true},
+					"cron_exp":                 schema.StringAttribute{Optional: true},
+					"enable_event_replication": schema.BoolAttribute{Optional: true},
+					"replications": schema.ListNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"url":                                 schema.StringAttribute{Optional: true},
+								"socket_timeout_millis":               schema.Int64Attribute{Optional: true},
+								"username":                            schema.StringAttribute{Optional: true},
+								"password":                            schema.StringAttribute{Optional: true, Sensitive: true},
+								"enabled":                             schema.BoolAttribute{Optional: true},
+								"sync_deletes":                        schema.BoolAttribute{Optional: true},
+								"sync_properties":                     schema.BoolAttribute{Optional: true},
+								"sync_statistics":                     schema.BoolAttribute{Optional: true},
+								"path_prefix":                         schema.StringAttribute{Optional: true},
+								"proxy":                               schema.StringAttribute{Optional: true},
+								"check_binary_existence_in_filestore": schema.BoolAttribute{Optional: true},
+							},
+						},
+					},
+				},
+			},
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "artifactory_push_replication" || req.SourceState == nil {
+					return
+				}
+
+				var source pushReplicationSourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				replications := make([]attr.Value, 0, len(source.Replications))
+				for _, replication := range source.Replications {
+					r, ds := types.ObjectValue(
+						replicationResourceModelAttributeTypes,
+						map[string]attr.Value{
+							"url":                                 types.StringValue(replication.URL.ValueString()),
+							"socket_timeout_millis":               types.Int64Value(replication.SocketTimeoutMillis.ValueInt64()),
+							"username":                            types.StringValue(replication.Username.ValueString()),
+							"password":                            replication.Password,
+							"enabled":                             types.BoolValue(replication.Enabled.ValueBool()),
+							"sync_deletes":                        types.BoolValue(replication.SyncDeletes.ValueBool()),
+							"sync_properties":                     types.BoolValue(replication.SyncProperties.ValueBool()),
+							"sync_statistics":                     types.BoolValue(replication.SyncStatistics.ValueBool()),
+							"include_path_prefix_pattern":         types.StringValue(replication.PathPrefix.ValueString()),
+							"exclude_path_prefix_pattern":         types.StringValue(""),
+							"check_binary_existence_in_filestore": types.BoolValue(replication.CheckBinaryExistenceInFilestore.ValueBool()),
+							"proxy":                               types.StringValue(replication.Proxy.ValueString()),
+							"disable_proxy":                       types.BoolValue(false),
+							"replication_key":                     types.StringValue(""),
+						},
+					)
+					resp.Diagnostics.Append(ds...)
+					replications = append(replications, r)
+				}
+
+				replicationList, ds := types.ListValue(replicationListResourceModelAttributeTypes, replications)
+				resp.Diagnostics.Append(ds...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				state := LocalRepositoryMultiReplicationResourceModel{
+					ID:                     source.RepoKey,
+					RepoKey:                source.RepoKey,
+					CronExp:                source.CronExp,
+					EnableEventReplication: types.BoolValue(source.EnableEventReplication.ValueBool()),
+					Replication:            replicationList,
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &state)...)
+			},
+		},
+	}
+}
@@ -28,6 +28,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 
@@ -201,11 +203,11 @@ func (r *RemoteRepositoryReplicationResource) Configure(ctx context.Context, req
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), r.TypeName)
 }
 
 func (r *RemoteRepositoryReplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan RemoteRepositoryReplicationResourceModel
 
@@ -249,7 +251,7 @@ func (r *RemoteRepositoryReplicationResource) Create(ctx context.Context, req re
 }
 
 func (r *RemoteRepositoryReplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state RemoteRepositoryReplicationResourceModel
 
@@ -290,7 +292,7 @@ func (r *RemoteRepositoryReplicationResource) Read(ctx context.Context, req reso
 }
 
 func (r *RemoteRepositoryReplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan RemoteRepositoryReplicationResourceModel
 
@@ -341,7 +343,7 @@ func (r *RemoteRepositoryReplicationResource) Update(ctx context.Context, req re
 }
 
 func (r *RemoteRepositoryReplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state RemoteRepositoryReplicationResourceModel
 
@@ -376,3 +378,63 @@ func (r *RemoteRepositoryReplicationResource) Delete(ctx context.Context, req re
 func (r *RemoteRepositoryReplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("repo_key"), req, resp)
 }
+
+type pullReplicationSourceModel struct {
+	RepoKey                         types.String `tfsdk:"repo_key"`
+	CronExp                         types.String `tfsdk:"cron_exp"`
+	Enabled                         types.Bool   `tfsdk:"enabled"`
+	SyncDeletes                     types.Bool   `tfsdk:"sync_deletes"`
+	SyncProperties                  types.Bool   `tfsdk:"sync_properties"`
+	PathPrefix                      types.String `tfsdk:"path_prefix"`
+	EnableEventReplication          types.Bool   `tfsdk:"enable_event_replication"`
+	CheckBinaryExistenceInFilestore types.Bool   `tfsdk:"check_binary_existence_in_filestore"`
+}
+
+// MoveState supports `moved` blocks from the deprecated `artifactory_pull_replication` resource.
+// `path_prefix` becomes `include_path_prefix_pattern`. The connection attributes (`url`, `username`,
+// `password`, `proxy`, ...) have no counterpart as they are taken from the remote repository.
+func (r *RemoteRepositoryReplicationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"repo_key":                            schema.StringAttribute{Optional: true},
+					"cron_exp":                            schema.StringAttribute{Optional: true},
+					"enabled":                             schema.BoolAttribute{Optional: true},
+					"sync_deletes":                        schema.BoolAttribute{Optional: true},
+					"sync_properties":                     schema.BoolAttribute{Optional: true},
+					"path_prefix":                         schema.StringAttribute{Optional: true},
+					"enable_event_replication":            schema.BoolAttribute{Optional: true},
+					"check_binary_existence_in_filestore": schema.BoolAttribute{Optional: true},
+				},
+			},
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "artifactory_pull_replication" || req.SourceState == nil {
+					return
+				}
+
+				var source pullReplicationSourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				state := RemoteRepositoryReplicationResourceModel{
+					ID:                              source.RepoKey,
+					RepoKey:                         source.RepoKey,
+					CronExp:                         source.CronExp,
+					Enabled:                         types.BoolValue(source.Enabled.ValueBool()),
+					SyncDeletes:                     types.BoolValue(source.SyncDeletes.ValueBool()),
+					SyncProperties:                  types.BoolValue(source.SyncProperties.ValueBool()),
+					EnableEventReplication:          types.BoolValue(source.EnableEventReplication.ValueBool()),
+					CheckBinaryExistenceInFilestore: types.BoolValue(source.CheckBinaryExistenceInFilestore.ValueBool()),
+					IncludePathPrefixPattern:        types.StringValue(source.PathPrefix.ValueString()),
+					ExcludePathPrefixPattern:        types.StringValue(""),
+					ReplicationKey:                  types.StringValue(""),
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &state)...)
+			},
+		},
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+// RepositoryDefaultsRclasses are the repository classes supported by the provider `repository_defaults` block.
+var RepositoryDefaultsRclasses = []string{"local", "remote", "virtual"}
+
+// RepositoryDefaultsRemoteOnlyAttributes can only be set in a `repository_defaults` block for remote repositories.
+var RepositoryDefaultsRemoteOnlyAttributes = []string{
+	"socket_timeout_millis",
+	"retrieval_cache_period_seconds",
+	"proxy",
+}
+
+// RepositoryDefaultsModel is the Terraform data model of one provider `repository_defaults` block.
+type RepositoryDefaultsModel struct {
+	Rclass                      types.String `tfsdk:"rclass"`
+	PackageTypes                types.Set    `tfsdk:"package_types"`
+	XrayIndex                   types.Bool   `tfsdk:"xray_index"`
+	PropertySets                types.Set    `tfsdk:"property_sets"`
+	IncludesPattern             types.String `tfsdk:"includes_pattern"`
+	Notes                       types.String `tfsdk:"notes"`
+	ProjectEnvironments         types.Set    `tfsdk:"project_environments"`
+	SocketTimeoutMillis         types.Int64  `tfsdk:"socket_timeout_millis"`
+	RetrievalCachePeriodSeconds types.Int64  `tfsdk:"retrieval_cache_period_seconds"`
+	Proxy                       types.String `tfsdk:"proxy"`
+}
+
+// RepositoryDefaults are the default attribute values for repositories of one rclass and,
+// optionally, a set of package types.
+type RepositoryDefaults struct {
+	Rclass       string
+	PackageTypes []string
+	// Values holds the configured default values keyed by resource attribute name
+	Values map[string]attr.Value
+}
+
+func (d RepositoryDefaults) appliesTo(rclass, packageType string) bool {
+	return d.Rclass == rclass && (len(d.PackageTypes) == 0 || slices.Contains(d.PackageTypes, packageType))
+}
+
+// ToRepositoryDefaults converts the block data model and validates it. Unset (null) values are omitted.
+func (m RepositoryDefaultsModel) ToRepositoryDefaults(ctx context.Context) (RepositoryDefaults, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	defaults := RepositoryDefaults{
+		Rclass: m.Rclass.ValueString(),
+		Values: map[string]attr.Value{},
+	}
+
+	if !m.PackageTypes.IsNull() {
+		diags.Append(m.PackageTypes.ElementsAs(ctx, &defaults.PackageTypes, false)...)
+	}
+
+	values := map[string]attr.Value{
+		"xray_index":                     m.XrayIndex,
+		"property_sets":                  m.PropertySets,
+		"includes_pattern":               m.IncludesPattern,
+		"notes":                          m.Notes,
+		"project_environments":           m.ProjectEnvironments,
+		"socket_timeout_millis":          m.SocketTimeoutMillis,
+		"retrieval_cache_period_seconds": m.RetrievalCachePeriodSeconds,
+		"proxy":                          m.Proxy,
+	}
+
+	for name, value := range values {
+		if value.IsNull() {
+			continue
+		}
+
+		if value.IsUnknown() {
+			diags.AddAttributeError(
+				path.Root("repository_defaults"),
+				"Unknown repository default",
+				fmt.Sprintf("%s must be known when the provider is configured", name),
+			)
+			continue
+		}
+
+		if defaults.Rclass != "remote" && slices.Contains(RepositoryDefaultsRemoteOnlyAttributes, name) {
+			diags.AddAttributeError(
+				path.Root("repository_defaults"),
+				"Invalid repository default",
+				fmt.Sprintf("%s can only be set for rclass 'remote', got '%s'", name, defaults.Rclass),
+			)
+			continue
+		}
+
+		defaults.Values[name] = value
+	}
+
+	return defaults, diags
+}
+
+// ResourceWithRepositoryDefaults is implemented by the repository resources which apply the provider
+// `repository_defaults`. util.ProviderMetadata is shared across JFrog providers and can't carry them, so
+// the provider hands them to each resource it constructs.
+type ResourceWithRepositoryDefaults interface {
+	SetRepositoryDefaults(defaults []RepositoryDefaults)
+}
+
+// SetRepositoryDefaults sets the repository defaults of the provider which constructed the resource.
+func (r *BaseResource) SetRepositoryDefaults(defaults []RepositoryDefaults) {
+	r.RepositoryDefaults = defaults
+}
+
+// MergeRepositoryDefaults returns the merged default values for a repository. Blocks without
+// `package_types` are applied first, then blocks for the specific package type override them.
+// Within each group, later blocks override earlier ones.
+func MergeRepositoryDefaults(defaults []RepositoryDefaults, rclass, packageType string) map[string]attr.Value {
+	applicable := lo.Filter(defaults, func(d RepositoryDefaults, _ int) bool {
+		return d.appliesTo(rclass, packageType)
+	})
+
+	specific, generic := lo.FilterReject(applicable, func(d RepositoryDefaults, _ int) bool {
+		return len(d.PackageTypes) > 0
+	})
+
+	values := map[string]attr.Value{}
+	for _, d := range append(generic, specific...) {
+		values = lo.Assign(values, d.Values)
+	}
+
+	return values
+}
+
+// computedWithoutDefault are optional attributes that are only computed so the provider defaults can be
+// planned. They must be planned as null when neither the configuration nor the provider defaults set them.
+var computedWithoutDefault = map[string]attr.Value{
+	"property_sets": types.SetNull(types.StringType),
+}
+
+// ModifyPlan applies the provider `repository_defaults` to attributes that are not set in the configuration.
+func (r *BaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	defaults := MergeRepositoryDefaults(r.RepositoryDefaults, r.Rclass, r.PackageType)
+
+	schemaAttributes := req.Plan.Schema.GetAttributes()
+
+	for name, value := range lo.Assign(computedWithoutDefault, defaults) {
+		if _, ok := schemaAttributes[name]; !ok {
+			continue
+		}
+
+		var configValue attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &configValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !configValue.IsNull() {
+			continue
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
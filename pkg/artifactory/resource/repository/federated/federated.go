@@ -18,19 +18,32 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"reflect"
 	"strings"
-
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-shared/client"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	"github.com/jfrog/terraform-provider-shared/unpacker"
-	"github.com/jfrog/terraform-provider-shared/util"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
 	"github.com/samber/lo"
 )
 
@@ -69,34 +82,560 @@ type Member struct {
 	Enabled bool   `json:"enabled"`
 }
 
-var SchemaGeneratorV3 = func(isRequired bool) map[string]*schema.Schema {
+const CurrentSchemaVersion = 5
+
+const memberDescription = "The list of Federated members. If a Federated member receives a request that does not include the repository URL, it will " +
+	"automatically be added with the combination of the configured base URL and `key` field value. " +
+	"Note that each of the federated members will need to have a base URL set. Please follow the [instruction](https://www.jfrog.com/confluence/display/JFROG/Working+with+Federated+Repositories#WorkingwithFederatedRepositories-SettingUpaFederatedRepository)" +
+	" to set up Federated repositories correctly."
+
+// Framework Support
+
+func NewFederatedRepositoryResource(packageType, packageName string, resourceModelType, apiModelType reflect.Type, localResource func() resource.Resource) federatedResource {
+	return federatedResource{
+		BaseResource:  repository.NewRepositoryResource(packageType, packageName, Rclass, resourceModelType, apiModelType),
+		localResource: localResource,
+	}
+}
+
+// federatedResource is a local repository resource with federation members, the attributes of the local
+// repository of the same package type are reused.
+type federatedResource struct {
+	repository.BaseResource
+	localResource func() resource.Resource
+}
+
+func (r *federatedResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	localResp := resource.SchemaResponse{}
+	r.localResource().Schema(ctx, req, &localResp)
+	resp.Diagnostics.Append(localResp.Diagnostics...)
+
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			localResp.Schema.Attributes,
+			repository.RepoLayoutRefAttribute(r.Rclass, r.PackageType),
+			FederatedAttributes,
+		),
+		Blocks: lo.Assign(
+			localResp.Schema.Blocks,
+			FederatedBlocks,
+		),
+		Description: r.Description,
+	}
+}
+
+func (r federatedResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	r.BaseResource.ValidateConfig(ctx, req, resp)
+
+	var key, proxy types.String
+	var allowRename, disableProxy, waitForHealthyMembers types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key"), &key)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("allow_rename"), &allowRename)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("proxy"), &proxy)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("disable_proxy"), &disableProxy)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("wait_for_healthy_members"), &waitForHealthyMembers)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if allowRename.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allow_rename"),
+			"Invalid Attribute Configuration",
+			"federated repositories can't be renamed.",
+		)
+	}
+
+	if disableProxy.ValueBool() && len(proxy.ValueString()) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proxy"),
+			"Invalid Attribute Configuration",
+			"if `disable_proxy` is set to `true`, `proxy` can't be set.",
+		)
+	}
+
+	if r.PackageType == repository.ReleasebundlesPackageType && !key.IsNull() && !key.IsUnknown() {
+		requiredSuffix := "release-bundles-v2"
+		if !strings.HasSuffix(key.ValueString(), requiredSuffix) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("key"),
+				"Invalid Attribute Configuration",
+				fmt.Sprintf("the `key` of a %s repository must end with `%s`, got: `%s`.", repository.ReleasebundlesPackageType, requiredSuffix, key.ValueString()),
+			)
+		}
+	}
+
+	if waitForHealthyMembers.ValueBool() {
+		// the configuration of releasebundles repositories is not synchronized on create, see Create
+		if r.PackageType == repository.ReleasebundlesPackageType {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("wait_for_healthy_members"),
+				"Invalid Attribute Configuration",
+				fmt.Sprintf("`wait_for_healthy_members` is not supported for %s repositories.", repository.ReleasebundlesPackageType),
+			)
+		} else if r.ProviderData != nil {
+			capability.FederationStatus.Validate(*r.ProviderData, path.Root("wait_for_healthy_members"), &resp.Diagnostics)
+		}
+	}
+}
+
+func (r *federatedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.BaseResource.Create(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var key types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("key"), &key)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip synchronization for `releasebundles` package type to prevent creation of the member repo on target.
+	// There is a bug on 7.126, which prevents correct sync for environments, and only DEV will be added, if the
+	// repo on the target instance will be created by federation.
+	if r.PackageType != repository.ReleasebundlesPackageType {
+		resp.Diagnostics.Append(r.configSync(ctx, key.ValueString())...)
+	}
+
+	resp.Diagnostics.Append(r.setMemberStatus(ctx, key.ValueString(), &resp.State, memberStatusAfterCreate)...)
+}
+
+func (r *federatedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.BaseResource.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() || resp.State.Raw.IsNull() {
+		return
+	}
+
+	var key types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("key"), &key)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setMemberStatus(ctx, key.ValueString(), &resp.State, memberStatusOnRead)...)
+}
+
+func (r *federatedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseResource.Update(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var key types.String
+	var fullSyncNewMembers types.Bool
+	var members, priorMembers []FederatedMemberResourceModel
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("key"), &key)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("full_sync_new_members"), &fullSyncNewMembers)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("member"), &members)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("member"), &priorMembers)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.configSync(ctx, key.ValueString())...)
+
+	if fullSyncNewMembers.ValueBool() {
+		for _, member := range newMembers(members, priorMembers) {
+			if isLocalMember(key.ValueString(), r.ProviderData.Client.BaseURL, member.URL.ValueString()) {
+				continue
+			}
+			resp.Diagnostics.Append(r.fullSync(ctx, key.ValueString(), member.URL.ValueString())...)
+		}
+	}
+
+	resp.Diagnostics.Append(r.setMemberStatus(ctx, key.ValueString(), &resp.State, memberStatusAfterUpdate)...)
+}
+
+// newMembers returns the members which are not in the prior members.
+func newMembers(members, priorMembers []FederatedMemberResourceModel) []FederatedMemberResourceModel {
+	return lo.Filter(members, func(m FederatedMemberResourceModel, _ int) bool {
+		return !lo.ContainsBy(priorMembers, func(p FederatedMemberResourceModel) bool {
+			return p.URL.ValueString() == m.URL.ValueString()
+		})
+	})
+}
+
+// setMemberStatus refreshes `member_status` in the state. After an apply, it waits for the members to be
+// healthy if `wait_for_healthy_members` is set. `member_status` is null if the server doesn't support the
+// federation status API.
+func (r *federatedResource) setMemberStatus(ctx context.Context, key string, state *tfsdk.State, refresh memberStatusRefresh) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if !capability.FederationStatus.Supported(*r.ProviderData) {
+		diags.Append(state.SetAttribute(ctx, path.Root("member_status"), types.ListNull(memberStatusListElementType))...)
+		return diags
+	}
+
+	var waitForHealthyMembers types.Bool
+	var timeoutSeconds types.Int64
+	var members []FederatedMemberResourceModel
+	diags.Append(state.GetAttribute(ctx, path.Root("wait_for_healthy_members"), &waitForHealthyMembers)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("healthy_members_timeout_seconds"), &timeoutSeconds)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("member"), &members)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	wait := refresh != memberStatusOnRead && waitForHealthyMembers.ValueBool()
+	timeout := time.Duration(timeoutSeconds.ValueInt64()) * time.Second
+
+	memberStatus, d := r.refreshMemberStatus(ctx, key, members, refresh, wait, timeout)
+	diags.Append(d...)
+	diags.Append(state.SetAttribute(ctx, path.Root("member_status"), memberStatus)...)
+
+	return diags
+}
+
+// configSync triggers the synchronization of the federated member configuration. Failures are only reported as
+// warnings as the repository itself has been saved.
+func (r *federatedResource) configSync(ctx context.Context, key string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	tflog.Info(ctx,
+		"triggering synchronization of the federated member configuration",
+		map[string]interface{}{
+			"repoKey": key,
+		},
+	)
+
+	response, err := r.ProviderData.Client.R().
+		SetPathParam("repositoryKey", key).
+		Post("artifactory/api/federation/configSync/{repositoryKey}")
+	if err != nil {
+		diags.AddWarning(
+			"failed to trigger synchronization of the federated member configuration",
+			err.Error(),
+		)
+		return diags
+	}
+
+	if response.IsError() {
+		diags.AddWarning(
+			"failed to trigger synchronization of the federated member configuration",
+			response.String(),
+		)
+	}
+
+	return diags
+}
+
+// Delete deletes the repository, and all the federated members (except the initial repo member) if
+// `cleanup_on_delete` is set to `true`.
+func (r *federatedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var key types.String
+	var cleanupOnDelete types.Bool
+	var members []FederatedMemberResourceModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("key"), &key)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("cleanup_on_delete"), &cleanupOnDelete)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("member"), &members)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if cleanupOnDelete.ValueBool() {
+		for _, member := range members {
+			resp.Diagnostics.Append(r.deleteMember(ctx, key.ValueString(), member)...)
+		}
+	}
+
+	r.BaseResource.Delete(ctx, req, resp)
+}
+
+func (r *federatedResource) deleteMember(ctx context.Context, key string, member FederatedMemberResourceModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	memberUrl := member.URL.ValueString() // example "https://artifactory-instance.com/artifactory/federated-generic-repository-example"
+	parsedMemberUrl, err := url.Parse(memberUrl)
+	if err != nil {
+		diags.AddWarning(
+			"Failed to delete federated repository member",
+			fmt.Sprintf("Invalid member URL %s: %s", memberUrl, err.Error()),
+		)
+		return diags
+	}
+
+	memberHost := memberUrl[:strings.Index(memberUrl, parsedMemberUrl.Path)]
+	memberRepoName := memberUrl[strings.LastIndex(memberUrl, "/")+1:]
+
+	restyClient := r.ProviderData.Client
+	if isLocalMember(key, restyClient.BaseURL, memberUrl) {
+		return diags
+	}
+
+	tflog.Info(ctx, "deleting federated repository member", map[string]interface{}{
+		"memberUrl": memberUrl,
+	})
+
+	request := restyClient.R().
+		AddRetryCondition(client.RetryOnMergeError).
+		SetPathParam("key", memberRepoName)
+
+	if accessToken := member.AccessToken.ValueString(); accessToken != "" {
+		request.SetAuthToken(accessToken)
+	}
+
+	response, err := request.Delete(fmt.Sprintf("%s/%s", memberHost, RepositoriesEndpoint))
+	if err != nil {
+		diags.AddWarning(
+			"Failed to delete federated repository member",
+			fmt.Sprintf("Error deleting member repository %s: %s", memberRepoName, err.Error()),
+		)
+		return diags
+	}
+
+	if response.IsError() {
+		diags.AddWarning(
+			"Failed to delete federated repository member",
+			fmt.Sprintf("Error deleting member repository %s: %s", memberRepoName, response.String()),
+		)
+	}
+
+	return diags
+}
+
+func (r *federatedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// the schema hasn't changed, except the removal of default value from `project_key` attribute
+		2: repository.UpgradeStateSDKv2(repository.ResourceUpgradeProjectKey, upgradeMemberAccessToken, repository.ResourceUpgradeAllowRename),
+		3: repository.UpgradeStateSDKv2(upgradeMemberAccessToken, repository.ResourceUpgradeAllowRename),
+		// the SDKv2 resources didn't have `allow_rename`
+		4: repository.UpgradeStateSDKv2(repository.ResourceUpgradeAllowRename),
+	}
+}
+
+type FederatedResourceModel struct {
+	Member                       types.Set    `tfsdk:"member"`
+	MemberStatus                 types.List   `tfsdk:"member_status"`
+	CleanupOnDelete              types.Bool   `tfsdk:"cleanup_on_delete"`
+	WaitForHealthyMembers        types.Bool   `tfsdk:"wait_for_healthy_members"`
+	HealthyMembersTimeoutSeconds types.Int64  `tfsdk:"healthy_members_timeout_seconds"`
+	FullSyncNewMembers           types.Bool   `tfsdk:"full_sync_new_members"`
+	Proxy                        types.String `tfsdk:"proxy"`
+	DisableProxy                 types.Bool   `tfsdk:"disable_proxy"`
+}
+
+type FederatedMemberResourceModel struct {
+	URL         types.String `tfsdk:"url"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	AccessToken types.String `tfsdk:"access_token"`
+}
+
+var memberResourceModelAttributeTypes = map[string]attr.Type{
+	"url":          types.StringType,
+	"enabled":      types.BoolType,
+	"access_token": types.StringType,
+}
+
+var memberSetElementType = types.ObjectType{
+	AttrTypes: memberResourceModelAttributeTypes,
+}
+
+func (r FederatedResourceModel) ToAPIModel(ctx context.Context) (FederatedAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	var members []FederatedMemberResourceModel
+	diags.Append(r.Member.ElementsAs(ctx, &members, false)...)
+
+	return FederatedAPIModel{
+		Members: lo.Map(members, func(m FederatedMemberResourceModel, _ int) Member {
+			return Member{
+				Url:     m.URL.ValueString(),
+				Enabled: m.Enabled.ValueBool(),
+			}
+		}),
+		RepoParams: RepoParams{
+			Proxy:        r.Proxy.ValueString(),
+			DisableProxy: r.DisableProxy.ValueBool(),
+		},
+	}, diags
+}
+
+func (r *FederatedResourceModel) FromAPIModel(ctx context.Context, model FederatedAPIModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	// the access tokens are not returned by the API, keep the ones of the matching members in the state
+	var priorMembers []FederatedMemberResourceModel
+	if !r.Member.IsNull() && !r.Member.IsUnknown() {
+		diags.Append(r.Member.ElementsAs(ctx, &priorMembers, false)...)
+	}
+
+	members := lo.Map(model.Members, func(m Member, _ int) FederatedMemberResourceModel {
+		accessToken := types.StringNull()
+		if prior, found := lo.Find(priorMembers, func(p FederatedMemberResourceModel) bool {
+			return p.URL.ValueString() == m.Url
+		}); found {
+			accessToken = prior.AccessToken
+		}
+
+		return FederatedMemberResourceModel{
+			URL:         types.StringValue(m.Url),
+			Enabled:     types.BoolValue(m.Enabled),
+			AccessToken: accessToken,
+		}
+	})
+
+	memberSet, d := types.SetValueFrom(ctx, memberSetElementType, members)
+	diags.Append(d...)
+	r.Member = memberSet
+
+	r.Proxy = types.StringValue(model.Proxy)
+	r.DisableProxy = types.BoolValue(model.DisableProxy)
+
+	// not part of the repository configuration, e.g. during import
+	if r.CleanupOnDelete.IsNull() {
+		r.CleanupOnDelete = types.BoolValue(false)
+	}
+	if r.WaitForHealthyMembers.IsNull() {
+		r.WaitForHealthyMembers = types.BoolValue(false)
+	}
+	if r.HealthyMembersTimeoutSeconds.IsNull() {
+		r.HealthyMembersTimeoutSeconds = types.Int64Value(defaultHealthyMembersTimeoutSeconds)
+	}
+	if r.FullSyncNewMembers.IsNull() {
+		r.FullSyncNewMembers = types.BoolValue(false)
+	}
+	// refreshed by the resource from the federation status
+	if r.MemberStatus.IsNull() || r.MemberStatus.IsUnknown() {
+		r.MemberStatus = types.ListNull(memberStatusListElementType)
+	}
+
+	return diags
+}
+
+type FederatedAPIModel struct {
+	Members []Member `json:"members"`
+	RepoParams
+}
+
+var FederatedAttributes = map[string]schema.Attribute{
+	"cleanup_on_delete": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Delete all federated members on `terraform destroy` if set to `true`. Caution: it will delete all the repositories in the federation on other Artifactory instances. Set `access_token` attribute if Access Federation for access tokens is not enabled.",
+	},
+	"wait_for_healthy_members": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Wait after create and update until all the enabled members are reported healthy by the federation status, see `member_status`. The update fails if they are not healthy within `healthy_members_timeout_seconds`, while the create only reports a warning so the new repository is kept in state. Requires Artifactory 7.49.3 or later, not supported for `releasebundles` repositories. Default value is `false`.",
+	},
+	"healthy_members_timeout_seconds": schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		Default:  int64default.StaticInt64(defaultHealthyMembersTimeoutSeconds),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+		MarkdownDescription: fmt.Sprintf("How long to wait for the members to be healthy when `wait_for_healthy_members` is set, in seconds. Default value is `%d`.", defaultHealthyMembersTimeoutSeconds),
+	},
+	"full_sync_new_members": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Trigger a full synchronization of the repository content, and not only of its configuration, with the members added on update. Default value is `false`.",
+	},
+	"member_status": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"url": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Full URL of the member.",
+				},
+				"status": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "`HEALTHY` if the member is available, the status reported by Artifactory if it's unavailable, or `UNKNOWN` if Artifactory doesn't report the member.",
+				},
+				"lag_in_ms": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "Replication lag of the member in milliseconds. Only set for healthy members.",
+				},
+			},
+		},
+		Computed:            true,
+		MarkdownDescription: "Federation status of the members, except this repository itself. Refreshed on every read, null before Artifactory 7.49.3.",
+	},
+	"proxy": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(""),
+		MarkdownDescription: "Proxy key from Artifactory Proxies settings. Can't be set if `disable_proxy = true`.",
+	},
+	"disable_proxy": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "When set to `true`, the proxy is disabled, and not returned in the API response body. If there is a default proxy set for the Artifactory instance, it will be ignored, too. Introduced since Artifactory 7.41.7.",
+	},
+}
+
+var FederatedBlocks = map[string]schema.Block{
+	"member": schema.SetNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"url": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						validatorfw_string.IsURLHttpOrHttps(),
+					},
+					MarkdownDescription: "Full URL to ending with the repositoryName",
+				},
+				"enabled": schema.BoolAttribute{
+					Required: true,
+					MarkdownDescription: "Represents the active state of the federated member. It is supported to " +
+						"change the enabled status of my own member. The config will be updated on the other " +
+						"federated members automatically.",
+				},
+				"access_token": schema.StringAttribute{
+					Optional:  true,
+					Sensitive: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+					MarkdownDescription: "Admin access token for this member Artifactory instance. Used in conjunction with `cleanup_on_delete` attribute when Access Federation for access tokens is not enabled.",
+				},
+			},
+		},
+		Validators: []validator.Set{
+			setvalidator.IsRequired(),
+			setvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: memberDescription,
+	},
+}
+
+// SDKv2
+
+var SchemaGeneratorV3 = func(isRequired bool) map[string]*sdkv2_schema.Schema {
 	return lo.Assign(
 		repository.ProxySchemaSDKv2,
-		map[string]*schema.Schema{
+		map[string]*sdkv2_schema.Schema{
 			"cleanup_on_delete": {
-				Type:        schema.TypeBool,
+				Type:        sdkv2_schema.TypeBool,
 				Optional:    true,
 				Default:     false,
 				Description: "Delete all federated members on `terraform destroy` if set to `true`. Caution: it will delete all the repositories in the federation on other Artifactory instances.",
 			},
 			"member": {
-				Type:     schema.TypeSet,
+				Type:     sdkv2_schema.TypeSet,
 				Required: isRequired,
 				Optional: !isRequired,
 				Description: "The list of Federated members. If a Federated member receives a request that does not include the repository URL, it will " +
 					"automatically be added with the combination of the configured base URL and `key` field value. " +
 					"Note that each of the federated members will need to have a base URL set. Please follow the [instruction](https://www.jfrog.com/confluence/display/JFROG/Working+with+Federated+Repositories#WorkingwithFederatedRepositories-SettingUpaFederatedRepository)" +
 					" to set up Federated repositories correctly.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+				Elem: &sdkv2_schema.Resource{
+					Schema: map[string]*sdkv2_schema.Schema{
 						"url": {
-							Type:             schema.TypeString,
+							Type:             sdkv2_schema.TypeString,
 							Required:         true,
 							Description:      "Full URL to ending with the repositoryName",
 							ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
 						},
 						"enabled": {
-							Type:     schema.TypeBool,
+							Type:     sdkv2_schema.TypeBool,
 							Required: true,
 							Description: "Represents the active state of the federated member. It is supported to " +
 								"change the enabled status of my own member. The config will be updated on the other " +
@@ -111,41 +650,41 @@ var SchemaGeneratorV3 = func(isRequired bool) map[string]*schema.Schema {
 
 var federatedSchemaV3 = SchemaGeneratorV3(true)
 
-var SchemaGeneratorV4 = func(isRequired bool) map[string]*schema.Schema {
+var SchemaGeneratorV4 = func(isRequired bool) map[string]*sdkv2_schema.Schema {
 	return lo.Assign(
 		federatedSchemaV3,
-		map[string]*schema.Schema{
+		map[string]*sdkv2_schema.Schema{
 			"cleanup_on_delete": {
-				Type:        schema.TypeBool,
+				Type:        sdkv2_schema.TypeBool,
 				Optional:    true,
 				Default:     false,
 				Description: "Delete all federated members on `terraform destroy` if set to `true`. Caution: it will delete all the repositories in the federation on other Artifactory instances. Set `access_token` attribute if Access Federation for access tokens is not enabled.",
 			},
 			"member": {
-				Type:     schema.TypeSet,
+				Type:     sdkv2_schema.TypeSet,
 				Required: isRequired,
 				Optional: !isRequired,
 				Description: "The list of Federated members. If a Federated member receives a request that does not include the repository URL, it will " +
 					"automatically be added with the combination of the configured base URL and `key` field value. " +
 					"Note that each of the federated members will need to have a base URL set. Please follow the [instruction](https://www.jfrog.com/confluence/display/JFROG/Working+with+Federated+Repositories#WorkingwithFederatedRepositories-SettingUpaFederatedRepository)" +
 					" to set up Federated repositories correctly.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+				Elem: &sdkv2_schema.Resource{
+					Schema: map[string]*sdkv2_schema.Schema{
 						"url": {
-							Type:             schema.TypeString,
+							Type:             sdkv2_schema.TypeString,
 							Required:         true,
 							Description:      "Full URL to ending with the repositoryName",
 							ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
 						},
 						"enabled": {
-							Type:     schema.TypeBool,
+							Type:     sdkv2_schema.TypeBool,
 							Required: true,
 							Description: "Represents the active state of the federated member. It is supported to " +
 								"change the enabled status of my own member. The config will be updated on the other " +
 								"federated members automatically.",
 						},
 						"access_token": {
-							Type:             schema.TypeString,
+							Type:             sdkv2_schema.TypeString,
 							Optional:         true,
 							Sensitive:        true,
 							ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
@@ -158,41 +697,7 @@ var SchemaGeneratorV4 = func(isRequired bool) map[string]*schema.Schema {
 	)
 }
 
-var federatedSchemaV4 = SchemaGeneratorV4(true)
-
-func unpackMembers(data *schema.ResourceData) []Member {
-	d := &utilsdk.ResourceData{ResourceData: data}
-	var members []Member
-
-	if v, ok := d.GetOk("member"); ok {
-		federatedMembers := v.(*schema.Set).List()
-		if len(federatedMembers) == 0 {
-			return members
-		}
-
-		for _, federatedMember := range federatedMembers {
-			id := federatedMember.(map[string]interface{})
-
-			member := Member{
-				Url:     id["url"].(string),
-				Enabled: id["enabled"].(bool),
-			}
-			members = append(members, member)
-		}
-	}
-	return members
-}
-
-func unpackRepoParams(data *schema.ResourceData) RepoParams {
-	d := &utilsdk.ResourceData{ResourceData: data}
-
-	return RepoParams{
-		Proxy:        d.GetString("proxy", false),
-		DisableProxy: d.GetBool("disable_proxy", false),
-	}
-}
-
-func PackMembers(members []Member, d *schema.ResourceData) error {
+func PackMembers(members []Member, d *sdkv2_schema.ResourceData) error {
 	setValue := utilsdk.MkLens(d)
 
 	var federatedMembers []interface{}
@@ -207,7 +712,7 @@ func PackMembers(members []Member, d *schema.ResourceData) error {
 		// find matching member to restore the 'access_token' value
 		if v, ok := d.GetOk("member"); ok {
 			matchedMember, found := lo.Find(
-				v.(*schema.Set).List(),
+				v.(*sdkv2_schema.Set).List(),
 				func(m interface{}) bool {
 					id := m.(map[string]interface{})
 					return id["url"] == member.Url
@@ -233,225 +738,6 @@ func PackMembers(members []Member, d *schema.ResourceData) error {
 	return nil
 }
 
-func configSync(ctx context.Context, repoKey string, m interface{}) diag.Diagnostics {
-	var ds diag.Diagnostics
-
-	tflog.Info(ctx,
-		"triggering synchronization of the federated member configuration",
-		map[string]interface{}{
-			"repoKey": repoKey,
-		},
-	)
-	resp, restErr := m.(util.ProviderMetadata).Client.R().
-		SetPathParam("repositoryKey", repoKey).
-		Post("artifactory/api/federation/configSync/{repositoryKey}")
-	if restErr != nil {
-		ds = append(ds, diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  "failed to trigger synchronization of the federated member configuration",
-			Detail:   restErr.Error(),
-		})
-	}
-	if resp.IsError() {
-		ds = append(ds, diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  "failed to trigger synchronization of the federated member configuration",
-			Detail:   resp.String(),
-		})
-	}
-
-	return ds
-}
-
-func createRepo(unpack unpacker.UnpackFunc, read schema.ReadContextFunc) schema.CreateContextFunc {
-	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-		var ds diag.Diagnostics
-		ds = append(ds, repository.Create(ctx, d, m, unpack)...)
-		if ds.HasError() {
-			return ds
-		}
-
-		// Skip synchronization for `releasebundles` package type to prevent creation of the member repo on target.
-		// There is a bug on 7.126, which prevents correct sync for environments, and only DEV will be added, if the
-		// repo on the target instance will be created by federation.
-		packageType, ok := d.GetOk("package_type")
-		if !ok || packageType.(string) != repository.ReleasebundlesPackageType {
-			ds = append(ds, configSync(ctx, d.Id(), m)...)
-			if ds.HasError() {
-				return ds
-			}
-		}
-
-		return append(ds, read(ctx, d, m)...)
-	}
-}
-
-func updateRepo(unpack unpacker.UnpackFunc, read schema.ReadContextFunc) schema.UpdateContextFunc {
-	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-		var ds diag.Diagnostics
-		ds = append(ds, repository.Update(ctx, d, m, unpack)...)
-		if ds.HasError() {
-			return ds
-		}
-
-		ds = append(ds, configSync(ctx, d.Id(), m)...)
-		if ds.HasError() {
-			return ds
-		}
-
-		return append(ds, read(ctx, d, m)...)
-	}
-}
-
-func deleteRepo(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	ds := diag.Diagnostics{}
-
-	restyClient := m.(util.ProviderMetadata).Client
-
-	allowDelete, ok := d.GetOkExists("allow_delete")
-
-	if ok && !allowDelete.(bool) { // set to 'false'
-		return diag.Errorf("failed to destroy resource. 'allow_delete' is not set to 'true'")
-	}
-
-	tflog.Warn(ctx, fmt.Sprintf("allow_delete is set to 'true'. Deleting repository %s", d.Id()))
-
-	// For federated repositories we delete all the federated members (except the initial repo member), if the flag `cleanup_on_delete` is set to `true`
-	s := &utilsdk.ResourceData{ResourceData: d}
-	initialRepoName := s.GetString("key", false)
-
-	if v, ok := d.GetOk("member"); ok && s.GetBool("cleanup_on_delete", false) {
-		federatedMembers := v.(*schema.Set).List()
-
-		for _, federatedMember := range federatedMembers {
-			id := federatedMember.(map[string]interface{})
-
-			memberUrl := id["url"].(string) // example "https://artifactory-instance.com/artifactory/federated-generic-repository-example"
-			parsedMemberUrl, err := url.Parse(memberUrl)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			memberHost := memberUrl[:strings.Index(memberUrl, parsedMemberUrl.Path)]
-			memberRepoName := strings.ReplaceAll(memberUrl, memberUrl[:strings.LastIndex(memberUrl, "/")+1], "")
-
-			if initialRepoName != memberRepoName || !strings.HasPrefix(memberUrl, restyClient.BaseURL) {
-				request := restyClient.R().
-					AddRetryCondition(client.RetryOnMergeError).
-					SetPathParam("key", memberRepoName)
-
-				accessToken := ""
-				if v, ok := id["access_token"]; ok {
-					accessToken = v.(string)
-				}
-
-				if accessToken != "" {
-					request.SetAuthToken(accessToken)
-				}
-
-				memberAPIURL := fmt.Sprintf("%s/%s", memberHost, RepositoriesEndpoint)
-				resp, err := request.Delete(memberAPIURL)
-
-				if err != nil {
-					ds = append(
-						ds,
-						diag.Diagnostic{
-							Severity: diag.Warning,
-							Summary:  "Failed to delete federated repository member",
-							Detail:   fmt.Sprintf("Error deleting member repository %s: %s", memberRepoName, err.Error()),
-						},
-					)
-				}
-
-				if resp.IsError() {
-					ds = append(
-						ds,
-						diag.Diagnostic{
-							Severity: diag.Warning,
-							Summary:  "Failed to delete federated repository member",
-							Detail:   fmt.Sprintf("Error deleting member repository %s: %s", memberRepoName, resp.String()),
-						},
-					)
-				}
-			}
-		}
-	}
-
-	resp, err := restyClient.R().
-		AddRetryCondition(client.RetryOnMergeError).
-		SetPathParam("key", d.Id()).
-		Delete(RepositoriesEndpoint)
-
-	if err != nil {
-		ds = append(
-			ds,
-			diag.FromErr(err)...,
-		)
-		return ds
-	}
-
-	if resp.IsError() {
-		ds = append(
-			ds,
-			diag.Errorf("%s", resp.String())...,
-		)
-		return ds
-	}
-
-	d.SetId("")
-
-	return ds
-}
-
-func mkResourceSchema(skeema map[string]*schema.Schema, packer packer.PackFunc, unpack unpacker.UnpackFunc, constructor repository.Constructor) *schema.Resource {
-	var reader = repository.MkRepoRead(packer, constructor)
-	return &schema.Resource{
-		CreateContext: createRepo(unpack, reader),
-		ReadContext:   reader,
-		UpdateContext: updateRepo(unpack, reader),
-		DeleteContext: deleteRepo,
-
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
-		Schema:        skeema,
-		SchemaVersion: 4,
-
-		StateUpgraders: []schema.StateUpgrader{
-			{
-				// this only works because the schema hasn't changed, except the removal of default value
-				// from `project_key` attribute.
-				Type:    resourceV2(federatedSchemaV3).CoreConfigSchema().ImpliedType(),
-				Upgrade: repository.ResourceUpgradeProjectKey,
-				Version: 2,
-			},
-			{
-				Type:    resourceV3().CoreConfigSchema().ImpliedType(),
-				Upgrade: upgradeMemberAccessToken,
-				Version: 3,
-			},
-		},
-		CustomizeDiff: customdiff.All(
-			repository.ProjectEnvironmentsDiff,
-			repository.VerifyDisableProxy,
-			repository.VerifyReleasebundlesKey,
-		),
-	}
-}
-
-func resourceV2(skeema map[string]*schema.Schema) *schema.Resource {
-	return &schema.Resource{
-		Schema: skeema,
-	}
-}
-
-func resourceV3() *schema.Resource {
-	return &schema.Resource{
-		Schema: federatedSchemaV3,
-	}
-}
-
 func upgradeMemberAccessToken(_ context.Context, rawState map[string]any, meta any) (map[string]any, error) {
 	if v, ok := rawState["member"]; ok {
 		for _, m := range v.([]interface{}) {
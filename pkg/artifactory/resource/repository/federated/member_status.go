@@ -0,0 +1,299 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federated
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+)
+
+const (
+	federationStatusEndpoint   = "artifactory/api/federation/status/repo/{repositoryKey}"
+	federationFullSyncEndpoint = "artifactory/api/federation/fullSync/{repositoryKey}"
+
+	memberStatusHealthy = "HEALTHY"
+	memberStatusUnknown = "UNKNOWN"
+
+	defaultHealthyMembersTimeoutSeconds = 600
+)
+
+// memberStatusRefresh is when `member_status` is refreshed, which decides how failures are reported.
+type memberStatusRefresh int
+
+const (
+	memberStatusOnRead memberStatusRefresh = iota
+	memberStatusAfterCreate
+	memberStatusAfterUpdate
+)
+
+// memberStatusPollInterval is a variable so unit tests can shorten it.
+var memberStatusPollInterval = 10 * time.Second
+
+type federationMirrorLagAPIModel struct {
+	RemoteUrl     string `json:"remoteUrl"`
+	RemoteRepoKey string `json:"remoteRepoKey"`
+	LagInMS       int64  `json:"lagInMS"`
+}
+
+type federationUnavailableMirrorAPIModel struct {
+	RemoteUrl     string `json:"remoteUrl"`
+	RemoteRepoKey string `json:"remoteRepoKey"`
+	Status        string `json:"status"`
+}
+
+type federationStatusAPIModel struct {
+	LocalKey           string                                `json:"localKey"`
+	MirrorLags         []federationMirrorLagAPIModel         `json:"mirrorLags"`
+	UnavailableMirrors []federationUnavailableMirrorAPIModel `json:"unavailableMirrors"`
+}
+
+type FederatedMemberStatusResourceModel struct {
+	URL     types.String `tfsdk:"url"`
+	Status  types.String `tfsdk:"status"`
+	LagInMS types.Int64  `tfsdk:"lag_in_ms"`
+}
+
+var memberStatusResourceModelAttributeTypes = map[string]attr.Type{
+	"url":       types.StringType,
+	"status":    types.StringType,
+	"lag_in_ms": types.Int64Type,
+}
+
+var memberStatusListElementType = types.ObjectType{
+	AttrTypes: memberStatusResourceModelAttributeTypes,
+}
+
+// sameMirror matches a member URL with a mirror of the status API, which may report the URL
+// of the remote instance and the repository key separately.
+func sameMirror(memberUrl, remoteUrl, remoteRepoKey string) bool {
+	memberUrl = strings.TrimSuffix(memberUrl, "/")
+	remoteUrl = strings.TrimSuffix(remoteUrl, "/")
+
+	return memberUrl == remoteUrl || memberUrl == fmt.Sprintf("%s/%s", remoteUrl, remoteRepoKey)
+}
+
+// isLocalMember returns true if the member URL points to the repository itself on this Artifactory instance.
+func isLocalMember(key, baseURL, memberUrl string) bool {
+	memberRepoName := memberUrl[strings.LastIndex(memberUrl, "/")+1:]
+	return memberRepoName == key && strings.HasPrefix(memberUrl, baseURL)
+}
+
+// memberStatuses returns the status of the remote members, in the order of the members. Members
+// not reported by Artifactory have the `UNKNOWN` status.
+func memberStatuses(key, baseURL string, members []FederatedMemberResourceModel, status federationStatusAPIModel) []FederatedMemberStatusResourceModel {
+	remoteMembers := lo.Filter(members, func(m FederatedMemberResourceModel, _ int) bool {
+		return !isLocalMember(key, baseURL, m.URL.ValueString())
+	})
+
+	return lo.Map(remoteMembers, func(m FederatedMemberResourceModel, _ int) FederatedMemberStatusResourceModel {
+		memberStatus := FederatedMemberStatusResourceModel{
+			URL:     m.URL,
+			Status:  types.StringValue(memberStatusUnknown),
+			LagInMS: types.Int64Null(),
+		}
+
+		if unavailable, found := lo.Find(status.UnavailableMirrors, func(u federationUnavailableMirrorAPIModel) bool {
+			return sameMirror(m.URL.ValueString(), u.RemoteUrl, u.RemoteRepoKey)
+		}); found {
+			memberStatus.Status = types.StringValue(unavailable.Status)
+			return memberStatus
+		}
+
+		if lag, found := lo.Find(status.MirrorLags, func(l federationMirrorLagAPIModel) bool {
+			return sameMirror(m.URL.ValueString(), l.RemoteUrl, l.RemoteRepoKey)
+		}); found {
+			memberStatus.Status = types.StringValue(memberStatusHealthy)
+			memberStatus.LagInMS = types.Int64Value(lag.LagInMS)
+		}
+
+		return memberStatus
+	})
+}
+
+// unhealthyMembers returns the URLs of the enabled members which are not healthy.
+func unhealthyMembers(members []FederatedMemberResourceModel, statuses []FederatedMemberStatusResourceModel) []string {
+	return lo.FilterMap(statuses, func(s FederatedMemberStatusResourceModel, _ int) (string, bool) {
+		member, _ := lo.Find(members, func(m FederatedMemberResourceModel) bool {
+			return m.URL.ValueString() == s.URL.ValueString()
+		})
+
+		return s.URL.ValueString(), member.Enabled.ValueBool() && s.Status.ValueString() != memberStatusHealthy
+	})
+}
+
+func getFederationStatus(ctx context.Context, client *resty.Client, key string) (federationStatusAPIModel, error) {
+	var status federationStatusAPIModel
+	var jfrogErrors util.JFrogErrors
+
+	response, err := client.R().
+		SetContext(ctx).
+		SetPathParam("repositoryKey", key).
+		SetResult(&status).
+		SetError(&jfrogErrors).
+		Get(federationStatusEndpoint)
+	if err != nil {
+		return status, err
+	}
+
+	if response.IsError() {
+		return status, fmt.Errorf("%s", jfrogErrors.String())
+	}
+
+	return status, nil
+}
+
+// waitForHealthyMembers polls getStatuses until all the enabled members are healthy, or until timeout
+// elapses. Failures to get the statuses are retried until then. The last known statuses are always
+// returned so callers can record them in state.
+func waitForHealthyMembers(ctx context.Context, timeout time.Duration, members []FederatedMemberResourceModel, getStatuses func(context.Context) ([]FederatedMemberStatusResourceModel, error)) ([]FederatedMemberStatusResourceModel, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(memberStatusPollInterval)
+	defer ticker.Stop()
+
+	var statuses []FederatedMemberStatusResourceModel
+	var lastErr error
+	for {
+		current, err := getStatuses(ctx)
+		if err != nil {
+			tflog.Debug(ctx, "failed to get the status of the federated members, retrying", map[string]interface{}{
+				"error": err.Error(),
+			})
+			lastErr = err
+		} else {
+			statuses, lastErr = current, nil
+			if len(unhealthyMembers(members, statuses)) == 0 {
+				return statuses, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return statuses, fmt.Errorf("timed out after %s waiting for federated members to be healthy: %w", timeout, lastErr)
+			}
+			return statuses, fmt.Errorf("timed out after %s waiting for federated members to be healthy: %s", timeout, strings.Join(unhealthyMembers(members, statuses), ", "))
+		case <-ticker.C:
+		}
+	}
+}
+
+// refreshMemberStatus reads the status of the members and, if `wait` is set, waits until the enabled members
+// are healthy. Members not healthy in time fail an update, but are only reported as a warning after a create
+// so the new repository is not tainted. Without `wait`, a failure to read the status is reported as a warning
+// after an apply and only logged on read, the member status is then null.
+func (r *federatedResource) refreshMemberStatus(ctx context.Context, key string, members []FederatedMemberResourceModel, refresh memberStatusRefresh, wait bool, timeout time.Duration) (types.List, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	client := r.ProviderData.Client
+	getStatuses := func(ctx context.Context) ([]FederatedMemberStatusResourceModel, error) {
+		status, err := getFederationStatus(ctx, client, key)
+		if err != nil {
+			return nil, err
+		}
+
+		return memberStatuses(key, client.BaseURL, members, status), nil
+	}
+
+	var statuses []FederatedMemberStatusResourceModel
+	var err error
+	if wait {
+		statuses, err = waitForHealthyMembers(ctx, timeout, members, getStatuses)
+		switch {
+		case err == nil:
+		case refresh == memberStatusAfterUpdate:
+			diags.AddError(
+				"Federated repository members are not healthy",
+				err.Error(),
+			)
+		default:
+			diags.AddWarning(
+				"Federated repository members are not healthy",
+				fmt.Sprintf("The repository has been created. %s", err.Error()),
+			)
+		}
+	} else {
+		statuses, err = getStatuses(ctx)
+		switch {
+		case err == nil:
+		case refresh == memberStatusOnRead:
+			tflog.Warn(ctx, "failed to get the status of the federated members", map[string]interface{}{
+				"repoKey": key,
+				"error":   err.Error(),
+			})
+		default:
+			diags.AddWarning(
+				"failed to get the status of the federated members",
+				err.Error(),
+			)
+		}
+	}
+
+	if statuses == nil {
+		return types.ListNull(memberStatusListElementType), diags
+	}
+
+	memberStatusList, d := types.ListValueFrom(ctx, memberStatusListElementType, statuses)
+	diags.Append(d...)
+
+	return memberStatusList, diags
+}
+
+// fullSync triggers the synchronization of the repository content, and not only its configuration, with
+// a member. Failures are only reported as warnings as the repository itself has been saved.
+func (r *federatedResource) fullSync(ctx context.Context, key, memberUrl string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	tflog.Info(ctx,
+		"triggering full synchronization of the federated member",
+		map[string]interface{}{
+			"repoKey":   key,
+			"memberUrl": memberUrl,
+		},
+	)
+
+	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParam("repositoryKey", key).
+		SetQueryParam("mirror", memberUrl).
+		Post(federationFullSyncEndpoint)
+	if err != nil {
+		diags.AddWarning(
+			"failed to trigger full synchronization of the federated member",
+			err.Error(),
+		)
+		return diags
+	}
+
+	if response.IsError() {
+		diags.AddWarning(
+			"failed to trigger full synchronization of the federated member",
+			response.String(),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,167 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federated
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func member(url string, enabled bool) FederatedMemberResourceModel {
+	return FederatedMemberResourceModel{
+		URL:         types.StringValue(url),
+		Enabled:     types.BoolValue(enabled),
+		AccessToken: types.StringNull(),
+	}
+}
+
+func TestMemberStatuses(t *testing.T) {
+	members := []FederatedMemberResourceModel{
+		member("https://rt1.example.com/artifactory/fed-generic", true),
+		member("https://rt2.example.com/artifactory/fed-generic", true),
+		member("https://rt3.example.com/artifactory/fed-generic/", true),
+		member("https://rt4.example.com/artifactory/fed-generic", false),
+	}
+	status := federationStatusAPIModel{
+		LocalKey: "fed-generic",
+		MirrorLags: []federationMirrorLagAPIModel{
+			{RemoteUrl: "https://rt2.example.com/artifactory/fed-generic", RemoteRepoKey: "fed-generic", LagInMS: 42},
+		},
+		UnavailableMirrors: []federationUnavailableMirrorAPIModel{
+			{RemoteUrl: "https://rt3.example.com/artifactory", RemoteRepoKey: "fed-generic", Status: "BLOCKED"},
+		},
+	}
+
+	statuses := memberStatuses("fed-generic", "https://rt1.example.com", members, status)
+
+	if len(statuses) != 3 {
+		t.Fatalf("expected the local member to be excluded, got %v", statuses)
+	}
+
+	expected := []struct {
+		status string
+		lag    types.Int64
+	}{
+		{memberStatusHealthy, types.Int64Value(42)},
+		{"BLOCKED", types.Int64Null()},
+		{memberStatusUnknown, types.Int64Null()},
+	}
+	for i, e := range expected {
+		if statuses[i].Status.ValueString() != e.status || !statuses[i].LagInMS.Equal(e.lag) {
+			t.Errorf("member %s: expected status %s and lag %s, got %s and %s", statuses[i].URL, e.status, e.lag, statuses[i].Status, statuses[i].LagInMS)
+		}
+	}
+
+	if unhealthy := unhealthyMembers(members, statuses); len(unhealthy) != 1 || unhealthy[0] != "https://rt3.example.com/artifactory/fed-generic/" {
+		t.Errorf("expected only the enabled unavailable member to be unhealthy, got %v", unhealthy)
+	}
+}
+
+func TestNewMembers(t *testing.T) {
+	prior := []FederatedMemberResourceModel{
+		member("https://rt1.example.com/artifactory/fed-generic", true),
+	}
+	members := append(prior, member("https://rt2.example.com/artifactory/fed-generic", true))
+
+	added := newMembers(members, prior)
+	if len(added) != 1 || added[0].URL.ValueString() != "https://rt2.example.com/artifactory/fed-generic" {
+		t.Fatalf("expected one new member, got %v", added)
+	}
+}
+
+func TestWaitForHealthyMembers(t *testing.T) {
+	memberStatusPollInterval = time.Millisecond
+	defer func() { memberStatusPollInterval = 10 * time.Second }()
+
+	members := []FederatedMemberResourceModel{
+		member("https://rt2.example.com/artifactory/fed-generic", true),
+	}
+	// withStatus returns the given statuses in turn, an empty status is a failure to get the status
+	withStatus := func(values ...string) func(context.Context) ([]FederatedMemberStatusResourceModel, error) {
+		return func(context.Context) ([]FederatedMemberStatusResourceModel, error) {
+			value := values[0]
+			if len(values) > 1 {
+				values = values[1:]
+			}
+			if value == "" {
+				return nil, errors.New("connection reset")
+			}
+			return []FederatedMemberStatusResourceModel{
+				{
+					URL:     members[0].URL,
+					Status:  types.StringValue(value),
+					LagInMS: types.Int64Null(),
+				},
+			}, nil
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		timeout        time.Duration
+		getStatuses    func(context.Context) ([]FederatedMemberStatusResourceModel, error)
+		expectedStatus string
+		expectedError  string
+	}{
+		{
+			name:           "healthy",
+			timeout:        time.Second,
+			getStatuses:    withStatus(memberStatusUnknown, "BLOCKED", memberStatusHealthy),
+			expectedStatus: memberStatusHealthy,
+		},
+		{
+			name:           "timed out",
+			timeout:        10 * time.Millisecond,
+			getStatuses:    withStatus("BLOCKED"),
+			expectedStatus: "BLOCKED",
+			expectedError:  "timed out",
+		},
+		{
+			name:           "transient error",
+			timeout:        time.Second,
+			getStatuses:    withStatus("", "BLOCKED", "", memberStatusHealthy),
+			expectedStatus: memberStatusHealthy,
+		},
+		{
+			name:           "timed out with error",
+			timeout:        10 * time.Millisecond,
+			getStatuses:    withStatus("BLOCKED", ""),
+			expectedStatus: "BLOCKED",
+			expectedError:  "connection reset",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			statuses, err := waitForHealthyMembers(context.Background(), tc.timeout, members, tc.getStatuses)
+
+			if len(statuses) != 1 || statuses[0].Status.ValueString() != tc.expectedStatus {
+				t.Errorf("expected status %s, got %v", tc.expectedStatus, statuses)
+			}
+
+			if tc.expectedError == "" && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if tc.expectedError != "" && (err == nil || !strings.Contains(err.Error(), tc.expectedError)) {
+				t.Errorf("expected error containing %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
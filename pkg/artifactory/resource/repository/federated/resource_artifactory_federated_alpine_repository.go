@@ -15,71 +15,113 @@
 package federated
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	"github.com/jfrog/terraform-provider-shared/predicate"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
-type AlpineRepositoryParams struct {
-	local.AlpineLocalRepoParams
-	Members []Member `hcl:"member" json:"members"`
-	RepoParams
+func NewAlpineFederatedRepositoryResource() resource.Resource {
+	return &federatedAlpineResource{
+		federatedResource: NewFederatedRepositoryResource(
+			repository.AlpinePackageType,
+			"Alpine",
+			reflect.TypeFor[FederatedAlpineResourceModel](),
+			reflect.TypeFor[FederatedAlpineAPIModel](),
+			local.NewAlpineLocalRepositoryResource,
+		),
+	}
 }
 
-func unpackLocalAlpineRepository(data *schema.ResourceData, Rclass string) local.AlpineLocalRepoParams {
-	d := &utilsdk.ResourceData{ResourceData: data}
-	return local.AlpineLocalRepoParams{
-		RepositoryBaseParams: local.UnpackBaseRepo(Rclass, data, repository.AlpinePackageType),
-		PrimaryKeyPairRefParam: repository.PrimaryKeyPairRefParam{
-			PrimaryKeyPairRefSDKv2: d.GetString("primary_keypair_ref", false),
-		},
-	}
+type federatedAlpineResource struct {
+	federatedResource
 }
 
-func ResourceArtifactoryFederatedAlpineRepository() *schema.Resource {
-	alpineFederatedSchema := utilsdk.MergeMaps(
-		local.AlpineLocalSchemas[local.CurrentSchemaVersion],
-		federatedSchemaV4,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, repository.AlpinePackageType),
-	)
-
-	var unpackFederatedAlpineRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := AlpineRepositoryParams{
-			AlpineLocalRepoParams: unpackLocalAlpineRepository(data, Rclass),
-			Members:               unpackMembers(data),
-			RepoParams:            unpackRepoParams(data),
-		}
-		return repo, repo.Id(), nil
-	}
+type FederatedAlpineResourceModel struct {
+	local.LocalAlpineResourceModel
+	FederatedResourceModel
+}
 
-	var packAlpineMembers = func(repo interface{}, d *schema.ResourceData) error {
-		members := repo.(*AlpineRepositoryParams).Members
-		return PackMembers(members, d)
+func (r *FederatedAlpineResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r FederatedAlpineResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedAlpineResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedAlpineResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedAlpineResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *FederatedAlpineResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedAlpineResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r FederatedAlpineResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model, d := r.LocalAlpineResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	pkr := packer.Compose(
-		packer.Universal(
-			predicate.All(
-				predicate.NoClass,
-				predicate.Ignore("member", "terraform_type"),
-			),
-		),
-		packAlpineMembers,
-	)
-
-	constructor := func() (interface{}, error) {
-		return &AlpineRepositoryParams{
-			AlpineLocalRepoParams: local.AlpineLocalRepoParams{
-				RepositoryBaseParams: local.RepositoryBaseParams{
-					PackageType: repository.AlpinePackageType,
-					Rclass:      Rclass,
-				},
-			},
-		}, nil
+	localAPIModel := model.(local.LocalAlpineAPIModel)
+	localAPIModel.Rclass = Rclass
+
+	federatedAPIModel, d := r.FederatedResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return mkResourceSchema(alpineFederatedSchema, pkr, unpackFederatedAlpineRepository, constructor)
+	return FederatedAlpineAPIModel{
+		LocalAlpineAPIModel: localAPIModel,
+		FederatedAPIModel:   federatedAPIModel,
+	}, diags
+}
+
+func (r *FederatedAlpineResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*FederatedAlpineAPIModel)
+
+	diags.Append(r.LocalAlpineResourceModel.FromAPIModel(ctx, &model.LocalAlpineAPIModel)...)
+	diags.Append(r.FederatedResourceModel.FromAPIModel(ctx, model.FederatedAPIModel)...)
+
+	return diags
+}
+
+type FederatedAlpineAPIModel struct {
+	local.LocalAlpineAPIModel
+	FederatedAPIModel
+}
+
+// SDKv2
+
+type AlpineRepositoryParams struct {
+	local.AlpineLocalRepoParams
+	Members []Member `hcl:"member" json:"members"`
+	RepoParams
 }
@@ -15,67 +15,114 @@
 package federated
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	"github.com/jfrog/terraform-provider-shared/predicate"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
-	"github.com/samber/lo"
 )
 
-type AnsibleRepositoryParams struct {
-	local.RepositoryBaseParams
-	RepoParams
-	Members []Member `hcl:"member" json:"members"`
-	repository.PrimaryKeyPairRefParam
+func NewAnsibleFederatedRepositoryResource() resource.Resource {
+	return &federatedAnsibleResource{
+		federatedResource: NewFederatedRepositoryResource(
+			repository.AnsiblePackageType,
+			"Ansible",
+			reflect.TypeFor[FederatedAnsibleResourceModel](),
+			reflect.TypeFor[FederatedAnsibleAPIModel](),
+			local.NewAnsibleLocalRepositoryResource,
+		),
+	}
 }
 
-func ResourceArtifactoryFederatedAnsibleRepository() *schema.Resource {
-	var ansibleSchema = lo.Assign(
-		local.GetGenericSchemas(repository.AnsiblePackageType)[local.CurrentSchemaVersion],
-		federatedSchemaV4,
-		repository.AlpinePrimaryKeyPairRefSDKv2,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, repository.AnsiblePackageType),
-	)
-
-	var unpackFederatedRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		d := &utilsdk.ResourceData{ResourceData: data}
-
-		repo := AnsibleRepositoryParams{
-			RepositoryBaseParams: local.UnpackBaseRepo(Rclass, data, repository.AnsiblePackageType),
-			RepoParams:           unpackRepoParams(data),
-			Members:              unpackMembers(data),
-			PrimaryKeyPairRefParam: repository.PrimaryKeyPairRefParam{
-				PrimaryKeyPairRefSDKv2: d.GetString("primary_keypair_ref", false),
-			},
-		}
-		return repo, repo.Id(), nil
-	}
+type federatedAnsibleResource struct {
+	federatedResource
+}
+
+type FederatedAnsibleResourceModel struct {
+	local.LocalAnsibleResourceModel
+	FederatedResourceModel
+}
+
+func (r *FederatedAnsibleResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r FederatedAnsibleResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedAnsibleResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedAnsibleResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedAnsibleResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
 
-	var packMembers = func(repo interface{}, d *schema.ResourceData) error {
-		members := repo.(*AnsibleRepositoryParams).Members
-		return PackMembers(members, d)
+func (r *FederatedAnsibleResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedAnsibleResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r FederatedAnsibleResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model, d := r.LocalAnsibleResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	pkr := packer.Compose(
-		packer.Universal(
-			predicate.All(
-				predicate.NoClass,
-				predicate.Ignore("member", "terraform_type"),
-			),
-		),
-		packMembers,
-	)
-
-	constructor := func() (interface{}, error) {
-		return &AnsibleRepositoryParams{
-			RepositoryBaseParams: local.RepositoryBaseParams{
-				PackageType: repository.AnsiblePackageType,
-				Rclass:      Rclass,
-			},
-		}, nil
+	localAPIModel := model.(local.LocalAnsibleAPIModel)
+	localAPIModel.Rclass = Rclass
+
+	federatedAPIModel, d := r.FederatedResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return mkResourceSchema(ansibleSchema, pkr, unpackFederatedRepository, constructor)
+	return FederatedAnsibleAPIModel{
+		LocalAnsibleAPIModel: localAPIModel,
+		FederatedAPIModel:    federatedAPIModel,
+	}, diags
+}
+
+func (r *FederatedAnsibleResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*FederatedAnsibleAPIModel)
+
+	diags.Append(r.LocalAnsibleResourceModel.FromAPIModel(ctx, &model.LocalAnsibleAPIModel)...)
+	diags.Append(r.FederatedResourceModel.FromAPIModel(ctx, model.FederatedAPIModel)...)
+
+	return diags
+}
+
+type FederatedAnsibleAPIModel struct {
+	local.LocalAnsibleAPIModel
+	FederatedAPIModel
+}
+
+// SDKv2
+
+type AnsibleRepositoryParams struct {
+	local.RepositoryBaseParams
+	RepoParams
+	Members []Member `hcl:"member" json:"members"`
+	repository.PrimaryKeyPairRefParam
 }
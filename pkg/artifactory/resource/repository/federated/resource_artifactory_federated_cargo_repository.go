@@ -15,71 +15,113 @@
 package federated
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	"github.com/jfrog/terraform-provider-shared/predicate"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
-	"github.com/samber/lo"
 )
 
-type CargoFederatedRepositoryParams struct {
-	local.CargoLocalRepoParams
-	Members []Member `hcl:"member" json:"members"`
-	RepoParams
+func NewCargoFederatedRepositoryResource() resource.Resource {
+	return &federatedCargoResource{
+		federatedResource: NewFederatedRepositoryResource(
+			repository.CargoPackageType,
+			"Cargo",
+			reflect.TypeFor[FederatedCargoResourceModel](),
+			reflect.TypeFor[FederatedCargoAPIModel](),
+			local.NewCargoLocalRepositoryResource,
+		),
+	}
 }
 
-func unpackLocalCargoRepository(data *schema.ResourceData, Rclass string) local.CargoLocalRepoParams {
-	d := &utilsdk.ResourceData{ResourceData: data}
-	return local.CargoLocalRepoParams{
-		RepositoryBaseParams: local.UnpackBaseRepo(Rclass, data, repository.CargoPackageType),
-		AnonymousAccess:      d.GetBool("anonymous_access", false),
-		EnableSparseIndex:    d.GetBool("enable_sparse_index", false),
-	}
+type federatedCargoResource struct {
+	federatedResource
 }
 
-func ResourceArtifactoryFederatedCargoRepository() *schema.Resource {
-	cargoFederatedSchema := lo.Assign(
-		local.CargoSchemas[local.CurrentSchemaVersion],
-		federatedSchemaV4,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, repository.CargoPackageType),
-	)
-
-	var unpackFederatedCargoRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := CargoFederatedRepositoryParams{
-			CargoLocalRepoParams: unpackLocalCargoRepository(data, Rclass),
-			Members:              unpackMembers(data),
-			RepoParams:           unpackRepoParams(data),
-		}
-		return repo, repo.Id(), nil
-	}
+type FederatedCargoResourceModel struct {
+	local.LocalCargoResourceModel
+	FederatedResourceModel
+}
 
-	var packCargoMembers = func(repo interface{}, d *schema.ResourceData) error {
-		members := repo.(*CargoFederatedRepositoryParams).Members
-		return PackMembers(members, d)
+func (r *FederatedCargoResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r FederatedCargoResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedCargoResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedCargoResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedCargoResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *FederatedCargoResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedCargoResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r FederatedCargoResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model, d := r.LocalCargoResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	pkr := packer.Compose(
-		packer.Universal(
-			predicate.All(
-				predicate.NoClass,
-				predicate.Ignore("member", "terraform_type"),
-			),
-		),
-		packCargoMembers,
-	)
-
-	constructor := func() (interface{}, error) {
-		return &CargoFederatedRepositoryParams{
-			CargoLocalRepoParams: local.CargoLocalRepoParams{
-				RepositoryBaseParams: local.RepositoryBaseParams{
-					PackageType: repository.CargoPackageType,
-					Rclass:      Rclass,
-				},
-			},
-		}, nil
+	localAPIModel := model.(local.LocalCargoAPIModel)
+	localAPIModel.Rclass = Rclass
+
+	federatedAPIModel, d := r.FederatedResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return mkResourceSchema(cargoFederatedSchema, pkr, unpackFederatedCargoRepository, constructor)
+	return FederatedCargoAPIModel{
+		LocalCargoAPIModel: localAPIModel,
+		FederatedAPIModel:  federatedAPIModel,
+	}, diags
+}
+
+func (r *FederatedCargoResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*FederatedCargoAPIModel)
+
+	diags.Append(r.LocalCargoResourceModel.FromAPIModel(ctx, &model.LocalCargoAPIModel)...)
+	diags.Append(r.FederatedResourceModel.FromAPIModel(ctx, model.FederatedAPIModel)...)
+
+	return diags
+}
+
+type FederatedCargoAPIModel struct {
+	local.LocalCargoAPIModel
+	FederatedAPIModel
+}
+
+// SDKv2
+
+type CargoFederatedRepositoryParams struct {
+	local.CargoLocalRepoParams
+	Members []Member `hcl:"member" json:"members"`
+	RepoParams
 }
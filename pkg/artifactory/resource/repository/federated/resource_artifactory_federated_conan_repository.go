@@ -15,73 +15,113 @@
 package federated
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	"github.com/jfrog/terraform-provider-shared/predicate"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
-	"github.com/samber/lo"
 )
 
-type ConanRepositoryParams struct {
-	local.ConanRepoParams
-	Members []Member `hcl:"member" json:"members"`
-	RepoParams
+func NewConanFederatedRepositoryResource() resource.Resource {
+	return &federatedConanResource{
+		federatedResource: NewFederatedRepositoryResource(
+			repository.ConanPackageType,
+			"Conan",
+			reflect.TypeFor[FederatedConanResourceModel](),
+			reflect.TypeFor[FederatedConanAPIModel](),
+			local.NewConanLocalRepositoryResource,
+		),
+	}
 }
 
-func ResourceArtifactoryFederatedConanRepository() *schema.Resource {
-	conanSchema := lo.Assign(
-		local.ConanSchemas[local.CurrentSchemaVersion],
-		federatedSchemaV4,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, repository.ConanPackageType),
-	)
-
-	var unpackConanRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		d := &utilsdk.ResourceData{ResourceData: data}
-		repo := ConanRepositoryParams{
-			ConanRepoParams: local.ConanRepoParams{
-				RepositoryBaseParams: local.UnpackBaseRepo(Rclass, data, repository.ConanPackageType),
-				ConanBaseParams: repository.ConanBaseParams{
-					EnableConanSupport:       true,
-					ForceConanAuthentication: d.GetBool("force_conan_authentication", false),
-				},
-			},
-			Members:    unpackMembers(data),
-			RepoParams: unpackRepoParams(data),
-		}
-		return repo, repo.Id(), nil
-	}
+type federatedConanResource struct {
+	federatedResource
+}
+
+type FederatedConanResourceModel struct {
+	local.LocalConanResourceModel
+	FederatedResourceModel
+}
+
+func (r *FederatedConanResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r FederatedConanResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedConanResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedConanResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedConanResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
 
-	var packConanMembers = func(repo interface{}, d *schema.ResourceData) error {
-		repo.(*ConanRepositoryParams).EnableConanSupport = true
-		members := repo.(*ConanRepositoryParams).Members
-		return PackMembers(members, d)
+func (r *FederatedConanResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedConanResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r FederatedConanResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model, d := r.LocalConanResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	pkr := packer.Compose(
-		packer.Universal(
-			predicate.All(
-				predicate.NoClass,
-				predicate.Ignore("member", "terraform_type", "enable_conan_support"),
-			),
-		),
-		packConanMembers,
-	)
-
-	constructor := func() (interface{}, error) {
-		return &ConanRepositoryParams{
-			ConanRepoParams: local.ConanRepoParams{
-				RepositoryBaseParams: local.RepositoryBaseParams{
-					PackageType: repository.ConanPackageType,
-					Rclass:      Rclass,
-				},
-				ConanBaseParams: repository.ConanBaseParams{
-					EnableConanSupport: true,
-				},
-			},
-		}, nil
+	localAPIModel := model.(local.LocalConanAPIModel)
+	localAPIModel.Rclass = Rclass
+
+	federatedAPIModel, d := r.FederatedResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return mkResourceSchema(conanSchema, pkr, unpackConanRepository, constructor)
+	return FederatedConanAPIModel{
+		LocalConanAPIModel: localAPIModel,
+		FederatedAPIModel:  federatedAPIModel,
+	}, diags
+}
+
+func (r *FederatedConanResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*FederatedConanAPIModel)
+
+	diags.Append(r.LocalConanResourceModel.FromAPIModel(ctx, &model.LocalConanAPIModel)...)
+	diags.Append(r.FederatedResourceModel.FromAPIModel(ctx, model.FederatedAPIModel)...)
+
+	return diags
+}
+
+type FederatedConanAPIModel struct {
+	local.LocalConanAPIModel
+	FederatedAPIModel
+}
+
+// SDKv2
+
+type ConanRepositoryParams struct {
+	local.ConanRepoParams
+	Members []Member `hcl:"member" json:"members"`
+	RepoParams
 }
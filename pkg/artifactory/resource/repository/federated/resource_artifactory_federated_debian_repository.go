@@ -15,76 +15,113 @@
 package federated
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	"github.com/jfrog/terraform-provider-shared/predicate"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
-type DebianFederatedRepositoryParams struct {
-	local.DebianLocalRepositoryParams
-	Members []Member `hcl:"member" json:"members"`
-	RepoParams
+func NewDebianFederatedRepositoryResource() resource.Resource {
+	return &federatedDebianResource{
+		federatedResource: NewFederatedRepositoryResource(
+			repository.DebianPackageType,
+			"Debian",
+			reflect.TypeFor[FederatedDebianResourceModel](),
+			reflect.TypeFor[FederatedDebianAPIModel](),
+			local.NewDebianLocalRepositoryResource,
+		),
+	}
 }
 
-func unpackLocalDebianRepository(data *schema.ResourceData, Rclass string) local.DebianLocalRepositoryParams {
-	d := &utilsdk.ResourceData{ResourceData: data}
-	return local.DebianLocalRepositoryParams{
-		PrimaryKeyPairRefParam: repository.PrimaryKeyPairRefParam{
-			PrimaryKeyPairRefSDKv2: d.GetString("primary_keypair_ref", false),
-		},
-		SecondaryKeyPairRefParam: repository.SecondaryKeyPairRefParam{
-			SecondaryKeyPairRefSDKv2: d.GetString("secondary_keypair_ref", false),
-		},
-		RepositoryBaseParams:    local.UnpackBaseRepo(Rclass, data, repository.DebianPackageType),
-		TrivialLayout:           d.GetBool("trivial_layout", false),
-		IndexCompressionFormats: d.GetSet("index_compression_formats"),
-	}
+type federatedDebianResource struct {
+	federatedResource
 }
 
-func ResourceArtifactoryFederatedDebianRepository() *schema.Resource {
-	debianFederatedSchema := utilsdk.MergeMaps(
-		local.DebianSchemas[local.CurrentSchemaVersion],
-		federatedSchemaV4,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, repository.DebianPackageType),
-	)
-
-	var unpackFederatedDebianRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := DebianFederatedRepositoryParams{
-			DebianLocalRepositoryParams: unpackLocalDebianRepository(data, Rclass),
-			Members:                     unpackMembers(data),
-			RepoParams:                  unpackRepoParams(data),
-		}
-		return repo, repo.Id(), nil
-	}
+type FederatedDebianResourceModel struct {
+	local.LocalDebianResourceModel
+	FederatedResourceModel
+}
 
-	var packDebianMembers = func(repo interface{}, d *schema.ResourceData) error {
-		members := repo.(*DebianFederatedRepositoryParams).Members
-		return PackMembers(members, d)
+func (r *FederatedDebianResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r FederatedDebianResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedDebianResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedDebianResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedDebianResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *FederatedDebianResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedDebianResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r FederatedDebianResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model, d := r.LocalDebianResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	pkr := packer.Compose(
-		packer.Universal(
-			predicate.All(
-				predicate.NoClass,
-				predicate.Ignore("member", "terraform_type"),
-			),
-		),
-		packDebianMembers,
-	)
-
-	constructor := func() (interface{}, error) {
-		return &DebianFederatedRepositoryParams{
-			DebianLocalRepositoryParams: local.DebianLocalRepositoryParams{
-				RepositoryBaseParams: local.RepositoryBaseParams{
-					PackageType: repository.DebianPackageType,
-					Rclass:      Rclass,
-				},
-			},
-		}, nil
+	localAPIModel := model.(local.LocalDebianAPIModel)
+	localAPIModel.Rclass = Rclass
+
+	federatedAPIModel, d := r.FederatedResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return mkResourceSchema(debianFederatedSchema, pkr, unpackFederatedDebianRepository, constructor)
+	return FederatedDebianAPIModel{
+		LocalDebianAPIModel: localAPIModel,
+		FederatedAPIModel:   federatedAPIModel,
+	}, diags
+}
+
+func (r *FederatedDebianResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*FederatedDebianAPIModel)
+
+	diags.Append(r.LocalDebianResourceModel.FromAPIModel(ctx, &model.LocalDebianAPIModel)...)
+	diags.Append(r.FederatedResourceModel.FromAPIModel(ctx, model.FederatedAPIModel)...)
+
+	return diags
+}
+
+type FederatedDebianAPIModel struct {
+	local.LocalDebianAPIModel
+	FederatedAPIModel
+}
+
+// SDKv2
+
+type DebianFederatedRepositoryParams struct {
+	local.DebianLocalRepositoryParams
+	Members []Member `hcl:"member" json:"members"`
+	RepoParams
 }
@@ -15,125 +15,224 @@
 package federated
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	"github.com/jfrog/terraform-provider-shared/predicate"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
-	"github.com/samber/lo"
 )
 
-type DockerFederatedRepositoryParams struct {
-	local.DockerLocalRepositoryParams
-	Members []Member `hcl:"member" json:"members"`
-	RepoParams
+func NewDockerV1FederatedRepositoryResource() resource.Resource {
+	return newDockerFederatedRepositoryResource(
+		"v1",
+		"Docker V1",
+		reflect.TypeFor[FederatedDockerV1ResourceModel](),
+		reflect.TypeFor[FederatedDockerV1APIModel](),
+		local.NewDockerV1LocalRepositoryResource,
+	)
 }
 
-func unpackLocalDockerV2Repository(data *schema.ResourceData, Rclass string) local.DockerLocalRepositoryParams {
-	d := &utilsdk.ResourceData{ResourceData: data}
-	return local.DockerLocalRepositoryParams{
-		RepositoryBaseParams: local.UnpackBaseRepo(Rclass, data, repository.DockerPackageType),
-		MaxUniqueTags:        d.GetInt("max_unique_tags", false),
-		DockerApiVersion:     "V2",
-		TagRetention:         d.GetInt("tag_retention", false),
-		BlockPushingSchema1:  d.GetBool("block_pushing_schema1", false),
-	}
+func NewDockerV2FederatedRepositoryResource() resource.Resource {
+	return newDockerFederatedRepositoryResource(
+		"v2",
+		"Docker V2",
+		reflect.TypeFor[FederatedDockerV2ResourceModel](),
+		reflect.TypeFor[FederatedDockerV2APIModel](),
+		local.NewDockerV2LocalRepositoryResource,
+	)
 }
 
-func ResourceArtifactoryFederatedDockerV2Repository() *schema.Resource {
-	dockerV2FederatedSchema := lo.Assign(
-		local.DockerV2Schemas[local.CurrentSchemaVersion],
-		federatedSchemaV4,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, repository.DockerPackageType),
-	)
+// NewDockerFederatedRepositoryResource is the `artifactory_federated_docker_repository` alias of the Docker V2
+// resource, kept for backward compatibility.
+func NewDockerFederatedRepositoryResource() resource.Resource {
+	r := NewDockerV2FederatedRepositoryResource().(*federatedDockerResource)
+	r.TypeName = fmt.Sprintf("artifactory_%s_%s_repository", Rclass, repository.DockerPackageType)
 
-	var unpackFederatedDockerRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := DockerFederatedRepositoryParams{
-			DockerLocalRepositoryParams: unpackLocalDockerV2Repository(data, Rclass),
-			Members:                     unpackMembers(data),
-			RepoParams:                  unpackRepoParams(data),
-		}
-		return repo, repo.Id(), nil
+	return r
+}
+
+func newDockerFederatedRepositoryResource(apiVersion, packageName string, resourceModelType, apiModelType reflect.Type, localResource func() resource.Resource) resource.Resource {
+	r := &federatedDockerResource{
+		federatedResource: NewFederatedRepositoryResource(
+			repository.DockerPackageType,
+			packageName,
+			resourceModelType,
+			apiModelType,
+			localResource,
+		),
 	}
+	r.TypeName = fmt.Sprintf("artifactory_%s_%s_%s_repository", Rclass, repository.DockerPackageType, apiVersion)
 
-	var packDockerMembers = func(repo interface{}, d *schema.ResourceData) error {
-		members := repo.(*DockerFederatedRepositoryParams).Members
-		return PackMembers(members, d)
+	return r
+}
+
+type federatedDockerResource struct {
+	federatedResource
+}
+
+type FederatedDockerV1ResourceModel struct {
+	local.LocalDockerV1ResourceModel
+	FederatedResourceModel
+}
+
+func (r *FederatedDockerV1ResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r FederatedDockerV1ResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedDockerV1ResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedDockerV1ResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedDockerV1ResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *FederatedDockerV1ResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedDockerV1ResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r FederatedDockerV1ResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model, d := r.LocalDockerV1ResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	pkr := packer.Compose(
-		packer.Universal(
-			predicate.All(
-				predicate.NoClass,
-				predicate.Ignore("member", "terraform_type"),
-			),
-		),
-		packDockerMembers,
-	)
+	localAPIModel := model.(local.LocalDockerV1APIModel)
+	localAPIModel.Rclass = Rclass
 
-	constructor := func() (interface{}, error) {
-		return &DockerFederatedRepositoryParams{
-			DockerLocalRepositoryParams: local.DockerLocalRepositoryParams{
-				RepositoryBaseParams: local.RepositoryBaseParams{
-					PackageType: repository.DockerPackageType,
-					Rclass:      Rclass,
-				},
-			},
-		}, nil
+	federatedAPIModel, d := r.FederatedResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return mkResourceSchema(dockerV2FederatedSchema, pkr, unpackFederatedDockerRepository, constructor)
+	return FederatedDockerV1APIModel{
+		LocalDockerV1APIModel: localAPIModel,
+		FederatedAPIModel:     federatedAPIModel,
+	}, diags
 }
 
-func unpackLocalDockerV1Repository(data *schema.ResourceData, Rclass string) local.DockerLocalRepositoryParams {
-	return local.DockerLocalRepositoryParams{
-		RepositoryBaseParams: local.UnpackBaseRepo(Rclass, data, repository.DockerPackageType),
-		DockerApiVersion:     "V1",
-		MaxUniqueTags:        0,
-		TagRetention:         1,
-		BlockPushingSchema1:  false,
-	}
+func (r *FederatedDockerV1ResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*FederatedDockerV1APIModel)
+
+	diags.Append(r.LocalDockerV1ResourceModel.FromAPIModel(ctx, &model.LocalDockerV1APIModel)...)
+	diags.Append(r.FederatedResourceModel.FromAPIModel(ctx, model.FederatedAPIModel)...)
+
+	return diags
 }
 
-func ResourceArtifactoryFederatedDockerV1Repository() *schema.Resource {
-	dockerFederatedSchema := lo.Assign(
-		local.DockerV1Schemas[local.CurrentSchemaVersion],
-		federatedSchemaV4,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, repository.DockerPackageType),
-	)
+type FederatedDockerV1APIModel struct {
+	local.LocalDockerV1APIModel
+	FederatedAPIModel
+}
 
-	var unpackFederatedDockerRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := DockerFederatedRepositoryParams{
-			DockerLocalRepositoryParams: unpackLocalDockerV1Repository(data, Rclass),
-			Members:                     unpackMembers(data),
-			RepoParams:                  unpackRepoParams(data),
-		}
-		return repo, repo.Id(), nil
-	}
+type FederatedDockerV2ResourceModel struct {
+	local.LocalDockerV2ResourceModel
+	FederatedResourceModel
+}
+
+func (r *FederatedDockerV2ResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r FederatedDockerV2ResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
 
-	var packDockerMembers = func(repo interface{}, d *schema.ResourceData) error {
-		members := repo.(*DockerFederatedRepositoryParams).Members
-		return PackMembers(members, d)
+func (r *FederatedDockerV2ResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedDockerV2ResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedDockerV2ResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *FederatedDockerV2ResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedDockerV2ResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r FederatedDockerV2ResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model, d := r.LocalDockerV2ResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	pkr := packer.Compose(
-		packer.Universal(
-			predicate.Ignore("class", "rclass", "member", "terraform_type"),
-		),
-		packDockerMembers,
-	)
+	localAPIModel := model.(local.LocalDockerV2APIModel)
+	localAPIModel.Rclass = Rclass
 
-	constructor := func() (interface{}, error) {
-		return &DockerFederatedRepositoryParams{
-			DockerLocalRepositoryParams: local.DockerLocalRepositoryParams{
-				RepositoryBaseParams: local.RepositoryBaseParams{
-					PackageType: repository.DockerPackageType,
-					Rclass:      Rclass,
-				},
-			},
-		}, nil
+	federatedAPIModel, d := r.FederatedResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return mkResourceSchema(dockerFederatedSchema, pkr, unpackFederatedDockerRepository, constructor)
+	return FederatedDockerV2APIModel{
+		LocalDockerV2APIModel: localAPIModel,
+		FederatedAPIModel:     federatedAPIModel,
+	}, diags
+}
+
+func (r *FederatedDockerV2ResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*FederatedDockerV2APIModel)
+
+	diags.Append(r.LocalDockerV2ResourceModel.FromAPIModel(ctx, &model.LocalDockerV2APIModel)...)
+	diags.Append(r.FederatedResourceModel.FromAPIModel(ctx, model.FederatedAPIModel)...)
+
+	return diags
+}
+
+type FederatedDockerV2APIModel struct {
+	local.LocalDockerV2APIModel
+	FederatedAPIModel
+}
+
+// SDKv2
+
+type DockerFederatedRepositoryParams struct {
+	local.DockerLocalRepositoryParams
+	Members []Member `hcl:"member" json:"members"`
+	RepoParams
 }
@@ -15,59 +15,115 @@
 package federated
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	"github.com/jfrog/terraform-provider-shared/predicate"
-	"github.com/samber/lo"
 )
 
-type GenericRepositoryParams struct {
-	local.RepositoryBaseParams
-	Members []Member `hcl:"member" json:"members"`
-	RepoParams
-}
-
-func ResourceArtifactoryFederatedGenericRepository(packageType string) *schema.Resource {
-	var genericSchema = lo.Assign(
-		local.GetGenericSchemas(packageType)[local.CurrentSchemaVersion],
-		federatedSchemaV4,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, packageType),
-	)
-
-	var unpackFederatedRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := GenericRepositoryParams{
-			RepositoryBaseParams: local.UnpackBaseRepo(Rclass, data, packageType),
-			Members:              unpackMembers(data),
-			RepoParams:           unpackRepoParams(data),
+func NewGenericFederatedRepositoryResource(packageType string) func() resource.Resource {
+	return func() resource.Resource {
+		return &federatedGenericResource{
+			federatedResource: NewFederatedRepositoryResource(
+				packageType,
+				repository.PackageNameLookup[packageType],
+				reflect.TypeFor[FederatedGenericResourceModel](),
+				reflect.TypeFor[FederatedGenericAPIModel](),
+				local.NewGenericLocalRepositoryResource(packageType),
+			),
 		}
-		return repo, repo.Id(), nil
 	}
+}
+
+type federatedGenericResource struct {
+	federatedResource
+}
+
+type FederatedGenericResourceModel struct {
+	local.LocalGenericResourceModel
+	FederatedResourceModel
+}
+
+func (r *FederatedGenericResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r FederatedGenericResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedGenericResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedGenericResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedGenericResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *FederatedGenericResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedGenericResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r FederatedGenericResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
 
-	var packGenericMembers = func(repo interface{}, d *schema.ResourceData) error {
-		members := repo.(*GenericRepositoryParams).Members
-		return PackMembers(members, d)
+	model, d := r.LocalGenericResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	pkr := packer.Compose(
-		packer.Universal(
-			predicate.All(
-				predicate.NoClass,
-				predicate.Ignore("member", "terraform_type"),
-			),
-		),
-		packGenericMembers,
-	)
-
-	constructor := func() (interface{}, error) {
-		return &GenericRepositoryParams{
-			RepositoryBaseParams: local.RepositoryBaseParams{
-				PackageType: local.GetPackageType(packageType),
-				Rclass:      Rclass,
-			},
-		}, nil
+	localAPIModel := model.(local.LocalGenericAPIModel)
+	localAPIModel.Rclass = Rclass
+
+	federatedAPIModel, d := r.FederatedResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return mkResourceSchema(genericSchema, pkr, unpackFederatedRepository, constructor)
+	return FederatedGenericAPIModel{
+		LocalGenericAPIModel: localAPIModel,
+		FederatedAPIModel:    federatedAPIModel,
+	}, diags
+}
+
+func (r *FederatedGenericResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*FederatedGenericAPIModel)
+
+	diags.Append(r.LocalGenericResourceModel.FromAPIModel(ctx, &model.LocalGenericAPIModel)...)
+	diags.Append(r.FederatedResourceModel.FromAPIModel(ctx, model.FederatedAPIModel)...)
+
+	return diags
+}
+
+type FederatedGenericAPIModel struct {
+	local.LocalGenericAPIModel
+	FederatedAPIModel
+}
+
+// SDKv2
+
+type GenericRepositoryParams struct {
+	local.RepositoryBaseParams
+	Members []Member `hcl:"member" json:"members"`
+	RepoParams
 }
@@ -15,71 +15,113 @@
 package federated
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	"github.com/jfrog/terraform-provider-shared/predicate"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
-	"github.com/samber/lo"
 )
 
-type HelmOciFederatedRepositoryParams struct {
-	local.HelmOciLocalRepositoryParams
-	Members []Member `hcl:"member" json:"members"`
-	RepoParams
+func NewHelmOCIFederatedRepositoryResource() resource.Resource {
+	return &federatedHelmOCIResource{
+		federatedResource: NewFederatedRepositoryResource(
+			repository.HelmOCIPackageType,
+			"Helm OCI",
+			reflect.TypeFor[FederatedHelmOCIResourceModel](),
+			reflect.TypeFor[FederatedHelmOCIAPIModel](),
+			local.NewHelmOCILocalRepositoryResource,
+		),
+	}
 }
 
-func unpackLocalHelmOciRepository(data *schema.ResourceData, Rclass string) local.HelmOciLocalRepositoryParams {
-	d := &utilsdk.ResourceData{ResourceData: data}
-	return local.HelmOciLocalRepositoryParams{
-		RepositoryBaseParams: local.UnpackBaseRepo(Rclass, data, repository.HelmOCIPackageType),
-		MaxUniqueTags:        d.GetInt("max_unique_tags", false),
-		TagRetention:         d.GetInt("tag_retention", false),
-	}
+type federatedHelmOCIResource struct {
+	federatedResource
 }
 
-func ResourceArtifactoryFederatedHelmOciRepository() *schema.Resource {
-	helmociSchema := lo.Assign(
-		local.HelmOCISchemas[local.CurrentSchemaVersion],
-		federatedSchemaV4,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, repository.HelmOCIPackageType),
-	)
-
-	var unpackRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := HelmOciFederatedRepositoryParams{
-			HelmOciLocalRepositoryParams: unpackLocalHelmOciRepository(data, Rclass),
-			Members:                      unpackMembers(data),
-			RepoParams:                   unpackRepoParams(data),
-		}
-		return repo, repo.Id(), nil
-	}
+type FederatedHelmOCIResourceModel struct {
+	local.LocalHelmOCIResourceModel
+	FederatedResourceModel
+}
 
-	var packMembers = func(repo interface{}, d *schema.ResourceData) error {
-		members := repo.(*HelmOciFederatedRepositoryParams).Members
-		return PackMembers(members, d)
+func (r *FederatedHelmOCIResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r FederatedHelmOCIResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedHelmOCIResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedHelmOCIResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedHelmOCIResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *FederatedHelmOCIResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedHelmOCIResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r FederatedHelmOCIResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model, d := r.LocalHelmOCIResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	pkr := packer.Compose(
-		packer.Universal(
-			predicate.All(
-				predicate.NoClass,
-				predicate.Ignore("member", "terraform_type"),
-			),
-		),
-		packMembers,
-	)
-
-	constructor := func() (interface{}, error) {
-		return &HelmOciFederatedRepositoryParams{
-			HelmOciLocalRepositoryParams: local.HelmOciLocalRepositoryParams{
-				RepositoryBaseParams: local.RepositoryBaseParams{
-					PackageType: repository.HelmOCIPackageType,
-					Rclass:      Rclass,
-				},
-			},
-		}, nil
+	localAPIModel := model.(local.LocalHelmOCIAPIModel)
+	localAPIModel.Rclass = Rclass
+
+	federatedAPIModel, d := r.FederatedResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return mkResourceSchema(helmociSchema, pkr, unpackRepository, constructor)
+	return FederatedHelmOCIAPIModel{
+		LocalHelmOCIAPIModel: localAPIModel,
+		FederatedAPIModel:    federatedAPIModel,
+	}, diags
+}
+
+func (r *FederatedHelmOCIResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*FederatedHelmOCIAPIModel)
+
+	diags.Append(r.LocalHelmOCIResourceModel.FromAPIModel(ctx, &model.LocalHelmOCIAPIModel)...)
+	diags.Append(r.FederatedResourceModel.FromAPIModel(ctx, model.FederatedAPIModel)...)
+
+	return diags
+}
+
+type FederatedHelmOCIAPIModel struct {
+	local.LocalHelmOCIAPIModel
+	FederatedAPIModel
+}
+
+// SDKv2
+
+type HelmOciFederatedRepositoryParams struct {
+	local.HelmOciLocalRepositoryParams
+	Members []Member `hcl:"member" json:"members"`
+	RepoParams
 }
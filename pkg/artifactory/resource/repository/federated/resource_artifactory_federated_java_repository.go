@@ -15,78 +15,117 @@
 package federated
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	"github.com/jfrog/terraform-provider-shared/predicate"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
-	"github.com/samber/lo"
 )
 
-type JavaFederatedRepositoryParams struct {
-	local.JavaLocalRepositoryParams
-	Members []Member `hcl:"member" json:"members"`
-	RepoParams
+func NewJavaFederatedRepositoryResource(packageType string, suppressPom bool) func() resource.Resource {
+	return func() resource.Resource {
+		return &federatedJavaResource{
+			federatedResource: NewFederatedRepositoryResource(
+				packageType,
+				repository.PackageNameLookup[packageType],
+				reflect.TypeFor[FederatedJavaResourceModel](),
+				reflect.TypeFor[FederatedJavaAPIModel](),
+				func() resource.Resource {
+					return local.NewJavaLocalRepositoryResource(packageType, suppressPom)()
+				},
+			),
+		}
+	}
 }
 
-var unpackLocalJavaRepository = func(data *schema.ResourceData, Rclass string, packageType string) local.JavaLocalRepositoryParams {
-	d := &utilsdk.ResourceData{ResourceData: data}
-	return local.JavaLocalRepositoryParams{
-		RepositoryBaseParams:         local.UnpackBaseRepo(Rclass, data, packageType),
-		ChecksumPolicyType:           d.GetString("checksum_policy_type", false),
-		SnapshotVersionBehavior:      d.GetString("snapshot_version_behavior", false),
-		MaxUniqueSnapshots:           d.GetInt("max_unique_snapshots", false),
-		HandleReleases:               d.GetBool("handle_releases", false),
-		HandleSnapshots:              d.GetBool("handle_snapshots", false),
-		SuppressPomConsistencyChecks: d.GetBool("suppress_pom_consistency_checks", false),
-	}
+type federatedJavaResource struct {
+	federatedResource
 }
 
-func ResourceArtifactoryFederatedJavaRepository(packageType string, suppressPom bool) *schema.Resource {
+type FederatedJavaResourceModel struct {
+	local.LocalJavaResourceModel
+	FederatedResourceModel
+}
 
-	javaFederatedSchema := lo.Assign(
-		local.GetJavaSchemas(packageType, suppressPom)[local.CurrentSchemaVersion],
-		federatedSchemaV4,
-		repository.RepoLayoutRefSDKv2Schema("federated", packageType),
-	)
+func (r *FederatedJavaResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
 
-	var unpackFederatedJavaRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := JavaFederatedRepositoryParams{
-			JavaLocalRepositoryParams: unpackLocalJavaRepository(data, Rclass, packageType),
-			Members:                   unpackMembers(data),
-			RepoParams:                unpackRepoParams(data),
-		}
+func (r FederatedJavaResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
 
-		return repo, repo.Id(), nil
-	}
+func (r *FederatedJavaResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedJavaResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedJavaResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *FederatedJavaResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedJavaResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
 
-	var packJavaMembers = func(repo interface{}, d *schema.ResourceData) error {
-		members := repo.(*JavaFederatedRepositoryParams).Members
-		return PackMembers(members, d)
+func (r FederatedJavaResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model, d := r.LocalJavaResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	pkr := packer.Compose(
-		packer.Universal(
-			predicate.All(
-				predicate.NoClass,
-				predicate.Ignore("member", "terraform_type"),
-			),
-		),
-		packJavaMembers,
-	)
-
-	constructor := func() (interface{}, error) {
-		return &JavaFederatedRepositoryParams{
-			JavaLocalRepositoryParams: local.JavaLocalRepositoryParams{
-				RepositoryBaseParams: local.RepositoryBaseParams{
-					PackageType: packageType,
-					Rclass:      Rclass,
-				},
-				SuppressPomConsistencyChecks: suppressPom,
-			},
-		}, nil
+	localAPIModel := model.(local.LocalJavaAPIModel)
+	localAPIModel.Rclass = Rclass
+
+	federatedAPIModel, d := r.FederatedResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return mkResourceSchema(javaFederatedSchema, pkr, unpackFederatedJavaRepository, constructor)
+	return FederatedJavaAPIModel{
+		LocalJavaAPIModel: localAPIModel,
+		FederatedAPIModel: federatedAPIModel,
+	}, diags
+}
+
+func (r *FederatedJavaResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*FederatedJavaAPIModel)
+
+	diags.Append(r.LocalJavaResourceModel.FromAPIModel(ctx, &model.LocalJavaAPIModel)...)
+	diags.Append(r.FederatedResourceModel.FromAPIModel(ctx, model.FederatedAPIModel)...)
+
+	return diags
+}
+
+type FederatedJavaAPIModel struct {
+	local.LocalJavaAPIModel
+	FederatedAPIModel
+}
+
+// SDKv2
+
+type JavaFederatedRepositoryParams struct {
+	local.JavaLocalRepositoryParams
+	Members []Member `hcl:"member" json:"members"`
+	RepoParams
 }
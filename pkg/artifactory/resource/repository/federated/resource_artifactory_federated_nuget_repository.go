@@ -15,71 +15,113 @@
 package federated
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	"github.com/jfrog/terraform-provider-shared/predicate"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
-	"github.com/samber/lo"
 )
 
-type NugetFederatedRepositoryParams struct {
-	local.NugetLocalRepositoryParams
-	Members []Member `hcl:"member" json:"members"`
-	RepoParams
+func NewNugetFederatedRepositoryResource() resource.Resource {
+	return &federatedNugetResource{
+		federatedResource: NewFederatedRepositoryResource(
+			repository.NugetPackageType,
+			"Nuget",
+			reflect.TypeFor[FederatedNugetResourceModel](),
+			reflect.TypeFor[FederatedNugetAPIModel](),
+			local.NewNugetLocalRepositoryResource,
+		),
+	}
 }
 
-func unpackLocalNugetRepository(data *schema.ResourceData, Rclass string) local.NugetLocalRepositoryParams {
-	d := &utilsdk.ResourceData{ResourceData: data}
-	return local.NugetLocalRepositoryParams{
-		RepositoryBaseParams:     local.UnpackBaseRepo(Rclass, data, repository.NugetPackageType),
-		MaxUniqueSnapshots:       d.GetInt("max_unique_snapshots", false),
-		ForceNugetAuthentication: d.GetBool("force_nuget_authentication", false),
-	}
+type federatedNugetResource struct {
+	federatedResource
 }
 
-func ResourceArtifactoryFederatedNugetRepository() *schema.Resource {
-	nugetFederatedSchema := lo.Assign(
-		local.NugetSchemas[local.CurrentSchemaVersion],
-		federatedSchemaV4,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, repository.NugetPackageType),
-	)
-
-	var unpackFederatedNugetRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := NugetFederatedRepositoryParams{
-			NugetLocalRepositoryParams: unpackLocalNugetRepository(data, Rclass),
-			Members:                    unpackMembers(data),
-			RepoParams:                 unpackRepoParams(data),
-		}
-		return repo, repo.Id(), nil
-	}
+type FederatedNugetResourceModel struct {
+	local.LocalNugetResourceModel
+	FederatedResourceModel
+}
 
-	var packNugetMembers = func(repo interface{}, d *schema.ResourceData) error {
-		members := repo.(*NugetFederatedRepositoryParams).Members
-		return PackMembers(members, d)
+func (r *FederatedNugetResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r FederatedNugetResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedNugetResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedNugetResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedNugetResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *FederatedNugetResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedNugetResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r FederatedNugetResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model, d := r.LocalNugetResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	pkr := packer.Compose(
-		packer.Universal(
-			predicate.All(
-				predicate.NoClass,
-				predicate.Ignore("member", "terraform_type"),
-			),
-		),
-		packNugetMembers,
-	)
-
-	constructor := func() (interface{}, error) {
-		return &NugetFederatedRepositoryParams{
-			NugetLocalRepositoryParams: local.NugetLocalRepositoryParams{
-				RepositoryBaseParams: local.RepositoryBaseParams{
-					PackageType: repository.NugetPackageType,
-					Rclass:      Rclass,
-				},
-			},
-		}, nil
+	localAPIModel := model.(local.LocalNugetAPIModel)
+	localAPIModel.Rclass = Rclass
+
+	federatedAPIModel, d := r.FederatedResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return mkResourceSchema(nugetFederatedSchema, pkr, unpackFederatedNugetRepository, constructor)
+	return FederatedNugetAPIModel{
+		LocalNugetAPIModel: localAPIModel,
+		FederatedAPIModel:  federatedAPIModel,
+	}, diags
+}
+
+func (r *FederatedNugetResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*FederatedNugetAPIModel)
+
+	diags.Append(r.LocalNugetResourceModel.FromAPIModel(ctx, &model.LocalNugetAPIModel)...)
+	diags.Append(r.FederatedResourceModel.FromAPIModel(ctx, model.FederatedAPIModel)...)
+
+	return diags
+}
+
+type FederatedNugetAPIModel struct {
+	local.LocalNugetAPIModel
+	FederatedAPIModel
+}
+
+// SDKv2
+
+type NugetFederatedRepositoryParams struct {
+	local.NugetLocalRepositoryParams
+	Members []Member `hcl:"member" json:"members"`
+	RepoParams
 }
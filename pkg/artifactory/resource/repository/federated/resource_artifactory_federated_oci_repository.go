@@ -15,72 +15,113 @@
 package federated
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	"github.com/jfrog/terraform-provider-shared/predicate"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
-	"github.com/samber/lo"
 )
 
-type OciFederatedRepositoryParams struct {
-	local.OciLocalRepositoryParams
-	Members []Member `hcl:"member" json:"members"`
-	RepoParams
+func NewOCIFederatedRepositoryResource() resource.Resource {
+	return &federatedOCIResource{
+		federatedResource: NewFederatedRepositoryResource(
+			repository.OCIPackageType,
+			"OCI",
+			reflect.TypeFor[FederatedOCIResourceModel](),
+			reflect.TypeFor[FederatedOCIAPIModel](),
+			local.NewOCILocalRepositoryResource,
+		),
+	}
 }
 
-func unpackLocalOciRepository(data *schema.ResourceData, Rclass string) local.OciLocalRepositoryParams {
-	d := &utilsdk.ResourceData{ResourceData: data}
-	return local.OciLocalRepositoryParams{
-		RepositoryBaseParams: local.UnpackBaseRepo(Rclass, data, repository.OCIPackageType),
-		MaxUniqueTags:        d.GetInt("max_unique_tags", false),
-		DockerApiVersion:     "V2",
-		TagRetention:         d.GetInt("tag_retention", false),
-	}
+type federatedOCIResource struct {
+	federatedResource
 }
 
-func ResourceArtifactoryFederatedOciRepository() *schema.Resource {
-	ociFederatedSchema := lo.Assign(
-		local.OCILocalSchemas[local.CurrentSchemaVersion],
-		federatedSchemaV4,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, repository.OCIPackageType),
-	)
-
-	var unpackFederatedOciRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := OciFederatedRepositoryParams{
-			OciLocalRepositoryParams: unpackLocalOciRepository(data, Rclass),
-			Members:                  unpackMembers(data),
-			RepoParams:               unpackRepoParams(data),
-		}
-		return repo, repo.Id(), nil
-	}
+type FederatedOCIResourceModel struct {
+	local.LocalOCIResourceModel
+	FederatedResourceModel
+}
 
-	var packOciMembers = func(repo interface{}, d *schema.ResourceData) error {
-		members := repo.(*OciFederatedRepositoryParams).Members
-		return PackMembers(members, d)
+func (r *FederatedOCIResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r FederatedOCIResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedOCIResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedOCIResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedOCIResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *FederatedOCIResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedOCIResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r FederatedOCIResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model, d := r.LocalOCIResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	pkr := packer.Compose(
-		packer.Universal(
-			predicate.All(
-				predicate.NoClass,
-				predicate.Ignore("member", "terraform_type", "docker_api_version"),
-			),
-		),
-		packOciMembers,
-	)
-
-	constructor := func() (interface{}, error) {
-		return &OciFederatedRepositoryParams{
-			OciLocalRepositoryParams: local.OciLocalRepositoryParams{
-				RepositoryBaseParams: local.RepositoryBaseParams{
-					PackageType: repository.OCIPackageType,
-					Rclass:      Rclass,
-				},
-			},
-		}, nil
+	localAPIModel := model.(local.LocalOCIAPIModel)
+	localAPIModel.Rclass = Rclass
+
+	federatedAPIModel, d := r.FederatedResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return mkResourceSchema(ociFederatedSchema, pkr, unpackFederatedOciRepository, constructor)
+	return FederatedOCIAPIModel{
+		LocalOCIAPIModel:  localAPIModel,
+		FederatedAPIModel: federatedAPIModel,
+	}, diags
+}
+
+func (r *FederatedOCIResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*FederatedOCIAPIModel)
+
+	diags.Append(r.LocalOCIResourceModel.FromAPIModel(ctx, &model.LocalOCIAPIModel)...)
+	diags.Append(r.FederatedResourceModel.FromAPIModel(ctx, model.FederatedAPIModel)...)
+
+	return diags
+}
+
+type FederatedOCIAPIModel struct {
+	local.LocalOCIAPIModel
+	FederatedAPIModel
+}
+
+// SDKv2
+
+type OciFederatedRepositoryParams struct {
+	local.OciLocalRepositoryParams
+	Members []Member `hcl:"member" json:"members"`
+	RepoParams
 }
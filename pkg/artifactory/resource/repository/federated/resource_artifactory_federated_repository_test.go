@@ -24,10 +24,10 @@ import (
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/federated"
-	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/security"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
@@ -83,6 +83,9 @@ func TestAccFederatedRepoWithMembers(t *testing.T) {
 			description = "Test federated repo for {{ .name }}"
 			notes       = "Test federated repo for {{ .name }}"
 
+			full_sync_new_members    = true
+			wait_for_healthy_members = true
+
 			member {
 				url     = "{{ .member1Url }}"
 				enabled = true
@@ -106,6 +109,7 @@ func TestAccFederatedRepoWithMembers(t *testing.T) {
 					resource.TestCheckResourceAttr(fqrn, "member.#", "1"),
 					resource.TestCheckResourceAttr(fqrn, "member.0.url", federatedMember1Url),
 					resource.TestCheckResourceAttr(fqrn, "member.0.enabled", "true"),
+					resource.TestCheckResourceAttr(fqrn, "member_status.#", "0"),
 				),
 			},
 			{
@@ -116,6 +120,10 @@ func TestAccFederatedRepoWithMembers(t *testing.T) {
 					resource.TestCheckResourceAttr(fqrn, "member.0.enabled", "true"),
 					resource.TestCheckResourceAttr(fqrn, "member.1.url", federatedMember1Url),
 					resource.TestCheckResourceAttr(fqrn, "member.1.enabled", "true"),
+					resource.TestCheckResourceAttr(fqrn, "member_status.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "member_status.0.url", federatedMember2Url),
+					resource.TestCheckResourceAttr(fqrn, "member_status.0.status", "HEALTHY"),
+					resource.TestCheckResourceAttrSet(fqrn, "member_status.0.lag_in_ms"),
 				),
 			},
 			{
@@ -123,7 +131,7 @@ func TestAccFederatedRepoWithMembers(t *testing.T) {
 				ImportState:             true,
 				ImportStateVerify:       true,
 				ImportStateCheck:        validator.CheckImportState(name, "key"),
-				ImportStateVerifyIgnore: []string{"cleanup_on_delete", "member.0.access_token", "member.1.access_token"},
+				ImportStateVerifyIgnore: []string{"cleanup_on_delete", "full_sync_new_members", "wait_for_healthy_members", "member.0.access_token", "member.1.access_token", "member_status.0.lag_in_ms"},
 			},
 		},
 	})
@@ -151,8 +159,6 @@ func genericTestCase(repoType string, t *testing.T) (*testing.T, resource.TestCa
 		"disableProxy": false,
 	}
 
-	repoTypeAdjusted := local.GetPackageType(repoType)
-
 	// Default proxy will be assigned to the repository no matter what, and it's impossible to remove it by submitting an empty string or
 	// removing the attribute. If `disable_proxy` is set to true, then both repo and default proxies are removed and not returned in the
 	// GET body.
@@ -217,7 +223,6 @@ func genericTestCase(repoType string, t *testing.T) (*testing.T, resource.TestCa
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", repoTypeAdjusted),
 					resource.TestCheckResourceAttr(fqrn, "description", fmt.Sprintf("Test federated repo for %s", name)),
 					resource.TestCheckResourceAttr(fqrn, "notes", fmt.Sprintf("Test federated repo for %s", name)),
 					resource.TestCheckResourceAttr(fqrn, "xray_index", fmt.Sprintf("%t", xrayIndex)),
@@ -234,7 +239,6 @@ func genericTestCase(repoType string, t *testing.T) (*testing.T, resource.TestCa
 				Config: updatedConfig,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", repoTypeAdjusted),
 					resource.TestCheckResourceAttr(fqrn, "description", fmt.Sprintf("Test federated repo for %s", name)),
 					resource.TestCheckResourceAttr(fqrn, "notes", fmt.Sprintf("Test federated repo for %s", name)),
 					resource.TestCheckResourceAttr(fqrn, "xray_index", fmt.Sprintf("%t", !xrayIndex)),
@@ -466,7 +470,6 @@ EOF
 				Config: federatedRepositoryBasic,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "alpine"),
 					resource.TestCheckResourceAttr(fqrn, "primary_keypair_ref", kpName),
 					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", func() string { r, _ := repository.GetDefaultRepoLayoutRef("federated", "alpine"); return r }()), //Check to ensure repository layout is set as per default even when it is not passed.
 				),
@@ -537,7 +540,6 @@ EOF
 				Config: federatedRepositoryBasic,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "ansible"),
 					resource.TestCheckResourceAttr(fqrn, "primary_keypair_ref", kpName),
 					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", func() string { r, _ := repository.GetDefaultRepoLayoutRef("federated", "ansible"); return r }()), //Check to ensure repository layout is set as per default even when it is not passed.
 				),
@@ -789,7 +791,6 @@ EOF
 				Config: federatedRepositoryBasic,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "debian"),
 					resource.TestCheckResourceAttr(fqrn, "primary_keypair_ref", kpName),
 					resource.TestCheckResourceAttr(fqrn, "secondary_keypair_ref", kpName2),
 					resource.TestCheckResourceAttr(fqrn, "trivial_layout", "true"),
@@ -803,7 +804,6 @@ EOF
 				Config: federatedRepositoryUpdated,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "debian"),
 					resource.TestCheckResourceAttr(fqrn, "primary_keypair_ref", kpName),
 					resource.TestCheckResourceAttr(fqrn, "secondary_keypair_ref", kpName2),
 					resource.TestCheckResourceAttr(fqrn, "trivial_layout", "false"),
@@ -1471,7 +1471,6 @@ EOF
 				Config: federatedRepositoryBasic,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "rpm"),
 					resource.TestCheckResourceAttr(fqrn, "primary_keypair_ref", kpName),
 					resource.TestCheckResourceAttr(fqrn, "secondary_keypair_ref", kpName2),
 					resource.TestCheckResourceAttr(fqrn, "enable_file_lists_indexing", "true"),
@@ -1484,7 +1483,6 @@ EOF
 				Config: federatedRepositoryUpdated,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "rpm"),
 					resource.TestCheckResourceAttr(fqrn, "primary_keypair_ref", kpName),
 					resource.TestCheckResourceAttr(fqrn, "secondary_keypair_ref", kpName2),
 					resource.TestCheckResourceAttr(fqrn, "enable_file_lists_indexing", "false"),
@@ -1537,7 +1535,6 @@ func makeFederatedTerraformRepoTestCase(registryType string, t *testing.T) (*tes
 				Config: federatedRepositoryBasic,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "terraform"),
 					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", func() string {
 						r, _ := repository.GetDefaultRepoLayoutRef("federated", "terraform_"+registryType)
 						return r
@@ -1688,7 +1685,6 @@ func TestAccFederatedReleasebundlesRepository(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(projectFqrn, "key", projectKey),
 					resource.TestCheckResourceAttr(fqrn, "key", repoName),
-					resource.TestCheckResourceAttr(fqrn, "package_type", repository.ReleasebundlesPackageType),
 					resource.TestCheckResourceAttr(fqrn, "description", fmt.Sprintf("Test federated releasebundles repo for %s", repoName)),
 					resource.TestCheckResourceAttr(fqrn, "notes", fmt.Sprintf("Test federated releasebundles repo for %s", repoName)),
 					resource.TestCheckResourceAttr(fqrn, "project_key", projectKey),
@@ -1704,7 +1700,6 @@ func TestAccFederatedReleasebundlesRepository(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(projectFqrn, "key", projectKey),
 					resource.TestCheckResourceAttr(fqrn, "key", repoName),
-					resource.TestCheckResourceAttr(fqrn, "package_type", repository.ReleasebundlesPackageType),
 					resource.TestCheckResourceAttr(fqrn, "project_key", projectKey),
 					resource.TestCheckResourceAttr(fqrn, "project_environments.#", "1"),
 					resource.TestCheckResourceAttr(fqrn, "project_environments.0", projectEnv),
@@ -1725,3 +1720,52 @@ func TestAccFederatedReleasebundlesRepository(t *testing.T) {
 		},
 	})
 }
+
+func TestAccFederatedGenericRepository_UpgradeFromSDKv2(t *testing.T) {
+	name := fmt.Sprintf("federated-generic-%d-full", rand.Int())
+	fqrn := fmt.Sprintf("artifactory_federated_generic_repository.%s", name)
+	federatedMemberUrl := fmt.Sprintf("%s/artifactory/%s", acctest.GetArtifactoryUrl(t), name)
+
+	config := util.ExecuteTemplate("TestAccFederatedGenericRepository_UpgradeFromSDKv2", `
+		resource "artifactory_federated_generic_repository" "{{ .name }}" {
+			key         = "{{ .name }}"
+			description = "Test federated repo for {{ .name }}"
+
+			member {
+				url     = "{{ .memberUrl }}"
+				enabled = true
+			}
+		}
+	`, map[string]interface{}{
+		"name":      name,
+		"memberUrl": federatedMemberUrl,
+	})
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: acctest.VerifyDeleted(t, fqrn, "key", acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"artifactory": {
+						Source:            "jfrog/artifactory",
+						VersionConstraint: "12.11.7",
+					},
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "member.#", "1"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Config:                   config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -15,83 +15,113 @@
 package federated
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	"github.com/jfrog/terraform-provider-shared/predicate"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
-	"github.com/samber/lo"
 )
 
-type RpmFederatedRepositoryParams struct {
-	local.RpmLocalRepositoryParams
-	Members []Member `hcl:"member" json:"members"`
-	RepoParams
+func NewRPMFederatedRepositoryResource() resource.Resource {
+	return &federatedRPMResource{
+		federatedResource: NewFederatedRepositoryResource(
+			repository.RPMPackageType,
+			"RPM",
+			reflect.TypeFor[FederatedRPMResourceModel](),
+			reflect.TypeFor[FederatedRPMAPIModel](),
+			local.NewRPMLocalRepositoryResource,
+		),
+	}
 }
 
-func unpackLocalRpmRepository(data *schema.ResourceData, Rclass string) local.RpmLocalRepositoryParams {
-	d := &utilsdk.ResourceData{ResourceData: data}
-	return local.RpmLocalRepositoryParams{
-		RepositoryBaseParams: local.UnpackBaseRepo(Rclass, data, repository.RPMPackageType),
-		PrimaryKeyPairRefParam: repository.PrimaryKeyPairRefParam{
-			PrimaryKeyPairRefSDKv2: d.GetString("primary_keypair_ref", false),
-		},
-		SecondaryKeyPairRefParam: repository.SecondaryKeyPairRefParam{
-			SecondaryKeyPairRefSDKv2: d.GetString("secondary_keypair_ref", false),
-		},
-		RootDepth:               d.GetInt("yum_root_depth", false),
-		CalculateYumMetadata:    d.GetBool("calculate_yum_metadata", false),
-		EnableFileListsIndexing: d.GetBool("enable_file_lists_indexing", false),
-		GroupFileNames:          d.GetString("yum_group_file_names", false),
-	}
+type federatedRPMResource struct {
+	federatedResource
 }
 
-func ResourceArtifactoryFederatedRpmRepository() *schema.Resource {
-	rpmFederatedSchema := lo.Assign(
-		local.RPMSchemas[local.CurrentSchemaVersion],
-		federatedSchemaV4,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, repository.RPMPackageType),
-	)
-
-	var unpackFederatedRpmRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := RpmFederatedRepositoryParams{
-			RpmLocalRepositoryParams: unpackLocalRpmRepository(data, Rclass),
-			Members:                  unpackMembers(data),
-			RepoParams:               unpackRepoParams(data),
-		}
-		return repo, repo.Id(), nil
-	}
+type FederatedRPMResourceModel struct {
+	local.LocalRPMResourceModel
+	FederatedResourceModel
+}
 
-	var packRpmMembers = func(repo interface{}, d *schema.ResourceData) error {
-		members := repo.(*RpmFederatedRepositoryParams).Members
-		return PackMembers(members, d)
+func (r *FederatedRPMResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r FederatedRPMResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedRPMResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedRPMResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedRPMResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *FederatedRPMResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedRPMResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r FederatedRPMResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model, d := r.LocalRPMResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	pkr := packer.Compose(
-		packer.Universal(
-			predicate.All(
-				predicate.NoClass,
-				predicate.Ignore("member", "terraform_type"),
-			),
-		),
-		packRpmMembers,
-	)
-
-	constructor := func() (interface{}, error) {
-		return &RpmFederatedRepositoryParams{
-			RpmLocalRepositoryParams: local.RpmLocalRepositoryParams{
-				RepositoryBaseParams: local.RepositoryBaseParams{
-					PackageType: repository.RPMPackageType,
-					Rclass:      Rclass,
-				},
-				RootDepth:               0,
-				CalculateYumMetadata:    false,
-				EnableFileListsIndexing: false,
-				GroupFileNames:          "",
-			},
-		}, nil
+	localAPIModel := model.(local.LocalRPMAPIModel)
+	localAPIModel.Rclass = Rclass
+
+	federatedAPIModel, d := r.FederatedResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return mkResourceSchema(rpmFederatedSchema, pkr, unpackFederatedRpmRepository, constructor)
+	return FederatedRPMAPIModel{
+		LocalRPMAPIModel:  localAPIModel,
+		FederatedAPIModel: federatedAPIModel,
+	}, diags
+}
+
+func (r *FederatedRPMResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*FederatedRPMAPIModel)
+
+	diags.Append(r.LocalRPMResourceModel.FromAPIModel(ctx, &model.LocalRPMAPIModel)...)
+	diags.Append(r.FederatedResourceModel.FromAPIModel(ctx, model.FederatedAPIModel)...)
+
+	return diags
+}
+
+type FederatedRPMAPIModel struct {
+	local.LocalRPMAPIModel
+	FederatedAPIModel
+}
+
+// SDKv2
+
+type RpmFederatedRepositoryParams struct {
+	local.RpmLocalRepositoryParams
+	Members []Member `hcl:"member" json:"members"`
+	RepoParams
 }
@@ -15,68 +15,125 @@
 package federated
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/local"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	"github.com/jfrog/terraform-provider-shared/predicate"
-	"github.com/samber/lo"
 )
 
-type TerraformFederatedRepositoryParams struct {
-	local.RepositoryBaseParams
-	Members []Member `hcl:"member" json:"members"`
-	RepoParams
+func NewTerraformModuleFederatedRepositoryResource() resource.Resource {
+	return &federatedTerraformResource{
+		federatedResource: NewFederatedRepositoryResource(
+			repository.TerraformModulePackageType,
+			"Terraform Module",
+			reflect.TypeFor[FederatedTerraformResourceModel](),
+			reflect.TypeFor[FederatedTerraformAPIModel](),
+			local.NewTerraformModuleLocalRepositoryResource,
+		),
+	}
 }
 
-func unpackLocalTerraformRepository(data *schema.ResourceData, Rclass string, registryType string) local.RepositoryBaseParams {
-	repo := local.UnpackBaseRepo(Rclass, data, "terraform_"+registryType)
-	repo.TerraformType = registryType
+func NewTerraformProviderFederatedRepositoryResource() resource.Resource {
+	return &federatedTerraformResource{
+		federatedResource: NewFederatedRepositoryResource(
+			repository.TerraformProviderPackageType,
+			"Terraform Provider",
+			reflect.TypeFor[FederatedTerraformResourceModel](),
+			reflect.TypeFor[FederatedTerraformAPIModel](),
+			local.NewTerraformProviderLocalRepositoryResource,
+		),
+	}
+}
 
-	return repo
+type federatedTerraformResource struct {
+	federatedResource
 }
 
-func ResourceArtifactoryFederatedTerraformRepository(registryType string) *schema.Resource {
-	packageType := "terraform_" + registryType
-
-	terraformFederatedSchema := lo.Assign(
-		local.GetTerraformSchemas(registryType)[local.CurrentSchemaVersion],
-		federatedSchemaV4,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, packageType),
-	)
-
-	var unpackFederatedTerraformRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := TerraformFederatedRepositoryParams{
-			RepositoryBaseParams: unpackLocalTerraformRepository(data, Rclass, registryType),
-			Members:              unpackMembers(data),
-			RepoParams:           unpackRepoParams(data),
-		}
-		return repo, repo.Id(), nil
-	}
+type FederatedTerraformResourceModel struct {
+	local.LocalTerraformResourceModel
+	FederatedResourceModel
+}
+
+func (r *FederatedTerraformResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r FederatedTerraformResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedTerraformResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedTerraformResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *FederatedTerraformResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
 
-	var packTerraformMembers = func(repo interface{}, d *schema.ResourceData) error {
-		members := repo.(*TerraformFederatedRepositoryParams).Members
-		return PackMembers(members, d)
+func (r *FederatedTerraformResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r FederatedTerraformResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r FederatedTerraformResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model, d := r.LocalTerraformResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	pkr := packer.Compose(
-		packer.Universal(
-			predicate.All(
-				predicate.NoClass,
-				predicate.Ignore("member", "terraform_type"),
-			),
-		),
-		packTerraformMembers,
-	)
-
-	constructor := func() (interface{}, error) {
-		return &TerraformFederatedRepositoryParams{
-			RepositoryBaseParams: local.RepositoryBaseParams{
-				PackageType: packageType,
-				Rclass:      Rclass,
-			},
-		}, nil
+	localAPIModel := model.(local.LocalTerraformAPIModel)
+	localAPIModel.Rclass = Rclass
+
+	federatedAPIModel, d := r.FederatedResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return mkResourceSchema(terraformFederatedSchema, pkr, unpackFederatedTerraformRepository, constructor)
+	return FederatedTerraformAPIModel{
+		LocalTerraformAPIModel: localAPIModel,
+		FederatedAPIModel:      federatedAPIModel,
+	}, diags
+}
+
+func (r *FederatedTerraformResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*FederatedTerraformAPIModel)
+
+	diags.Append(r.LocalTerraformResourceModel.FromAPIModel(ctx, &model.LocalTerraformAPIModel)...)
+	diags.Append(r.FederatedResourceModel.FromAPIModel(ctx, model.FederatedAPIModel)...)
+
+	return diags
+}
+
+type FederatedTerraformAPIModel struct {
+	local.LocalTerraformAPIModel
+	FederatedAPIModel
+}
+
+// SDKv2
+
+type TerraformFederatedRepositoryParams struct {
+	local.RepositoryBaseParams
+	Members []Member `hcl:"member" json:"members"`
+	RepoParams
 }
@@ -199,6 +199,7 @@ var LocalAttributes = lo.Assign(
 		"property_sets": schema.SetAttribute{
 			ElementType: types.StringType,
 			Optional:    true,
+			Computed:    true, // so that the provider `repository_defaults` can be planned
 			Validators: []validator.Set{
 				setvalidator.SizeAtLeast(1),
 			},
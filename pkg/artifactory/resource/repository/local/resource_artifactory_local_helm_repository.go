@@ -20,10 +20,12 @@ import (
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/samber/lo"
 )
@@ -39,8 +41,6 @@ func NewHelmLocalRepositoryResource() resource.Resource {
 	}
 }
 
-const HelmEnforceSupportedVersion = "7.104.0"
-
 type localHelmResource struct {
 	localResource
 }
@@ -152,7 +152,7 @@ func (r *localHelmResource) Schema(ctx context.Context, req resource.SchemaReque
 				PlanModifiers: []planmodifier.Bool{
 					PreventUpdateModifier(),
 				},
-				MarkdownDescription: fmt.Sprintf("Prevents the deployment of charts with the same name and version in different repository paths. Only available for %s onward. Cannot be updated after it is set.", HelmEnforceSupportedVersion),
+				MarkdownDescription: fmt.Sprintf("Prevents the deployment of charts with the same name and version in different repository paths. Only available for %s onward. Cannot be updated after it is set.", capability.HelmChartEnforcement.MinVersion),
 			},
 			"force_metadata_name_version": schema.BoolAttribute{
 				Optional: true,
@@ -160,7 +160,7 @@ func (r *localHelmResource) Schema(ctx context.Context, req resource.SchemaReque
 				PlanModifiers: []planmodifier.Bool{
 					PreventUpdateModifier(),
 				},
-				MarkdownDescription: fmt.Sprintf("Ensures that the chart name and version in the file name match the values in Chart.yaml and adhere to SemVer standards. Only available for %s onward. Cannot be updated after it is set.", HelmEnforceSupportedVersion),
+				MarkdownDescription: fmt.Sprintf("Ensures that the chart name and version in the file name match the values in Chart.yaml and adhere to SemVer standards. Only available for %s onward. Cannot be updated after it is set.", capability.HelmChartEnforcement.MinVersion),
 			},
 		},
 	)
@@ -172,6 +172,25 @@ func (r *localHelmResource) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+func (r localHelmResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	r.localResource.ValidateConfig(ctx, req, resp)
+	if r.ProviderData == nil {
+		return
+	}
+
+	for _, attr := range []string{"force_non_duplicate_chart", "force_metadata_name_version"} {
+		var value types.Bool
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if value.ValueBool() {
+			capability.HelmChartEnforcement.Validate(*r.ProviderData, path.Root(attr), &resp.Diagnostics)
+		}
+	}
+}
+
 // Plan Modifier
 
 func PreventUpdateModifier() planmodifier.Bool {
@@ -0,0 +1,416 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkv2_diag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	sdkv2_validator "github.com/jfrog/terraform-provider-shared/validator"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+const (
+	projectSharesDescription = "Projects this repository is shared with, in addition to the project it is assigned to with `project_key`. " +
+		"Each share can be read-only and limited to environments of the target project. " +
+		"When not set, the shares of the repository are not managed."
+
+	projectAttachRepositoryEndpoint = "access/api/v1/projects/_/attach/repositories/{repoKey}/{projectKey}"
+	projectDetachRepositoryEndpoint = "access/api/v1/projects/_/attach/repositories/{repoKey}"
+	projectShareRepositoryEndpoint  = "access/api/v1/projects/_/share/repositories/{repoKey}/{projectKey}"
+	projectRepositorySharesEndpoint = "access/api/v1/projects/_/share/repositories/{repoKey}"
+)
+
+type ProjectShareAPIModel struct {
+	ProjectKey   string   `json:"project_key"`
+	ReadOnly     bool     `json:"read_only"`
+	Environments []string `json:"environments"`
+}
+
+type projectSharesAPIModel struct {
+	SharedWithProjects []ProjectShareAPIModel `json:"shared_with_projects"`
+}
+
+var projectShareAttrTypes = map[string]attr.Type{
+	"project_key":  types.StringType,
+	"read_only":    types.BoolType,
+	"environments": types.SetType{ElemType: types.StringType},
+}
+
+var projectSharesAttribute = schema.SetNestedAttribute{
+	Optional: true,
+	NestedObject: schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				MarkdownDescription: "Key of the project the repository is shared with.",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Share the repository read-only. Default value is `false`.",
+			},
+			"environments": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "Environments of the target project the repository is available in. When empty, the repository is available in all environments.",
+			},
+		},
+	},
+	MarkdownDescription: projectSharesDescription,
+}
+
+var ProjectSharesSchemaSDKv2 = map[string]*sdkv2_schema.Schema{
+	"project_shares": {
+		Type:     sdkv2_schema.TypeSet,
+		Optional: true,
+		Elem: &sdkv2_schema.Resource{
+			Schema: map[string]*sdkv2_schema.Schema{
+				"project_key": {
+					Type:             sdkv2_schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: sdkv2_validator.ProjectKey,
+					Description:      "Key of the project the repository is shared with.",
+				},
+				"read_only": {
+					Type:        sdkv2_schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Share the repository read-only. Default value is `false`.",
+				},
+				"environments": {
+					Type:        sdkv2_schema.TypeSet,
+					Elem:        &sdkv2_schema.Schema{Type: sdkv2_schema.TypeString},
+					Set:         sdkv2_schema.HashString,
+					Optional:    true,
+					Description: "Environments of the target project the repository is available in. When empty, the repository is available in all environments.",
+				},
+			},
+		},
+		Description: projectSharesDescription,
+	},
+}
+
+// AssignRepoToProject assigns the repository to a project, moving it from the project it is currently assigned to.
+func AssignRepoToProject(repoKey string, projectKey string, client *resty.Client) error {
+	var jfrogErrors util.JFrogErrors
+	resp, err := client.R().
+		SetPathParams(map[string]string{
+			"repoKey":    repoKey,
+			"projectKey": projectKey,
+		}).
+		SetQueryParam("force", "true").
+		SetError(&jfrogErrors).
+		Put(projectAttachRepositoryEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if resp.IsError() {
+		return fmt.Errorf("failed to assign repository %s to project %s: %s", repoKey, projectKey, jfrogErrors.String())
+	}
+
+	return nil
+}
+
+func UnassignRepoFromProject(repoKey string, client *resty.Client) error {
+	var jfrogErrors util.JFrogErrors
+	resp, err := client.R().
+		SetPathParam("repoKey", repoKey).
+		SetError(&jfrogErrors).
+		Delete(projectDetachRepositoryEndpoint)
+	if err != nil {
+		return err
+	}
+
+	// the repository is no longer assigned to any project
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+
+	if resp.IsError() {
+		return fmt.Errorf("failed to unassign repository %s from its project: %s", repoKey, jfrogErrors.String())
+	}
+
+	return nil
+}
+
+// UpdateRepoProject moves the repository from oldProjectKey to newProjectKey, where an empty key means no project.
+func UpdateRepoProject(repoKey, oldProjectKey, newProjectKey string, client *resty.Client) error {
+	if oldProjectKey == newProjectKey {
+		return nil
+	}
+
+	if newProjectKey == "" {
+		return UnassignRepoFromProject(repoKey, client)
+	}
+
+	return AssignRepoToProject(repoKey, newProjectKey, client)
+}
+
+func GetRepoProjectShares(repoKey string, client *resty.Client) ([]ProjectShareAPIModel, error) {
+	var result projectSharesAPIModel
+	var jfrogErrors util.JFrogErrors
+	resp, err := client.R().
+		SetPathParam("repoKey", repoKey).
+		SetResult(&result).
+		SetError(&jfrogErrors).
+		Get(projectRepositorySharesEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return []ProjectShareAPIModel{}, nil
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("failed to get projects repository %s is shared with: %s", repoKey, jfrogErrors.String())
+	}
+
+	return result.SharedWithProjects, nil
+}
+
+// UpdateRepoProjectShares shares the repository with the projects added to or changed in newShares, and
+// stops sharing it with the projects removed from them.
+func UpdateRepoProjectShares(repoKey string, oldShares, newShares []ProjectShareAPIModel, client *resty.Client) error {
+	for _, share := range oldShares {
+		if lo.ContainsBy(newShares, func(s ProjectShareAPIModel) bool { return s.ProjectKey == share.ProjectKey }) {
+			continue
+		}
+
+		var jfrogErrors util.JFrogErrors
+		resp, err := client.R().
+			SetPathParams(map[string]string{
+				"repoKey":    repoKey,
+				"projectKey": share.ProjectKey,
+			}).
+			SetError(&jfrogErrors).
+			Delete(projectShareRepositoryEndpoint)
+		if err != nil {
+			return err
+		}
+
+		if resp.IsError() && resp.StatusCode() != http.StatusNotFound {
+			return fmt.Errorf("failed to stop sharing repository %s with project %s: %s", repoKey, share.ProjectKey, jfrogErrors.String())
+		}
+	}
+
+	for _, share := range newShares {
+		if lo.ContainsBy(oldShares, func(s ProjectShareAPIModel) bool { return s.equal(share) }) {
+			continue
+		}
+
+		var jfrogErrors util.JFrogErrors
+		resp, err := client.R().
+			SetPathParams(map[string]string{
+				"repoKey":    repoKey,
+				"projectKey": share.ProjectKey,
+			}).
+			SetBody(share).
+			SetError(&jfrogErrors).
+			Put(projectShareRepositoryEndpoint)
+		if err != nil {
+			return err
+		}
+
+		if resp.IsError() {
+			return fmt.Errorf("failed to share repository %s with project %s: %s", repoKey, share.ProjectKey, jfrogErrors.String())
+		}
+	}
+
+	return nil
+}
+
+// updateProjectShares changes the project shares of the repository in the same way as UpdateRepoProjectShares.
+// The repository has already been saved in state, so on failure `project_shares` in state is set to the shares
+// actually in place, and the next apply retries the others.
+func updateProjectShares(ctx context.Context, repoKey string, oldShares, newShares []ProjectShareAPIModel, client *resty.Client, state *tfsdk.State) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	err := UpdateRepoProjectShares(repoKey, oldShares, newShares, client)
+	if err == nil {
+		return diags
+	}
+
+	diags.AddError(
+		"Failed to update repository project shares",
+		err.Error(),
+	)
+
+	shares, err := GetRepoProjectShares(repoKey, client)
+	if err != nil {
+		// the shares in state are refreshed on the next read
+		return diags
+	}
+
+	projectShares, d := projectSharesToSet(ctx, shares)
+	diags.Append(d...)
+	if d.HasError() {
+		return diags
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root("project_shares"), projectShares)...)
+
+	return diags
+}
+
+func (s ProjectShareAPIModel) equal(other ProjectShareAPIModel) bool {
+	if s.ProjectKey != other.ProjectKey || s.ReadOnly != other.ReadOnly || len(s.Environments) != len(other.Environments) {
+		return false
+	}
+
+	for _, env := range s.Environments {
+		if !slices.Contains(other.Environments, env) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func projectSharesFromSet(ctx context.Context, shares types.Set) ([]ProjectShareAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := []ProjectShareAPIModel{}
+	for _, elem := range shares.Elements() {
+		attrs := elem.(types.Object).Attributes()
+
+		var environments []string
+		diags.Append(attrs["environments"].(types.Set).ElementsAs(ctx, &environments, false)...)
+
+		result = append(result, ProjectShareAPIModel{
+			ProjectKey:   attrs["project_key"].(types.String).ValueString(),
+			ReadOnly:     attrs["read_only"].(types.Bool).ValueBool(),
+			Environments: environments,
+		})
+	}
+
+	return result, diags
+}
+
+func projectSharesToSet(ctx context.Context, shares []ProjectShareAPIModel) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := lo.Map(shares, func(share ProjectShareAPIModel, _ int) attr.Value {
+		environments, d := types.SetValueFrom(ctx, types.StringType, lo.Ternary(share.Environments == nil, []string{}, share.Environments))
+		diags.Append(d...)
+
+		return types.ObjectValueMust(
+			projectShareAttrTypes,
+			map[string]attr.Value{
+				"project_key":  types.StringValue(share.ProjectKey),
+				"read_only":    types.BoolValue(share.ReadOnly),
+				"environments": environments,
+			},
+		)
+	})
+
+	set, d := types.SetValue(types.ObjectType{AttrTypes: projectShareAttrTypes}, values)
+	diags.Append(d...)
+
+	return set, diags
+}
+
+func projectSharesFromSetSDKv2(set *sdkv2_schema.Set) []ProjectShareAPIModel {
+	return lo.Map(set.List(), func(elem interface{}, _ int) ProjectShareAPIModel {
+		share := elem.(map[string]interface{})
+		return ProjectShareAPIModel{
+			ProjectKey:   share["project_key"].(string),
+			ReadOnly:     share["read_only"].(bool),
+			Environments: utilsdk.CastToStringArr(share["environments"].(*sdkv2_schema.Set).List()),
+		}
+	})
+}
+
+// updateProjectSharesSDKv2 applies the changes of `project_shares` to the repository. A renamed repository
+// is created again with the new key, so it is shared with all the projects.
+func updateProjectSharesSDKv2(d *sdkv2_schema.ResourceData, client *resty.Client) sdkv2_diag.Diagnostics {
+	renamed := d.HasChange("key")
+	if !renamed && !d.HasChange("project_shares") {
+		return nil
+	}
+
+	oldShares, newShares := d.GetChange("project_shares")
+
+	var currentShares []ProjectShareAPIModel
+	if !renamed {
+		currentShares = projectSharesFromSetSDKv2(oldShares.(*sdkv2_schema.Set))
+	}
+
+	err := UpdateRepoProjectShares(
+		d.Id(),
+		currentShares,
+		projectSharesFromSetSDKv2(newShares.(*sdkv2_schema.Set)),
+		client,
+	)
+
+	return sdkv2_diag.FromErr(err)
+}
+
+// readProjectShares refreshes `project_shares` when it's set, so that shares changed outside of Terraform show as drift.
+func readProjectShares(read sdkv2_schema.ReadContextFunc) sdkv2_schema.ReadContextFunc {
+	return func(ctx context.Context, d *sdkv2_schema.ResourceData, m interface{}) sdkv2_diag.Diagnostics {
+		ds := read(ctx, d, m)
+		if ds.HasError() || d.Id() == "" {
+			return ds
+		}
+
+		if v, ok := d.GetOk("project_shares"); !ok || v.(*sdkv2_schema.Set).Len() == 0 {
+			return ds
+		}
+
+		shares, err := GetRepoProjectShares(d.Id(), m.(util.ProviderMetadata).Client)
+		if err != nil {
+			return append(ds, sdkv2_diag.FromErr(err)...)
+		}
+
+		values := lo.Map(shares, func(share ProjectShareAPIModel, _ int) interface{} {
+			return map[string]interface{}{
+				"project_key":  share.ProjectKey,
+				"read_only":    share.ReadOnly,
+				"environments": share.Environments,
+			}
+		})
+
+		if err := d.Set("project_shares", values); err != nil {
+			return append(ds, sdkv2_diag.FromErr(err)...)
+		}
+
+		return ds
+	}
+}
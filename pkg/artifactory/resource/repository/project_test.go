@@ -0,0 +1,86 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repository
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// A failure to share the repository with one of the projects leaves the shares actually in place in state.
+func TestUpdateProjectSharesFailure(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/access/api/v1/projects/_/share/repositories/generic-local/proj1":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPut && r.URL.Path == "/access/api/v1/projects/_/share/repositories/generic-local/proj2":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":[{"code":"BAD_REQUEST","message":"project proj2 not found"}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/access/api/v1/projects/_/share/repositories/generic-local":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"shared_with_projects":[{"project_key":"proj1","read_only":false,"environments":[]}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := resty.New().SetBaseURL(server.URL)
+
+	stateSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_shares": projectSharesAttribute,
+		},
+	}
+	planned, diags := projectSharesToSet(ctx, []ProjectShareAPIModel{
+		{ProjectKey: "proj1", Environments: []string{}},
+		{ProjectKey: "proj2", Environments: []string{}},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	state := tfsdk.State{Schema: stateSchema, Raw: tftypes.NewValue(stateSchema.Type().TerraformType(ctx), nil)}
+	diags = state.SetAttribute(ctx, path.Root("project_shares"), planned)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	newShares, _ := projectSharesFromSet(ctx, planned)
+	diags = updateProjectShares(ctx, "generic-local", nil, newShares, client, &state)
+	if !diags.HasError() {
+		t.Fatal("expected an error")
+	}
+
+	var shares types.Set
+	state.GetAttribute(ctx, path.Root("project_shares"), &shares)
+	inState, _ := projectSharesFromSet(ctx, shares)
+	if len(inState) != 1 || inState[0].ProjectKey != "proj1" {
+		t.Errorf("expected only proj1 in state, got %v", inState)
+	}
+}
@@ -702,6 +702,26 @@ var javaAttributes = func(suppressPOM bool) map[string]schema.Attribute {
 	}
 }
 
+func (r *remoteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.BaseResource.ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var disableProxy types.Bool
+	var proxy types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("disable_proxy"), &disableProxy)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("proxy"), &proxy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Don't apply the provider default proxy when the proxy is disabled for this repository
+	if disableProxy.ValueBool() && proxy.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("proxy"), types.StringValue(""))...)
+	}
+}
+
 func (r remoteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	r.BaseResource.ValidateConfig(ctx, req, resp)
 
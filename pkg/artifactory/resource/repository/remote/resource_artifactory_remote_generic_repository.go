@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/samber/lo"
@@ -175,18 +176,16 @@ type httpHeaderAPIModel struct {
 	Sensitive bool   `json:"sensitive,omitempty"`
 }
 
-const customHttpHeadersSupportedVersion = "7.146.0"
-
 type customHttpHeadersVersionValidator struct {
 	providerData *util.ProviderMetadata
 }
 
 func (v customHttpHeadersVersionValidator) Description(_ context.Context) string {
-	return fmt.Sprintf("Requires Artifactory %s or later to use custom_http_headers.", customHttpHeadersSupportedVersion)
+	return fmt.Sprintf("Requires Artifactory %s or later to use custom_http_headers.", capability.RemoteCustomHTTPHeaders.MinVersion)
 }
 
 func (v customHttpHeadersVersionValidator) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("Requires Artifactory `%s` or later to use `custom_http_headers`.", customHttpHeadersSupportedVersion)
+	return fmt.Sprintf("Requires Artifactory `%s` or later to use `custom_http_headers`.", capability.RemoteCustomHTTPHeaders.MinVersion)
 }
 
 func (v customHttpHeadersVersionValidator) ValidateList(_ context.Context, req validator.ListRequest, resp *validator.ListResponse) {
@@ -196,22 +195,7 @@ func (v customHttpHeadersVersionValidator) ValidateList(_ context.Context, req v
 	if v.providerData == nil {
 		return
 	}
-	isSupported, err := util.CheckVersion(v.providerData.ArtifactoryVersion, customHttpHeadersSupportedVersion)
-	if err != nil {
-		resp.Diagnostics.AddAttributeError(
-			req.Path,
-			"Failed to check Artifactory version",
-			fmt.Sprintf("Unable to validate custom_http_headers version requirement: %s", err.Error()),
-		)
-		return
-	}
-	if !isSupported {
-		resp.Diagnostics.AddAttributeError(
-			req.Path,
-			"Unsupported Artifactory version",
-			fmt.Sprintf("custom_http_headers requires Artifactory %s or later. Connected version: %s", customHttpHeadersSupportedVersion, v.providerData.ArtifactoryVersion),
-		)
-	}
+	capability.RemoteCustomHTTPHeaders.Validate(*v.providerData, req.Path, &resp.Diagnostics)
 }
 
 type RemoteGenericAPIModel struct {
@@ -256,7 +240,7 @@ func (r *remoteGenericResource) Schema(ctx context.Context, req resource.SchemaR
 		Attributes: lo.Assign(remoteGenericAttributesV4, map[string]schema.Attribute{
 			"custom_http_headers": schema.ListNestedAttribute{
 				Optional:            true,
-				MarkdownDescription: fmt.Sprintf("Up to 5 custom HTTP headers sent on every outbound request to the remote URL. Header values are write-only and masked in plan output. To remove all headers, remove this attribute. When `sensitive` is `true`, Artifactory encrypts the value server-side. Requires Artifactory %s or later.", customHttpHeadersSupportedVersion),
+				MarkdownDescription: fmt.Sprintf("Up to 5 custom HTTP headers sent on every outbound request to the remote URL. Header values are write-only and masked in plan output. To remove all headers, remove this attribute. When `sensitive` is `true`, Artifactory encrypts the value server-side. Requires Artifactory %s or later.", capability.RemoteCustomHTTPHeaders.MinVersion),
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(5),
 					customHttpHeadersVersionValidator{providerData: r.ProviderData},
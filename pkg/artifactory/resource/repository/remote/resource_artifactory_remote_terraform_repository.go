@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-shared/util"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
@@ -123,8 +124,6 @@ type RemoteTerraformAPIModel struct {
 	TerraformProvidersURL string `json:"terraformProvidersUrl"`
 }
 
-const WizRegistryBypassSupportedVersion = "7.122.0"
-
 // Custom validator for bypass_head_requests to enforce true for specific terraform registries
 type terraformBypassHeadRequestsValidator struct {
 	providerData *util.ProviderMetadata
@@ -176,15 +175,7 @@ func (v terraformBypassHeadRequestsValidator) ValidateBool(ctx context.Context,
 		}
 
 		// Check if Artifactory version supports wiz registry bypass
-		isSupported, err := util.CheckVersion(v.providerData.ArtifactoryVersion, WizRegistryBypassSupportedVersion)
-		if err != nil {
-			resp.Diagnostics.AddAttributeError(
-				req.Path,
-				"Failed to check Artifactory version",
-				"Unable to validate bypass_head_requests requirement for tf.app.wiz.io: "+err.Error(),
-			)
-			return
-		}
+		isSupported := capability.WizRegistryHeadRequestsBypass.Supported(*v.providerData)
 
 		if isSupported {
 			// For Artifactory 7.122.0+, enforce bypass_head_requests = true for wiz registry
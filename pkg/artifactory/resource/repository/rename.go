@@ -0,0 +1,391 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const (
+	allowRenameDescription = "When set to `true`, changing `key` renames the repository in place instead of replacing it: " +
+		"a new repository is created with the new key, the content is moved to it, virtual repositories, replications and " +
+		"permission targets referencing the old key are updated, then the old repository is deleted. Default value is `false`. " +
+		"Not supported for federated repositories."
+
+	moveEndpoint        = "artifactory/api/move/{src_path}"
+	storageEndpoint     = "artifactory/api/storage/{repo_key}"
+	replicationEndpoint = "artifactory/api/replications/{repo_key}"
+	permissionsEndpoint = "artifactory/api/v2/security/permissions"
+)
+
+// KeyPlanModifier replaces the repository when `key` changes, unless `allow_rename` is set.
+var KeyPlanModifier = stringplanmodifier.RequiresReplaceIf(
+	func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		var allowRename types.Bool
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("allow_rename"), &allowRename)...)
+		resp.RequiresReplace = !allowRename.ValueBool()
+	},
+	"Changing the key replaces the repository, unless allow_rename is set to true.",
+	"Changing the `key` replaces the repository, unless `allow_rename` is set to `true`.",
+)
+
+var AllowRenameSchemaSDKv2 = map[string]*sdkv2_schema.Schema{
+	"allow_rename": {
+		Type:        sdkv2_schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: allowRenameDescription,
+	},
+}
+
+// KeyForceNewIf replaces the SDKv2 repository when `key` changes, unless `allow_rename` is set.
+func KeyForceNewIf(_ context.Context, diff *sdkv2_schema.ResourceDiff, _ interface{}) bool {
+	return !diff.Get("allow_rename").(bool)
+}
+
+type repositoryConfig struct {
+	Key                   string   `json:"key"`
+	Rclass                string   `json:"rclass"`
+	Repositories          []string `json:"repositories"`
+	DefaultDeploymentRepo string   `json:"defaultDeploymentRepo"`
+}
+
+// RenameRepository renames repository oldKey to newKey. The repository is created with the new key
+// from newRepo (the API model of the repository), then the content of local repositories is moved,
+// the references to the old key in virtual repositories, replications and permission targets are
+// updated, and the old repository is deleted.
+//
+// Steps that can't be performed automatically, e.g. push replications whose credentials can't be
+// read back from Artifactory, are returned as warnings.
+func RenameRepository(ctx context.Context, restyClient *resty.Client, oldKey, newKey string, newRepo interface{}) ([]string, error) {
+	var warnings []string
+
+	oldRepo, err := getRepositoryConfig(restyClient, oldKey)
+	if err != nil {
+		return warnings, err
+	}
+
+	if oldRepo.Rclass == "federated" {
+		return warnings, fmt.Errorf("federated repository %s can't be renamed", oldKey)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Renaming repository %s to %s", oldKey, newKey))
+
+	if err := createRepository(restyClient, newKey, newRepo); err != nil {
+		return warnings, fmt.Errorf("failed to create repository %s: %w", newKey, err)
+	}
+
+	// From here on, report that both repositories exist so the user can recover manually
+	incomplete := func(err error) error {
+		return fmt.Errorf("repository %s was created but repository %s was not deleted: %w", newKey, oldKey, err)
+	}
+
+	if oldRepo.Rclass == "local" {
+		if err := moveRepositoryContent(restyClient, oldKey, newKey); err != nil {
+			return warnings, incomplete(err)
+		}
+	}
+
+	if err := repointVirtualRepositories(ctx, restyClient, oldKey, newKey); err != nil {
+		return warnings, incomplete(err)
+	}
+
+	replicationWarnings, err := moveReplications(restyClient, oldRepo.Rclass, oldKey, newKey)
+	warnings = append(warnings, replicationWarnings...)
+	if err != nil {
+		return warnings, incomplete(err)
+	}
+
+	if err := repointPermissionTargets(ctx, restyClient, oldKey, newKey); err != nil {
+		return warnings, incomplete(err)
+	}
+
+	var jfrogErrors util.JFrogErrors
+	response, err := restyClient.R().
+		AddRetryCondition(client.RetryOnMergeError).
+		SetPathParam("key", oldKey).
+		SetError(&jfrogErrors).
+		Delete(RepositoriesEndpoint)
+	if err != nil {
+		return warnings, incomplete(err)
+	}
+	if response.IsError() {
+		return warnings, incomplete(fmt.Errorf("%s", jfrogErrors.String()))
+	}
+
+	return warnings, nil
+}
+
+func getRepositoryConfig(client *resty.Client, key string) (repositoryConfig, error) {
+	var repo repositoryConfig
+	var jfrogErrors util.JFrogErrors
+	response, err := client.R().
+		SetPathParam("key", key).
+		SetResult(&repo).
+		SetError(&jfrogErrors).
+		Get(RepositoriesEndpoint)
+	if err != nil {
+		return repo, err
+	}
+
+	if response.IsError() {
+		return repo, fmt.Errorf("failed to get repository %s: %s", key, jfrogErrors.String())
+	}
+
+	return repo, nil
+}
+
+func createRepository(restyClient *resty.Client, key string, repo interface{}) error {
+	var jfrogErrors util.JFrogErrors
+	response, err := restyClient.R().
+		AddRetryCondition(client.RetryOnMergeError).
+		SetPathParam("key", key).
+		SetBody(repo).
+		SetError(&jfrogErrors).
+		Put(RepositoriesEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return fmt.Errorf("%s", jfrogErrors.String())
+	}
+
+	return nil
+}
+
+// moveRepositoryContent moves each top level item, as the move API doesn't accept the repository root as the source.
+func moveRepositoryContent(client *resty.Client, oldKey, newKey string) error {
+	var folder struct {
+		Children []struct {
+			URI string `json:"uri"`
+		} `json:"children"`
+	}
+
+	var jfrogErrors util.JFrogErrors
+	response, err := client.R().
+		SetPathParam("repo_key", oldKey).
+		SetResult(&folder).
+		SetError(&jfrogErrors).
+		Get(storageEndpoint)
+	if err != nil {
+		return err
+	}
+	if response.IsError() {
+		return fmt.Errorf("failed to list content of repository %s: %s", oldKey, jfrogErrors.String())
+	}
+
+	for _, child := range folder.Children {
+		response, err := client.R().
+			SetRawPathParam("src_path", oldKey+child.URI).
+			SetQueryParams(map[string]string{
+				"to":              "/" + newKey + child.URI,
+				"suppressLayouts": "1",
+				"failFast":        "1",
+			}).
+			SetError(&jfrogErrors).
+			Post(moveEndpoint)
+		if err != nil {
+			return err
+		}
+		if response.IsError() {
+			return fmt.Errorf("failed to move %s%s to repository %s: %s", oldKey, child.URI, newKey, jfrogErrors.String())
+		}
+	}
+
+	return nil
+}
+
+func repointVirtualRepositories(ctx context.Context, client *resty.Client, oldKey, newKey string) error {
+	var virtualRepos []struct {
+		Key string `json:"key"`
+	}
+
+	var jfrogErrors util.JFrogErrors
+	response, err := client.R().
+		SetQueryParam("type", "virtual").
+		SetResult(&virtualRepos).
+		SetError(&jfrogErrors).
+		Get("artifactory/api/repositories")
+	if err != nil {
+		return err
+	}
+	if response.IsError() {
+		return fmt.Errorf("failed to list virtual repositories: %s", jfrogErrors.String())
+	}
+
+	for _, virtualRepo := range virtualRepos {
+		repo, err := getRepositoryConfig(client, virtualRepo.Key)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Contains(repo.Repositories, oldKey) {
+			continue
+		}
+
+		for i, r := range repo.Repositories {
+			if r == oldKey {
+				repo.Repositories[i] = newKey
+			}
+		}
+		if repo.DefaultDeploymentRepo == oldKey {
+			repo.DefaultDeploymentRepo = newKey
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Updating virtual repository %s to include %s instead of %s", repo.Key, newKey, oldKey))
+
+		response, err := client.R().
+			SetPathParam("key", repo.Key).
+			SetBody(repo).
+			SetError(&jfrogErrors).
+			Post(RepositoriesEndpoint)
+		if err != nil {
+			return err
+		}
+		if response.IsError() {
+			return fmt.Errorf("failed to update virtual repository %s: %s", repo.Key, jfrogErrors.String())
+		}
+	}
+
+	return nil
+}
+
+// moveReplications copies the pull replication of a remote repository to the new key. Push replications of a
+// local repository can't be copied as Artifactory doesn't return their password, so a warning is returned instead.
+// The replications of the old key are removed with the old repository.
+func moveReplications(client *resty.Client, rclass, oldKey, newKey string) ([]string, error) {
+	var replications []map[string]interface{}
+
+	response, err := client.R().
+		SetPathParam("repo_key", oldKey).
+		SetResult(&replications).
+		Get(replicationEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// no replication configured
+	if response.StatusCode() == http.StatusBadRequest || response.StatusCode() == http.StatusNotFound || len(replications) == 0 {
+		return nil, nil
+	}
+
+	if response.IsError() {
+		return nil, fmt.Errorf("failed to get replications of repository %s: %s", oldKey, response.String())
+	}
+
+	if rclass != "remote" {
+		return []string{
+			fmt.Sprintf("Repository %s has %d replication(s) which can't be moved to repository %s because their credentials can't be read from Artifactory. Configure the replications for repository %s.", oldKey, len(replications), newKey, newKey),
+		}, nil
+	}
+
+	replication := replications[0]
+	replication["repoKey"] = newKey
+
+	var jfrogErrors util.JFrogErrors
+	response, err = client.R().
+		SetPathParam("repo_key", newKey).
+		SetBody(replication).
+		SetError(&jfrogErrors).
+		Put(replicationEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	if response.IsError() {
+		return nil, fmt.Errorf("failed to create replication of repository %s: %s", newKey, jfrogErrors.String())
+	}
+
+	return nil, nil
+}
+
+func repointPermissionTargets(ctx context.Context, client *resty.Client, oldKey, newKey string) error {
+	var permissions []struct {
+		Name string `json:"name"`
+	}
+
+	var jfrogErrors util.JFrogErrors
+	response, err := client.R().
+		SetResult(&permissions).
+		SetError(&jfrogErrors).
+		Get(permissionsEndpoint)
+	if err != nil {
+		return err
+	}
+	if response.IsError() {
+		return fmt.Errorf("failed to list permission targets: %s", jfrogErrors.String())
+	}
+
+	for _, p := range permissions {
+		// keep the unknown fields intact when writing the permission target back
+		var permission map[string]interface{}
+		response, err := client.R().
+			SetPathParam("name", p.Name).
+			SetResult(&permission).
+			SetError(&jfrogErrors).
+			Get(permissionsEndpoint + "/{name}")
+		if err != nil {
+			return err
+		}
+		if response.IsError() {
+			return fmt.Errorf("failed to get permission target %s: %s", p.Name, jfrogErrors.String())
+		}
+
+		repo, ok := permission["repo"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		repositories, ok := repo["repositories"].([]interface{})
+		if !ok || !slices.Contains(repositories, interface{}(oldKey)) {
+			continue
+		}
+
+		for i, r := range repositories {
+			if r == oldKey {
+				repositories[i] = newKey
+			}
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Updating permission target %s to include %s instead of %s", p.Name, newKey, oldKey))
+
+		response, err = client.R().
+			SetPathParam("name", p.Name).
+			SetBody(permission).
+			SetError(&jfrogErrors).
+			Put(permissionsEndpoint + "/{name}")
+		if err != nil {
+			return err
+		}
+		if response.IsError() {
+			return fmt.Errorf("failed to update permission target %s: %s", p.Name, jfrogErrors.String())
+		}
+	}
+
+	return nil
+}
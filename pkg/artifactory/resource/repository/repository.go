@@ -30,10 +30,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -41,6 +41,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 	"github.com/jfrog/terraform-provider-shared/client"
 	"github.com/jfrog/terraform-provider-shared/packer"
 	"github.com/jfrog/terraform-provider-shared/testutil"
@@ -50,6 +53,7 @@ import (
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	sdkv2_validator "github.com/jfrog/terraform-provider-shared/validator"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
 
 	"golang.org/x/exp/slices"
 )
@@ -101,6 +105,7 @@ const (
 )
 
 var PackageNameLookup = map[string]string{
+	AnsiblePackageType:          "Ansible",
 	BowerPackageType:            "Bower",
 	ChefPackageType:             "Chef",
 	CocoapodsPackageType:        "CocoaPods",
@@ -116,15 +121,19 @@ var PackageNameLookup = map[string]string{
 	HexPackageType:              "Hex",
 	HuggingFacePackageType:      "HuggingFace ML",
 	IvyPackageType:              "Ivy",
+	MavenPackageType:            "Maven",
 	NPMPackageType:              "Npm",
 	NixPackageType:              "Nix",
 	OpkgPackageType:             "Opkg",
+	P2PackageType:               "P2",
 	PubPackageType:              "Pub",
 	PuppetPackageType:           "Puppet",
 	PyPiPackageType:             "PyPi",
+	ReleasebundlesPackageType:   "Release Bundles",
 	SBTPackageType:              "SBT",
 	SwiftPackageType:            "Swift",
 	TerraformBackendPackageType: "Terraform Backend",
+	TerraformPackageType:        "Terraform",
 	VagrantPackageType:          "Vagrant",
 }
 
@@ -150,6 +159,16 @@ type BaseResource struct {
 	Rclass            string
 	ResourceModelType reflect.Type
 	APIModelType      reflect.Type
+	// RepositoryDefaults are the provider `repository_defaults`, see ResourceWithRepositoryDefaults
+	RepositoryDefaults []RepositoryDefaults
+}
+
+func (r *BaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.JFrogResource.Configure(ctx, req, resp)
+	if r.ProviderData != nil {
+		meta := httplog.ForResource(*r.ProviderData, r.TypeName)
+		r.ProviderData = &meta
+	}
 }
 
 func (r BaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -175,24 +194,8 @@ func (r BaseResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 		return
 	}
 
-	isSupported, err := util.CheckVersion(r.ProviderData.ArtifactoryVersion, CustomProjectEnvironmentSupportedVersion)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to check Artifactory version",
-			err.Error(),
-		)
-		return
-	}
-
-	// Check if multiple environments are supported (7.107.1 or later)
-	multipleEnvsSupported, err := util.CheckVersion(r.ProviderData.ArtifactoryVersion, MultipleEnvironmentsSupportedVersion)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to check Artifactory version",
-			err.Error(),
-		)
-		return
-	}
+	isSupported := capability.CustomProjectEnvironments.Supported(*r.ProviderData)
+	multipleEnvsSupported := capability.MultipleProjectEnvironments.Supported(*r.ProviderData)
 
 	if isSupported && !multipleEnvsSupported {
 		// For versions between 7.53.1 and 7.107.1, only one environment can be assigned
@@ -200,7 +203,7 @@ func (r BaseResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 			resp.Diagnostics.AddError(
 				"Too many project environments",
 				fmt.Sprintf("for Artifactory versions %s to %s, only one environment can be assigned to a repository. Multiple environments are supported starting from version %s",
-					CustomProjectEnvironmentSupportedVersion, MultipleEnvironmentsSupportedVersion, MultipleEnvironmentsSupportedVersion),
+					capability.CustomProjectEnvironments.MinVersion, capability.MultipleProjectEnvironments.MinVersion, capability.MultipleProjectEnvironments.MinVersion),
 			)
 		}
 	}
@@ -228,7 +231,7 @@ func (r *BaseResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	plan := reflect.New(r.ResourceModelType).Interface().(ResourceModelIface)
 
@@ -262,11 +265,25 @@ func (r *BaseResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// save the repository first so a failure to share it taints the resource instead of orphaning the repository
 	plan.SetCreateResourceStateData(ctx, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if projectShares := plan.ProjectSharesValue(); !projectShares.IsNull() {
+		shares, d := projectSharesFromSet(ctx, projectShares)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(updateProjectShares(ctx, plan.KeyString(), nil, shares, r.ProviderData.Client, &resp.State)...)
+	}
 }
 
 func (r *BaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	state := reflect.New(r.ResourceModelType).Interface().(ResourceModelIface)
 
@@ -309,11 +326,27 @@ func (r *BaseResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	// only refresh the shares when they are managed, to detect the ones changed outside of Terraform
+	if !state.ProjectSharesValue().IsNull() {
+		shares, err := GetRepoProjectShares(state.KeyString(), r.ProviderData.Client)
+		if err != nil {
+			utilfw.UnableToRefreshResourceError(resp, err.Error())
+			return
+		}
+
+		projectShares, d := projectSharesToSet(ctx, shares)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.SetProjectShares(projectShares)
+	}
+
 	state.SetReadResourceStateData(ctx, resp)
 }
 
 func (r *BaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	plan := reflect.New(r.ResourceModelType).Interface().(ResourceModelIface)
 	plan.GetUpdateResourcePlanData(ctx, req, resp)
@@ -336,6 +369,49 @@ func (r *BaseResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	key := plan.KeyString()
+
+	// key can only change in place when `allow_rename` is set
+	if oldKey := state.KeyString(); key != oldKey {
+		warnings, err := RenameRepository(ctx, r.ProviderData.Client, oldKey, key, repo)
+		for _, warning := range warnings {
+			resp.Diagnostics.AddWarning("Repository rename", warning)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to rename repository",
+				err.Error(),
+			)
+			return
+		}
+
+		// the repository is created again with the new key, so it is assigned to its project and shared from
+		// scratch, after saving the new key so a failure doesn't leave the old one in state
+		plan.SetUpdateResourceStateData(ctx, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err = UpdateRepoProject(key, "", plan.ProjectKeyValue().ValueString(), r.ProviderData.Client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to assign/unassign repository to project",
+				err.Error(),
+			)
+			return
+		}
+
+		if projectShares := plan.ProjectSharesValue(); !projectShares.IsNull() {
+			shares, d := projectSharesFromSet(ctx, projectShares)
+			resp.Diagnostics.Append(d...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(updateProjectShares(ctx, key, nil, shares, r.ProviderData.Client, &resp.State)...)
+		}
+		return
+	}
+
 	var jfrogErrors util.JFrogErrors
 	response, err := r.ProviderData.Client.R().
 		SetPathParam("key", key).
@@ -353,37 +429,35 @@ func (r *BaseResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	planProjectKey := plan.ProjectKeyValue()
-	stateProjectKey := state.ProjectKeyValue()
-
-	if !planProjectKey.Equal(stateProjectKey) {
-		oldProjectKey := stateProjectKey.ValueString()
-		newProjectKey := planProjectKey.ValueString()
-
-		assignToProject := oldProjectKey == "" && len(newProjectKey) > 0
-		unassignFromProject := len(oldProjectKey) > 0 && newProjectKey == ""
+	err = UpdateRepoProject(key, state.ProjectKeyValue().ValueString(), plan.ProjectKeyValue().ValueString(), r.ProviderData.Client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to assign/unassign repository to project",
+			err.Error(),
+		)
+		return
+	}
 
-		var err error
-		if assignToProject {
-			err = AssignRepoToProject(key, newProjectKey, r.ProviderData.Client)
-		} else if unassignFromProject {
-			err = UnassignRepoFromProject(key, r.ProviderData.Client)
-		}
+	plan.SetUpdateResourceStateData(ctx, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Failed to assign/unassign repository to project",
-				err.Error(),
-			)
+	if !plan.ProjectSharesValue().Equal(state.ProjectSharesValue()) {
+		oldShares, d := projectSharesFromSet(ctx, state.ProjectSharesValue())
+		resp.Diagnostics.Append(d...)
+		newShares, d := projectSharesFromSet(ctx, plan.ProjectSharesValue())
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-	}
 
-	plan.SetUpdateResourceStateData(ctx, resp)
+		resp.Diagnostics.Append(updateProjectShares(ctx, key, oldShares, newShares, r.ProviderData.Client, &resp.State)...)
+	}
 }
 
 func (r *BaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var key types.String
 
@@ -429,6 +503,8 @@ type ResourceModelIface interface {
 	GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse)
 	SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse)
 	ProjectKeyValue() basetypes.StringValue
+	ProjectSharesValue() basetypes.SetValue
+	SetProjectShares(projectShares basetypes.SetValue)
 }
 
 type BaseResourceModel struct {
@@ -439,6 +515,8 @@ type BaseResourceModel struct {
 	Notes               types.String `tfsdk:"notes"`
 	IncludesPattern     types.String `tfsdk:"includes_pattern"`
 	ExcludesPattern     types.String `tfsdk:"excludes_pattern"`
+	AllowRename         types.Bool   `tfsdk:"allow_rename"`
+	ProjectShares       types.Set    `tfsdk:"project_shares"`
 }
 
 func (r BaseResourceModel) KeyString() string {
@@ -449,6 +527,14 @@ func (r BaseResourceModel) ProjectKeyValue() basetypes.StringValue {
 	return r.ProjectKey
 }
 
+func (r BaseResourceModel) ProjectSharesValue() basetypes.SetValue {
+	return r.ProjectShares
+}
+
+func (r *BaseResourceModel) SetProjectShares(projectShares basetypes.SetValue) {
+	r.ProjectShares = projectShares
+}
+
 func (r BaseResourceModel) ToAPIModel(ctx context.Context, rclass, packageType string) (interface{}, diag.Diagnostics) {
 	diags := diag.Diagnostics{}
 
@@ -490,6 +576,11 @@ func (r *BaseResourceModel) FromAPIModel(ctx context.Context, apiModel interface
 	}
 	r.ProjectEnvironments = envs
 
+	// not part of the repository configuration, e.g. during import
+	if r.AllowRename.IsNull() {
+		r.AllowRename = types.BoolValue(false)
+	}
+
 	return diags
 }
 
@@ -513,10 +604,16 @@ var BaseAttributes = map[string]schema.Attribute{
 			validatorfw_string.RepoKey(),
 		},
 		PlanModifiers: []planmodifier.String{
-			stringplanmodifier.RequiresReplace(),
+			KeyPlanModifier,
 		},
 		MarkdownDescription: "A mandatory identifier for the repository that must be unique. Must be 1 - 64 alphanumeric and hyphen characters. It cannot contain spaces or special characters.",
 	},
+	"allow_rename": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: allowRenameDescription,
+	},
 	"project_key": schema.StringAttribute{
 		Optional: true,
 		Computed: true,
@@ -526,6 +623,7 @@ var BaseAttributes = map[string]schema.Attribute{
 		},
 		MarkdownDescription: "Project key for assigning this repository to. Must be 2 - 32 lowercase alphanumeric and hyphen characters. When assigning repository to a project, repository key must be prefixed with project key, separated by a dash.",
 	},
+	"project_shares": projectSharesAttribute,
 	"project_environments": schema.SetAttribute{
 		ElementType: types.StringType,
 		Optional:    true,
@@ -772,6 +870,11 @@ func MkRepoCreate(unpack unpacker.UnpackFunc, read sdkv2_schema.ReadContextFunc)
 			return err
 		}
 
+		err = updateProjectSharesSDKv2(d, m.(util.ProviderMetadata).Client)
+		if err != nil {
+			return err
+		}
+
 		return read(ctx, d, m)
 	}
 }
@@ -810,6 +913,32 @@ func Update(ctx context.Context, d *sdkv2_schema.ResourceData, m interface{}, un
 		return sdkv2_diag.FromErr(err)
 	}
 
+	// key can only change in place when `allow_rename` is set
+	if d.HasChange("key") {
+		ds := sdkv2_diag.Diagnostics{}
+		warnings, err := RenameRepository(ctx, m.(util.ProviderMetadata).Client, d.Id(), key, repo)
+		for _, warning := range warnings {
+			ds = append(ds, sdkv2_diag.Diagnostic{
+				Severity: sdkv2_diag.Warning,
+				Summary:  "Repository rename",
+				Detail:   warning,
+			})
+		}
+		if err != nil {
+			return append(ds, sdkv2_diag.FromErr(err)...)
+		}
+
+		d.SetId(key)
+
+		// the repository is created again with the new key, its shares are added by updateProjectSharesSDKv2
+		err = UpdateRepoProject(key, "", d.Get("project_key").(string), m.(util.ProviderMetadata).Client)
+		if err != nil {
+			return append(ds, sdkv2_diag.FromErr(err)...)
+		}
+
+		return ds
+	}
+
 	resp, err := m.(util.ProviderMetadata).Client.R().
 		AddRetryCondition(client.RetryOnMergeError).
 		SetBody(repo).
@@ -825,22 +954,9 @@ func Update(ctx context.Context, d *sdkv2_schema.ResourceData, m interface{}, un
 
 	d.SetId(key)
 
-	projectKeyChanged := d.HasChange("project_key")
-	if projectKeyChanged {
-		old, newProject := d.GetChange("project_key")
-		oldProjectKey := old.(string)
-		newProjectKey := newProject.(string)
-
-		assignToProject := oldProjectKey == "" && len(newProjectKey) > 0
-		unassignFromProject := len(oldProjectKey) > 0 && newProjectKey == ""
-
-		var err error
-		if assignToProject {
-			err = AssignRepoToProject(key, newProjectKey, m.(util.ProviderMetadata).Client)
-		} else if unassignFromProject {
-			err = UnassignRepoFromProject(key, m.(util.ProviderMetadata).Client)
-		}
-
+	if d.HasChange("project_key") {
+		oldProjectKey, newProjectKey := d.GetChange("project_key")
+		err := UpdateRepoProject(key, oldProjectKey.(string), newProjectKey.(string), m.(util.ProviderMetadata).Client)
 		if err != nil {
 			return sdkv2_diag.FromErr(err)
 		}
@@ -856,27 +972,15 @@ func MkRepoUpdate(unpack unpacker.UnpackFunc, read sdkv2_schema.ReadContextFunc)
 			return err
 		}
 
+		err = updateProjectSharesSDKv2(d, m.(util.ProviderMetadata).Client)
+		if err != nil {
+			return err
+		}
+
 		return read(ctx, d, m)
 	}
 }
 
-func AssignRepoToProject(repoKey string, projectKey string, client *resty.Client) error {
-	_, err := client.R().
-		SetPathParams(map[string]string{
-			"repoKey":    repoKey,
-			"projectKey": projectKey,
-		}).
-		Put("access/api/v1/projects/_/attach/repositories/{repoKey}/{projectKey}")
-	return err
-}
-
-func UnassignRepoFromProject(repoKey string, client *resty.Client) error {
-	_, err := client.R().
-		SetPathParam("repoKey", repoKey).
-		Delete("access/api/v1/projects/_/attach/repositories/{repoKey}")
-	return err
-}
-
 type RepositoryFileList struct {
 	URI   string            `json:"uri"`
 	Files []json.RawMessage `json:"files"`
@@ -972,30 +1076,19 @@ func HandleResetWithNonExistentValue(d *utilsdk.ResourceData, key string) string
 	return value
 }
 
-const CustomProjectEnvironmentSupportedVersion = "7.53.1"
-const MultipleEnvironmentsSupportedVersion = "7.107.1"
-
 func ProjectEnvironmentsDiff(ctx context.Context, diff *sdkv2_schema.ResourceDiff, meta interface{}) error {
 	if data, ok := diff.GetOk("project_environments"); ok {
 		projectEnvironments := data.(*sdkv2_schema.Set).List()
 		providerMetadata := meta.(util.ProviderMetadata)
 
-		isSupported, err := util.CheckVersion(providerMetadata.ArtifactoryVersion, CustomProjectEnvironmentSupportedVersion)
-		if err != nil {
-			return fmt.Errorf("failed to check version %s", err)
-		}
-
-		// Check if multiple environments are supported (7.107.1 or later)
-		multipleEnvsSupported, err := util.CheckVersion(providerMetadata.ArtifactoryVersion, MultipleEnvironmentsSupportedVersion)
-		if err != nil {
-			return fmt.Errorf("failed to check version for multiple environments support: %s", err)
-		}
+		isSupported := capability.CustomProjectEnvironments.Supported(providerMetadata)
+		multipleEnvsSupported := capability.MultipleProjectEnvironments.Supported(providerMetadata)
 
 		if isSupported && !multipleEnvsSupported {
 			// For versions between 7.53.1 and 7.107.1, only one environment can be assigned
 			if len(projectEnvironments) >= 2 {
 				return fmt.Errorf("for Artifactory versions %s to %s, only one environment can be assigned to a repository. Multiple environments are supported starting from version %s",
-					CustomProjectEnvironmentSupportedVersion, MultipleEnvironmentsSupportedVersion, MultipleEnvironmentsSupportedVersion)
+					capability.CustomProjectEnvironments.MinVersion, capability.MultipleProjectEnvironments.MinVersion, capability.MultipleProjectEnvironments.MinVersion)
 			}
 		}
 		if !isSupported { // Before 7.53.1
@@ -1048,6 +1141,15 @@ func VerifyReleasebundlesKey(_ context.Context, diff *sdkv2_schema.ResourceDiff,
 
 func MkResourceSchema(skeemas map[int16]map[string]*sdkv2_schema.Schema, packer packer.PackFunc, unpack unpacker.UnpackFunc, constructor Constructor) *sdkv2_schema.Resource {
 	var reader = MkRepoRead(packer, constructor)
+
+	// key changes are handled by CustomizeDiff, so that the repository can be renamed when `allow_rename` is set
+	skeema := lo.Assign(skeemas[1], AllowRenameSchemaSDKv2, ProjectSharesSchemaSDKv2)
+	keySchema := *skeema["key"]
+	keySchema.ForceNew = false
+	skeema["key"] = &keySchema
+
+	reader = readProjectShares(readAllowRename(reader))
+
 	return &sdkv2_schema.Resource{
 		CreateContext: MkRepoCreate(unpack, reader),
 		ReadContext:   reader,
@@ -1058,7 +1160,7 @@ func MkResourceSchema(skeemas map[int16]map[string]*sdkv2_schema.Schema, packer
 			StateContext: sdkv2_schema.ImportStatePassthroughContext,
 		},
 
-		Schema:        skeemas[1],
+		Schema:        skeema,
 		SchemaVersion: 1,
 		StateUpgraders: []sdkv2_schema.StateUpgrader{
 			{
@@ -1072,12 +1174,31 @@ func MkResourceSchema(skeemas map[int16]map[string]*sdkv2_schema.Schema, packer
 		},
 
 		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIf("key", KeyForceNewIf),
 			ProjectEnvironmentsDiff,
 			VerifyReleasebundlesKey,
 		),
 	}
 }
 
+// readAllowRename sets `allow_rename` to its default value when it's not in the state, e.g. after import.
+func readAllowRename(read sdkv2_schema.ReadContextFunc) sdkv2_schema.ReadContextFunc {
+	return func(ctx context.Context, d *sdkv2_schema.ResourceData, m interface{}) sdkv2_diag.Diagnostics {
+		ds := read(ctx, d, m)
+		if ds.HasError() || d.Id() == "" {
+			return ds
+		}
+
+		if _, ok := d.GetOkExists("allow_rename"); !ok {
+			if err := d.Set("allow_rename", false); err != nil {
+				return append(ds, sdkv2_diag.FromErr(err)...)
+			}
+		}
+
+		return ds
+	}
+}
+
 func Resource(skeema map[string]*sdkv2_schema.Schema) *sdkv2_schema.Resource {
 	return &sdkv2_schema.Resource{
 		Schema: skeema,
@@ -20,7 +20,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
@@ -220,7 +223,7 @@ func TestAccRepository_can_set_two_project_environments_before_7_53_1(t *testing
 			{
 				SkipFunc: func() (bool, error) {
 					meta := acctest.Provider.Meta().(util.ProviderMetadata)
-					return util.CheckVersion(meta.ArtifactoryVersion, repository.CustomProjectEnvironmentSupportedVersion)
+					return util.CheckVersion(meta.ArtifactoryVersion, capability.CustomProjectEnvironments.MinVersion)
 				},
 				Config: localRepositoryBasic,
 				Check: resource.ComposeTestCheckFunc(
@@ -278,7 +281,7 @@ func TestAccRepository_invalid_project_environments_before_7_53_1(t *testing.T)
 			{
 				SkipFunc: func() (bool, error) {
 					meta := acctest.Provider.Meta().(util.ProviderMetadata)
-					return util.CheckVersion(meta.ArtifactoryVersion, repository.CustomProjectEnvironmentSupportedVersion)
+					return util.CheckVersion(meta.ArtifactoryVersion, capability.CustomProjectEnvironments.MinVersion)
 				},
 				Config:      localRepositoryBasic,
 				ExpectError: regexp.MustCompile(".*project_environment Foo not allowed.*"),
@@ -332,12 +335,12 @@ func TestAccRepository_invalid_project_environments_after_7_53_1_before_7_107_1(
 			{
 				SkipFunc: func() (bool, error) {
 					meta := acctest.Provider.Meta().(util.ProviderMetadata)
-					isSupported, _ := util.CheckVersion(meta.ArtifactoryVersion, repository.CustomProjectEnvironmentSupportedVersion)
-					multiSupported, _ := util.CheckVersion(meta.ArtifactoryVersion, repository.MultipleEnvironmentsSupportedVersion)
+					isSupported, _ := util.CheckVersion(meta.ArtifactoryVersion, capability.CustomProjectEnvironments.MinVersion)
+					multiSupported, _ := util.CheckVersion(meta.ArtifactoryVersion, capability.MultipleProjectEnvironments.MinVersion)
 					return !(isSupported && !multiSupported), nil
 				},
 				Config:      localRepositoryBasic,
-				ExpectError: regexp.MustCompile(fmt.Sprintf(".*for Artifactory versions %s to %s, only one environment.*", repository.CustomProjectEnvironmentSupportedVersion, repository.MultipleEnvironmentsSupportedVersion)),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(".*for Artifactory versions %s to %s, only one environment.*", capability.CustomProjectEnvironments.MinVersion, capability.MultipleProjectEnvironments.MinVersion)),
 			},
 		},
 	})
@@ -392,7 +395,7 @@ func TestAccRepository_can_set_multi_project_environments_after_7_107_1(t *testi
 			{
 				SkipFunc: func() (bool, error) {
 					meta := acctest.Provider.Meta().(util.ProviderMetadata)
-					multiSupport, err := util.CheckVersion(meta.ArtifactoryVersion, repository.MultipleEnvironmentsSupportedVersion)
+					multiSupport, err := util.CheckVersion(meta.ArtifactoryVersion, capability.MultipleProjectEnvironments.MinVersion)
 					return !multiSupport, err
 				},
 				Config: localRepositoryBasic,
@@ -430,7 +433,7 @@ func TestAccRepository_can_set_empty_project_environments_after_7_107_1(t *testi
 			{
 				SkipFunc: func() (bool, error) {
 					meta := acctest.Provider.Meta().(util.ProviderMetadata)
-					multiSupport, err := util.CheckVersion(meta.ArtifactoryVersion, repository.MultipleEnvironmentsSupportedVersion)
+					multiSupport, err := util.CheckVersion(meta.ArtifactoryVersion, capability.MultipleProjectEnvironments.MinVersion)
 					return !multiSupport, err
 				},
 				Config: localRepositoryWithEmptyEnvironments,
@@ -529,3 +532,514 @@ func TestAccRepository_unknown_project_environments(t *testing.T) {
 		},
 	})
 }
+
+func TestAccRepository_repository_defaults(t *testing.T) {
+	_, localFqrn, localName := testutil.MkNames("test-generic-local-", "artifactory_local_generic_repository")
+	_, overrideFqrn, overrideName := testutil.MkNames("test-generic-local-", "artifactory_local_generic_repository")
+	_, remoteFqrn, remoteName := testutil.MkNames("test-generic-remote-", "artifactory_remote_generic_repository")
+
+	params := map[string]interface{}{
+		"localName":    localName,
+		"overrideName": overrideName,
+		"remoteName":   remoteName,
+	}
+	config := util.ExecuteTemplate("TestAccRepositoryDefaults", `
+		provider "artifactory" {
+			repository_defaults {
+				rclass           = "local"
+				notes            = "org default notes"
+				includes_pattern = "org/**"
+				xray_index       = true
+			}
+
+			repository_defaults {
+				rclass        = "local"
+				package_types = ["generic"]
+				notes         = "generic default notes"
+			}
+
+			repository_defaults {
+				rclass                = "remote"
+				socket_timeout_millis = 25000
+			}
+		}
+
+		resource "artifactory_local_generic_repository" "{{ .localName }}" {
+			key = "{{ .localName }}"
+		}
+
+		resource "artifactory_local_generic_repository" "{{ .overrideName }}" {
+			key              = "{{ .overrideName }}"
+			notes            = "repository notes"
+			includes_pattern = "**/*"
+		}
+
+		resource "artifactory_remote_generic_repository" "{{ .remoteName }}" {
+			key = "{{ .remoteName }}"
+			url = "https://tempurl.org/"
+		}
+	`, params)
+
+	invalidConfig := util.ExecuteTemplate("TestAccRepositoryDefaultsInvalid", `
+		provider "artifactory" {
+			repository_defaults {
+				rclass = "local"
+				proxy  = "my-proxy"
+			}
+		}
+
+		resource "artifactory_local_generic_repository" "{{ .localName }}" {
+			key = "{{ .localName }}"
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: acctest.CompositeCheckDestroy(
+			acctest.VerifyDeleted(t, localFqrn, "key", acctest.CheckRepo),
+			acctest.VerifyDeleted(t, overrideFqrn, "key", acctest.CheckRepo),
+			acctest.VerifyDeleted(t, remoteFqrn, "key", acctest.CheckRepo),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config:      invalidConfig,
+				ExpectError: regexp.MustCompile(`proxy can only be set for rclass 'remote'`),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(localFqrn, "notes", "generic default notes"),
+					resource.TestCheckResourceAttr(localFqrn, "includes_pattern", "org/**"),
+					resource.TestCheckResourceAttr(localFqrn, "xray_index", "true"),
+					resource.TestCheckResourceAttr(overrideFqrn, "notes", "repository notes"),
+					resource.TestCheckResourceAttr(overrideFqrn, "includes_pattern", "**/*"),
+					resource.TestCheckResourceAttr(overrideFqrn, "xray_index", "true"),
+					resource.TestCheckResourceAttr(remoteFqrn, "socket_timeout_millis", "25000"),
+					resource.TestCheckResourceAttr(remoteFqrn, "notes", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRepository_rename(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-generic-local-", "artifactory_local_generic_repository")
+	_, virtualFqrn, virtualName := testutil.MkNames("test-generic-virtual-", "artifactory_virtual_generic_repository")
+	newKey := fmt.Sprintf("%s-renamed", name)
+
+	const template = `
+		resource "artifactory_local_generic_repository" "{{ .name }}" {
+			key          = "{{ .key }}"
+			allow_rename = true
+		}
+
+		resource "artifactory_virtual_generic_repository" "{{ .virtualName }}" {
+			key          = "{{ .virtualName }}"
+			repositories = [artifactory_local_generic_repository.{{ .name }}.key]
+			allow_rename = true
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccRepositoryRename", template, map[string]string{
+		"name":        name,
+		"key":         name,
+		"virtualName": virtualName,
+	})
+
+	renamedConfig := util.ExecuteTemplate("TestAccRepositoryRename", template, map[string]string{
+		"name":        name,
+		"key":         newKey,
+		"virtualName": virtualName,
+	})
+
+	artifactExists := func(key string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			resp, err := acctest.GetTestResty(t).R().
+				SetPathParam("key", key).
+				Head("artifactory/{key}/test/artifact.txt")
+			if err != nil {
+				return err
+			}
+			if resp.IsError() {
+				return fmt.Errorf("artifact not found in repository %s: %s", key, resp.Status())
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: acctest.CompositeCheckDestroy(
+			acctest.VerifyDeleted(t, fqrn, "key", acctest.CheckRepo),
+			acctest.VerifyDeleted(t, virtualFqrn, "key", acctest.CheckRepo),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(fqrn, "key", name),
+			},
+			{
+				PreConfig: func() {
+					_, err := acctest.GetTestResty(t).R().
+						SetPathParam("key", name).
+						SetBody("test artifact").
+						Put("artifactory/{key}/test/artifact.txt")
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: renamedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", newKey),
+					resource.TestCheckResourceAttr(virtualFqrn, "repositories.0", newKey),
+					artifactExists(newKey),
+					func(*terraform.State) error {
+						resp, err := acctest.CheckRepo(name, acctest.GetTestResty(t).R())
+						if err != nil {
+							return err
+						}
+						if !resp.IsError() {
+							return fmt.Errorf("repository %s still exists", name)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccRepository_unified(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-npm-local-", "artifactory_repository")
+
+	const template = `
+		resource "artifactory_repository" "{{ .name }}" {
+			key          = "{{ .name }}"
+			rclass       = "local"
+			package_type = "npm"
+
+			settings = {
+				description   = "{{ .description }}"
+				xray_index    = true
+				property_sets = ["artifactory"]
+			}
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccRepositoryUnified", template, map[string]string{
+		"name":        name,
+		"description": "test description",
+	})
+
+	updatedConfig := util.ExecuteTemplate("TestAccRepositoryUnified", template, map[string]string{
+		"name":        name,
+		"description": "updated description",
+	})
+
+	const invalidConfig = `
+		resource "artifactory_repository" "invalid" {
+			key          = "invalid-unified-repo"
+			rclass       = "local"
+			package_type = "npm"
+
+			settings = {
+				includes_pattern = ""
+				unknown_setting  = true
+			}
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.VerifyDeleted(t, fqrn, "key", acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config:      invalidConfig,
+				ExpectError: regexp.MustCompile(`unsupported attribute\(s\): unknown_setting`),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "rclass", "local"),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "npm"),
+					resource.TestCheckResourceAttr(fqrn, "settings.description", "test description"),
+					resource.TestCheckResourceAttr(fqrn, "settings.xray_index", "true"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(fqrn, "settings.description", "updated description"),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateId:                        name,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "key",
+				ImportStateVerifyIgnore:              []string{"settings"},
+			},
+		},
+	})
+}
+
+func TestAccRepository_unified_moved(t *testing.T) {
+	_, typedFqrn, name := testutil.MkNames("test-generic-local-", "artifactory_local_generic_repository")
+	fqrn := fmt.Sprintf("artifactory_repository.%s", name)
+
+	typedConfig := util.ExecuteTemplate("TestAccRepositoryUnifiedMoved", `
+		resource "artifactory_local_generic_repository" "{{ .name }}" {
+			key         = "{{ .name }}"
+			description = "test description"
+		}
+	`, map[string]string{
+		"name": name,
+	})
+
+	movedConfig := util.ExecuteTemplate("TestAccRepositoryUnifiedMoved", `
+		resource "artifactory_repository" "{{ .name }}" {
+			key          = "{{ .name }}"
+			rclass       = "local"
+			package_type = "generic"
+
+			settings = {
+				description = "test description"
+			}
+		}
+
+		moved {
+			from = artifactory_local_generic_repository.{{ .name }}
+			to   = artifactory_repository.{{ .name }}
+		}
+	`, map[string]string{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.VerifyDeleted(t, fqrn, "key", acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: typedConfig,
+				Check:  resource.TestCheckResourceAttr(typedFqrn, "key", name),
+			},
+			{
+				Config: movedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "generic"),
+					resource.TestCheckResourceAttr(fqrn, "settings.description", "test description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRepository_reassign_project_key(t *testing.T) {
+	projectKey := fmt.Sprintf("t%d", testutil.RandomInt())
+	otherProjectKey := fmt.Sprintf("t%d", testutil.RandomInt())
+	repoName := fmt.Sprintf("%s-generic-local", projectKey)
+
+	_, fqrn, name := testutil.MkNames(repoName, "artifactory_local_generic_repository")
+
+	const template = `
+		resource "artifactory_local_generic_repository" "{{ .name }}" {
+		  key         = "{{ .name }}"
+		  project_key = "{{ .projectKey }}"
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccRepository_reassign_project_key", template, map[string]interface{}{
+		"name":       name,
+		"projectKey": projectKey,
+	})
+
+	reassignedConfig := util.ExecuteTemplate("TestAccRepository_reassign_project_key", template, map[string]interface{}{
+		"name":       name,
+		"projectKey": otherProjectKey,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateProject(t, projectKey)
+			acctest.CreateProject(t, otherProjectKey)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: acctest.CompositeCheckDestroy(
+			acctest.VerifyDeleted(t, fqrn, "key", acctest.CheckRepo),
+			func(*terraform.State) error {
+				acctest.DeleteProject(t, projectKey)
+				acctest.DeleteProject(t, otherProjectKey)
+				return nil
+			},
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(fqrn, "project_key", projectKey),
+			},
+			{
+				Config: reassignedConfig,
+				Check:  resource.TestCheckResourceAttr(fqrn, "project_key", otherProjectKey),
+			},
+			{
+				// reassign the repository outside of Terraform
+				PreConfig: func() {
+					if err := repository.AssignRepoToProject(name, projectKey, acctest.GetTestResty(t)); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: reassignedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(fqrn, "project_key", otherProjectKey),
+			},
+		},
+	})
+}
+
+func TestAccRepository_project_shares(t *testing.T) {
+	projectKey := fmt.Sprintf("t%d", testutil.RandomInt())
+	sharedProjectKey := fmt.Sprintf("t%d", testutil.RandomInt())
+	repoName := fmt.Sprintf("%s-generic-local", projectKey)
+
+	_, fqrn, name := testutil.MkNames(repoName, "artifactory_local_generic_repository")
+
+	const template = `
+		resource "artifactory_local_generic_repository" "{{ .name }}" {
+		  key          = "{{ .key }}"
+		  project_key  = "{{ .projectKey }}"
+		  allow_rename = true
+
+		  project_shares = [{
+		    project_key  = "{{ .sharedProjectKey }}"
+		    read_only    = {{ .readOnly }}
+		    environments = ["DEV"]
+		  }]
+		}
+	`
+
+	testData := map[string]interface{}{
+		"name":             name,
+		"key":              name,
+		"projectKey":       projectKey,
+		"sharedProjectKey": sharedProjectKey,
+		"readOnly":         false,
+	}
+	config := util.ExecuteTemplate("TestAccRepository_project_shares", template, testData)
+
+	testData["readOnly"] = true
+	readOnlyConfig := util.ExecuteTemplate("TestAccRepository_project_shares", template, testData)
+
+	newKey := fmt.Sprintf("%s-renamed", name)
+	testData["key"] = newKey
+	renamedConfig := util.ExecuteTemplate("TestAccRepository_project_shares", template, testData)
+
+	checkRenamedRepo := func(*terraform.State) error {
+		var repo struct {
+			ProjectKey string `json:"projectKey"`
+		}
+		resp, err := acctest.GetTestResty(t).R().
+			SetPathParam("key", newKey).
+			SetResult(&repo).
+			Get("artifactory/api/repositories/{key}")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() || repo.ProjectKey != projectKey {
+			return fmt.Errorf("expected repository %s in project %s, got %s: %s", newKey, projectKey, repo.ProjectKey, resp.Status())
+		}
+
+		var shares struct {
+			SharedWithProjects []struct {
+				ProjectKey string `json:"project_key"`
+			} `json:"shared_with_projects"`
+		}
+		resp, err = acctest.GetTestResty(t).R().
+			SetPathParam("key", newKey).
+			SetResult(&shares).
+			Get("access/api/v1/projects/_/share/repositories/{key}")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() || len(shares.SharedWithProjects) != 1 || shares.SharedWithProjects[0].ProjectKey != sharedProjectKey {
+			return fmt.Errorf("expected repository %s to be shared with project %s, got %v: %s", newKey, sharedProjectKey, shares.SharedWithProjects, resp.Status())
+		}
+
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateProject(t, projectKey)
+			acctest.CreateProject(t, sharedProjectKey)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: acctest.CompositeCheckDestroy(
+			acctest.VerifyDeleted(t, fqrn, "key", acctest.CheckRepo),
+			func(*terraform.State) error {
+				acctest.DeleteProject(t, projectKey)
+				acctest.DeleteProject(t, sharedProjectKey)
+				return nil
+			},
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "project_shares.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "project_shares.0.project_key", sharedProjectKey),
+					resource.TestCheckResourceAttr(fqrn, "project_shares.0.read_only", "false"),
+					resource.TestCheckResourceAttr(fqrn, "project_shares.0.environments.#", "1"),
+				),
+			},
+			{
+				Config: readOnlyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "project_shares.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "project_shares.0.read_only", "true"),
+				),
+			},
+			{
+				Config: renamedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", newKey),
+					resource.TestCheckResourceAttr(fqrn, "project_shares.#", "1"),
+					checkRenamedRepo,
+				),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateId:                        newKey,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "key",
+				ImportStateVerifyIgnore:              []string{"project_shares", "allow_rename"},
+			},
+		},
+	})
+}
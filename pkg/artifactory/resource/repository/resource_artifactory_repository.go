@@ -0,0 +1,813 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+const UnifiedRepositoryTypeName = "artifactory_repository"
+
+// typedRepositoryTypeNameRegex matches the type names of the typed repository resources that can be
+// managed with `artifactory_repository`, e.g. `artifactory_remote_docker_repository`.
+var typedRepositoryTypeNameRegex = regexp.MustCompile(`^artifactory_(local|remote|virtual)_(.+)_repository$`)
+
+// federatedRepositoryTypeNameRegex matches the type names of the federated repository resources. Their members
+// are not part of `settings`, so federated repositories are rejected with a clear error instead.
+var federatedRepositoryTypeNameRegex = regexp.MustCompile(`^artifactory_federated_(.+)_repository$`)
+
+const federatedRepositoryUnsupportedDetail = "federated repositories, and their members, can't be managed with `artifactory_repository`, " +
+	"use the `artifactory_federated_<package_type>_repository` resources instead"
+
+// NewUnifiedRepositoryResource returns the constructor of the `artifactory_repository` resource, which manages any
+// repository supported by the given typed repository resources (e.g. `artifactory_local_npm_repository`) by delegating
+// to them. The package specific attributes are set in the dynamic `settings` attribute and validated against the
+// schema of the typed resource.
+func NewUnifiedRepositoryResource(typedResources []func() resource.Resource) func() resource.Resource {
+	constructors := map[string]func() resource.Resource{}
+
+	for _, constructor := range typedResources {
+		metadataResp := resource.MetadataResponse{}
+		constructor().Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "artifactory"}, &metadataResp)
+
+		// framework repository resources are not all managed by a BaseResource, e.g. virtual repositories
+		// override the model, so they are matched by type name
+		if typedRepositoryTypeNameRegex.MatchString(metadataResp.TypeName) {
+			constructors[metadataResp.TypeName] = constructor
+		}
+	}
+
+	return func() resource.Resource {
+		return &UnifiedRepositoryResource{
+			JFrogResource: util.JFrogResource{
+				TypeName:           UnifiedRepositoryTypeName,
+				CollectionEndpoint: "artifactory/api/repositories",
+				DocumentEndpoint:   "artifactory/api/repositories/{key}",
+			},
+			typedResources: constructors,
+		}
+	}
+}
+
+type UnifiedRepositoryResource struct {
+	util.JFrogResource
+	typedResources map[string]func() resource.Resource
+}
+
+func (r *UnifiedRepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.JFrogResource.Configure(ctx, req, resp)
+	if r.ProviderData != nil {
+		meta := httplog.ForResource(*r.ProviderData, r.TypeName)
+		r.ProviderData = &meta
+	}
+}
+
+type UnifiedRepositoryResourceModel struct {
+	Key         types.String  `tfsdk:"key"`
+	Rclass      types.String  `tfsdk:"rclass"`
+	PackageType types.String  `tfsdk:"package_type"`
+	Settings    types.Dynamic `tfsdk:"settings"`
+}
+
+func typedRepositoryTypeName(rclass, packageType string) string {
+	return fmt.Sprintf("artifactory_%s_%s_repository", rclass, packageType)
+}
+
+// packageTypes returns the supported package types of each rclass.
+func (r *UnifiedRepositoryResource) packageTypes() map[string][]string {
+	packageTypes := map[string][]string{}
+
+	for typeName := range r.typedResources {
+		matches := typedRepositoryTypeNameRegex.FindStringSubmatch(typeName)
+		packageTypes[matches[1]] = append(packageTypes[matches[1]], matches[2])
+	}
+
+	for _, p := range packageTypes {
+		sort.Strings(p)
+	}
+
+	return packageTypes
+}
+
+// unifiedKeyPlanModifier replaces the repository when `key` changes, unless `allow_rename` is set in `settings`.
+var unifiedKeyPlanModifier = stringplanmodifier.RequiresReplaceIf(
+	func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		var settings types.Dynamic
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("settings"), &settings)...)
+
+		values, diags := settingsValues(ctx, settings)
+		resp.Diagnostics.Append(diags...)
+
+		allowRename := false
+		if value, ok := values["allow_rename"]; ok && value.IsKnown() && !value.IsNull() {
+			// a non-boolean value is reported by the settings validation
+			_ = value.As(&allowRename)
+		}
+
+		resp.RequiresReplace = !allowRename
+	},
+	"Changing the key replaces the repository, unless settings.allow_rename is set to true.",
+	"Changing the `key` replaces the repository, unless `settings.allow_rename` is set to `true`.",
+)
+
+func (r *UnifiedRepositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.RepoKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					unifiedKeyPlanModifier,
+				},
+				Description: "A mandatory identifier for the repository that must be unique. Must be 1 - 64 alphanumeric and hyphen characters. It cannot contain spaces or special characters.",
+			},
+			"rclass": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(RepositoryDefaultsRclasses...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: fmt.Sprintf("Repository class. One of: %s. Changing it replaces the repository.", strings.Join(RepositoryDefaultsRclasses, ", ")),
+			},
+			"package_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Package type, as used in the name of the typed repository resource, e.g. `npm`, `docker_v2` or `terraform_module` " +
+					"for `artifactory_local_terraform_module_repository`. Changing it replaces the repository.",
+			},
+			"settings": schema.DynamicAttribute{
+				Optional: true,
+				Description: "Object with the package specific attributes of the repository. The supported attributes, their defaults and " +
+					"validations are the ones of the typed repository resource for `rclass` and `package_type`, e.g. `artifactory_remote_npm_repository`, " +
+					"except `key`. Only the attributes set here are checked for drift.",
+			},
+		},
+		MarkdownDescription: "Provides a resource to manage a repository of any repository class and package type supported by the " +
+			"typed repository resources, e.g. `artifactory_local_npm_repository`. The package specific attributes are set in `settings`.",
+	}
+}
+
+// typedRepository is the typed repository resource for one `artifactory_repository` instance.
+type typedRepository struct {
+	resource resource.Resource
+	schema   schema.Schema
+}
+
+func (r *UnifiedRepositoryResource) typedRepository(ctx context.Context, rclass, packageType string) (*typedRepository, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	constructor, ok := r.typedResources[typedRepositoryTypeName(rclass, packageType)]
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("package_type"),
+			"Unsupported package type",
+			fmt.Sprintf("package type '%s' is not supported for rclass '%s', must be one of: %s", packageType, rclass, strings.Join(r.packageTypes()[rclass], ", ")),
+		)
+		return nil, diags
+	}
+
+	typed := constructor()
+
+	if r.ProviderData != nil {
+		if configurable, ok := typed.(resource.ResourceWithConfigure); ok {
+			configureResp := resource.ConfigureResponse{}
+			configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: *r.ProviderData}, &configureResp)
+			diags.Append(configureResp.Diagnostics...)
+		}
+	}
+
+	schemaResp := resource.SchemaResponse{}
+	typed.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	diags.Append(schemaResp.Diagnostics...)
+
+	return &typedRepository{
+		resource: typed,
+		schema:   schemaResp.Schema,
+	}, diags
+}
+
+// typedConfig returns the configuration of the typed repository resource.
+func (t *typedRepository) config(ctx context.Context, key tftypes.Value, settings map[string]tftypes.Value) (tfsdk.Config, diag.Diagnostics) {
+	raw, diags := typedValue(ctx, t.schema, key, settings, typedConfigValue)
+	return tfsdk.Config{Schema: t.schema, Raw: raw}, diags
+}
+
+// state returns the state of the typed repository resource, as it was last planned.
+func (t *typedRepository) state(ctx context.Context, key tftypes.Value, settings map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	raw, diags := typedValue(ctx, t.schema, key, settings, typedStateValue)
+	return tfsdk.State{Schema: t.schema, Raw: raw}, diags
+}
+
+// plan returns the plan of the typed repository resource, including the changes of its plan modifier, e.g. the
+// provider `repository_defaults`.
+func (t *typedRepository) plan(ctx context.Context, config tfsdk.Config, state tfsdk.State, key tftypes.Value, settings map[string]tftypes.Value) (tfsdk.Plan, bool, diag.Diagnostics) {
+	raw, diags := typedValue(ctx, t.schema, key, settings, typedPlanValue)
+	plan := tfsdk.Plan{Schema: t.schema, Raw: raw}
+	if diags.HasError() {
+		return plan, false, diags
+	}
+
+	modifier, ok := t.resource.(resource.ResourceWithModifyPlan)
+	if !ok {
+		return plan, false, diags
+	}
+
+	modifyPlanResp := resource.ModifyPlanResponse{Plan: plan}
+	modifier.ModifyPlan(
+		ctx,
+		resource.ModifyPlanRequest{
+			Config: config,
+			Plan:   plan,
+			State:  state,
+		},
+		&modifyPlanResp,
+	)
+	diags.Append(settingsDiagnostics(modifyPlanResp.Diagnostics)...)
+
+	return modifyPlanResp.Plan, len(modifyPlanResp.RequiresReplace) > 0, diags
+}
+
+// validate runs the configuration validation of the typed repository resource.
+func (t *typedRepository) validate(ctx context.Context, config tfsdk.Config, settings map[string]tftypes.Value) diag.Diagnostics {
+	diags := validateTypedConfig(ctx, t.schema, settings, config)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v, ok := t.resource.(resource.ResourceWithConfigValidators); ok {
+		for _, configValidator := range v.ConfigValidators(ctx) {
+			validateResp := resource.ValidateConfigResponse{}
+			configValidator.ValidateResource(ctx, resource.ValidateConfigRequest{Config: config}, &validateResp)
+			diags.Append(settingsDiagnostics(validateResp.Diagnostics)...)
+		}
+	}
+
+	if v, ok := t.resource.(resource.ResourceWithValidateConfig); ok {
+		validateResp := resource.ValidateConfigResponse{}
+		v.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &validateResp)
+		diags.Append(settingsDiagnostics(validateResp.Diagnostics)...)
+	}
+
+	return diags
+}
+
+func (r *UnifiedRepositoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan UnifiedRepositoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// validation is deferred until the repository type and settings are known
+	if plan.Rclass.IsUnknown() || plan.PackageType.IsUnknown() || plan.Settings.IsUnknown() || plan.Settings.IsUnderlyingValueUnknown() {
+		return
+	}
+
+	typed, diags := r.typedRepository(ctx, plan.Rclass.ValueString(), plan.PackageType.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := settingsValues(ctx, plan.Settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := plan.Key.ToTerraformValue(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get key", err.Error())
+		return
+	}
+
+	config, diags := typed.config(ctx, key, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(typed.validate(ctx, config, settings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := tfsdk.State{Schema: typed.schema, Raw: tftypes.NewValue(typed.schema.Type().TerraformType(ctx), nil)}
+	if !req.State.Raw.IsNull() {
+		var priorState UnifiedRepositoryResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state, diags = r.typedState(ctx, typed, priorState)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	_, requiresReplace, diags := typed.plan(ctx, config, state, key, settings)
+	resp.Diagnostics.Append(diags...)
+
+	if requiresReplace {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("settings"))
+	}
+}
+
+// typedState returns the state of the typed repository resource from the `artifactory_repository` state.
+func (r *UnifiedRepositoryResource) typedState(ctx context.Context, typed *typedRepository, state UnifiedRepositoryResourceModel) (tfsdk.State, diag.Diagnostics) {
+	settings, diags := settingsValues(ctx, state.Settings)
+	if diags.HasError() {
+		return tfsdk.State{}, diags
+	}
+
+	key, err := state.Key.ToTerraformValue(ctx)
+	if err != nil {
+		diags.AddError("Failed to get key", err.Error())
+		return tfsdk.State{}, diags
+	}
+
+	typedState, d := typed.state(ctx, key, settings)
+	diags.Append(d...)
+
+	return typedState, diags
+}
+
+// typedPlan returns the configuration and plan of the typed repository resource from the `artifactory_repository`
+// configuration and plan.
+func (r *UnifiedRepositoryResource) typedPlan(ctx context.Context, typed *typedRepository, plan UnifiedRepositoryResourceModel, state tfsdk.State) (tfsdk.Config, tfsdk.Plan, diag.Diagnostics) {
+	settings, diags := settingsValues(ctx, plan.Settings)
+	if diags.HasError() {
+		return tfsdk.Config{}, tfsdk.Plan{}, diags
+	}
+
+	key, err := plan.Key.ToTerraformValue(ctx)
+	if err != nil {
+		diags.AddError("Failed to get key", err.Error())
+		return tfsdk.Config{}, tfsdk.Plan{}, diags
+	}
+
+	config, d := typed.config(ctx, key, settings)
+	diags.Append(d...)
+	if diags.HasError() {
+		return config, tfsdk.Plan{}, diags
+	}
+
+	typedPlan, _, d := typed.plan(ctx, config, state, key, settings)
+	diags.Append(d...)
+
+	return config, typedPlan, diags
+}
+
+func (r *UnifiedRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UnifiedRepositoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typed, diags := r.typedRepository(ctx, plan.Rclass.ValueString(), plan.PackageType.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nullState := tfsdk.State{Schema: typed.schema, Raw: tftypes.NewValue(typed.schema.Type().TerraformType(ctx), nil)}
+	config, typedPlan, diags := r.typedPlan(ctx, typed, plan, nullState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp := resource.CreateResponse{State: nullState}
+	typed.resource.Create(ctx, resource.CreateRequest{Config: config, Plan: typedPlan}, &createResp)
+	resp.Diagnostics.Append(settingsDiagnostics(createResp.Diagnostics)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UnifiedRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UnifiedRepositoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typed, diags := r.typedRepository(ctx, state.Rclass.ValueString(), state.PackageType.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typedState, diags := r.typedState(ctx, typed, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResp := resource.ReadResponse{State: typedState}
+	typed.resource.Read(ctx, resource.ReadRequest{State: typedState}, &readResp)
+	resp.Diagnostics.Append(settingsDiagnostics(readResp.Diagnostics)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Treat HTTP 404 Not Found status as a signal to recreate resource
+	// and return early
+	if readResp.State.Raw.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	settings, diags := settingsValues(ctx, state.Settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the attributes managed in `settings` are refreshed, so that the computed attributes
+	// and the defaults of the typed repository resource don't show as drift
+	drifted := false
+	for name := range settings {
+		p := path.Root(name)
+
+		var priorValue, newValue attr.Value
+		resp.Diagnostics.Append(typedState.GetAttribute(ctx, p, &priorValue)...)
+		resp.Diagnostics.Append(readResp.State.GetAttribute(ctx, p, &newValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if priorValue.Equal(newValue) {
+			continue
+		}
+
+		tfValue, err := newValue.ToTerraformValue(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read settings", err.Error())
+			return
+		}
+
+		settings[name] = tfValue
+		drifted = true
+	}
+
+	if drifted {
+		resp.Diagnostics.Append(state.setSettings(ctx, settings)...)
+	}
+
+	var key types.String
+	resp.Diagnostics.Append(readResp.State.GetAttribute(ctx, path.Root("key"), &key)...)
+	state.Key = key
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (m *UnifiedRepositoryResourceModel) setSettings(ctx context.Context, settings map[string]tftypes.Value) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	tfValue, err := settingsObject(settings)
+	if err != nil {
+		diags.AddAttributeError(path.Root("settings"), "Invalid settings", err.Error())
+		return diags
+	}
+
+	value, err := types.DynamicType.ValueFromTerraform(ctx, tfValue)
+	if err != nil {
+		diags.AddAttributeError(path.Root("settings"), "Invalid settings", err.Error())
+		return diags
+	}
+
+	m.Settings = value.(types.Dynamic)
+
+	return diags
+}
+
+func (r *UnifiedRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state UnifiedRepositoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typed, diags := r.typedRepository(ctx, plan.Rclass.ValueString(), plan.PackageType.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typedState, diags := r.typedState(ctx, typed, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, typedPlan, diags := r.typedPlan(ctx, typed, plan, typedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResp := resource.UpdateResponse{State: typedState}
+	typed.resource.Update(ctx, resource.UpdateRequest{Config: config, Plan: typedPlan, State: typedState}, &updateResp)
+	resp.Diagnostics.Append(settingsDiagnostics(updateResp.Diagnostics)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UnifiedRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UnifiedRepositoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typed, diags := r.typedRepository(ctx, state.Rclass.ValueString(), state.PackageType.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typedState, diags := r.typedState(ctx, typed, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteResp := resource.DeleteResponse{State: typedState}
+	typed.resource.Delete(ctx, resource.DeleteRequest{State: typedState}, &deleteResp)
+	resp.Diagnostics.Append(settingsDiagnostics(deleteResp.Diagnostics)...)
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+type unifiedRepositoryAPIModel struct {
+	Rclass      string `json:"rclass"`
+	PackageType string `json:"packageType"`
+}
+
+// ImportState imports the resource into the Terraform state. The import ID is the repository key, or
+// `key:rclass:package_type` when the package type can't be derived from the repository configuration,
+// e.g. for Docker V1 or Terraform module repositories.
+func (r *UnifiedRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 3)
+
+	key := parts[0]
+	var rclass, packageType string
+
+	switch len(parts) {
+	case 3:
+		rclass, packageType = parts[1], parts[2]
+	case 1:
+		var repo unifiedRepositoryAPIModel
+		var jfrogErrors util.JFrogErrors
+		response, err := r.ProviderData.Client.R().
+			SetPathParam("key", key).
+			SetResult(&repo).
+			SetError(&jfrogErrors).
+			Get(r.DocumentEndpoint)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import Resource", err.Error())
+			return
+		}
+		if response.StatusCode() == http.StatusBadRequest || response.StatusCode() == http.StatusNotFound {
+			resp.Diagnostics.AddError("Repository not found", fmt.Sprintf("repository %s does not exist", key))
+			return
+		}
+		if response.IsError() {
+			resp.Diagnostics.AddError("Unable to Import Resource", jfrogErrors.String())
+			return
+		}
+
+		rclass, packageType = repo.Rclass, strings.ToLower(repo.PackageType)
+		if rclass != "federated" && !slices.Contains(r.packageTypes()[rclass], packageType) {
+			resp.Diagnostics.AddError(
+				"Unable to determine package type",
+				fmt.Sprintf("package type '%s' of repository %s has no matching resource, use the import ID format 'key:rclass:package_type'", repo.PackageType, key),
+			)
+			return
+		}
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("expected 'key' or 'key:rclass:package_type', got '%s'", req.ID),
+		)
+		return
+	}
+
+	if rclass == "federated" {
+		resp.Diagnostics.AddError(
+			"Unsupported repository class",
+			fmt.Sprintf("repository %s is federated: %s", key, federatedRepositoryUnsupportedDetail),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rclass"), rclass)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_type"), packageType)...)
+}
+
+// MoveState supports `moved` blocks from the typed repository resources, e.g. from
+// `artifactory_local_npm_repository.my-repo` to `artifactory_repository.my-repo`. Moves from the federated
+// repository resources fail.
+func (r *UnifiedRepositoryResource) MoveState(ctx context.Context) []resource.StateMover {
+	movers := lo.MapToSlice(r.typedResources, func(typeName string, constructor func() resource.Resource) resource.StateMover {
+		return resource.StateMover{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != typeName || req.SourceRawState == nil {
+					return
+				}
+
+				matches := typedRepositoryTypeNameRegex.FindStringSubmatch(typeName)
+
+				schemaResp := resource.SchemaResponse{}
+				constructor().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+				resp.Diagnostics.Append(schemaResp.Diagnostics...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				typedSchema := schemaResp.Schema
+
+				sourceValue, err := req.SourceRawState.UnmarshalWithOpts(
+					typedSchema.Type().TerraformType(ctx),
+					tfprotov6.UnmarshalOpts{
+						ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+							IgnoreUndefinedAttributes: true,
+						},
+					},
+				)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to read source state", err.Error())
+					return
+				}
+
+				sourceState := tfsdk.State{Schema: typedSchema, Raw: sourceValue}
+
+				var key types.String
+				resp.Diagnostics.Append(sourceState.GetAttribute(ctx, path.Root("key"), &key)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				settings, diags := movedSettings(ctx, typedSchema, sourceState)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				state := UnifiedRepositoryResourceModel{
+					Key:         key,
+					Rclass:      types.StringValue(matches[1]),
+					PackageType: types.StringValue(matches[2]),
+					Settings:    types.DynamicNull(),
+				}
+
+				if len(settings) > 0 {
+					resp.Diagnostics.Append(state.setSettings(ctx, settings)...)
+					if resp.Diagnostics.HasError() {
+						return
+					}
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &state)...)
+			},
+		}
+	})
+
+	return append(movers, resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if !federatedRepositoryTypeNameRegex.MatchString(req.SourceTypeName) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Unsupported repository class",
+				fmt.Sprintf("%s can't be moved to %s: %s", req.SourceTypeName, UnifiedRepositoryTypeName, federatedRepositoryUnsupportedDetail),
+			)
+		},
+	})
+}
+
+// movedSettings returns the attributes of the typed repository resource state that would be configured:
+// the ones that are set and differ from their default. Computed only attributes are omitted.
+func movedSettings(ctx context.Context, typedSchema schema.Schema, state tfsdk.State) (map[string]tftypes.Value, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	settings := map[string]tftypes.Value{}
+
+	for name, attribute := range typedSchema.Attributes {
+		if name == "key" {
+			continue
+		}
+
+		var value attr.Value
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...)
+		if diags.HasError() {
+			return settings, diags
+		}
+
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		defaultValue, d := attributeDefault(ctx, attribute, path.Root(name))
+		diags.Append(d...)
+
+		if defaultValue != nil {
+			if value.Equal(defaultValue) {
+				continue
+			}
+		} else if attribute.IsComputed() {
+			continue
+		}
+
+		tfValue, err := value.ToTerraformValue(ctx)
+		if err != nil {
+			diags.AddError("Unable to read source state", err.Error())
+			return settings, diags
+		}
+		settings[name] = tfValue
+	}
+
+	for name := range typedSchema.Blocks {
+		var value attr.Value
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...)
+		if diags.HasError() {
+			return settings, diags
+		}
+
+		tfValue, err := value.ToTerraformValue(ctx)
+		if err != nil {
+			diags.AddError("Unable to read source state", err.Error())
+			return settings, diags
+		}
+
+		var elements []tftypes.Value
+		if tfValue.IsNull() || !tfValue.IsKnown() || (tfValue.As(&elements) == nil && len(elements) == 0) {
+			continue
+		}
+		settings[name] = tfValue
+	}
+
+	return settings, diags
+}
@@ -0,0 +1,42 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Federated repositories are rejected with an error rather than managed without their members.
+func TestUnifiedRepositoryRejectsFederated(t *testing.T) {
+	ctx := context.Background()
+	r := NewUnifiedRepositoryResource(nil)().(*UnifiedRepositoryResource)
+
+	importResp := resource.ImportStateResponse{}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "my-repo:federated:npm"}, &importResp)
+	if !importResp.Diagnostics.HasError() {
+		t.Error("expected an error importing a federated repository")
+	}
+
+	moveResp := resource.MoveStateResponse{}
+	for _, mover := range r.MoveState(ctx) {
+		mover.StateMover(ctx, resource.MoveStateRequest{SourceTypeName: "artifactory_federated_npm_repository"}, &moveResp)
+	}
+	if !moveResp.Diagnostics.HasError() {
+		t.Error("expected an error moving a federated repository resource")
+	}
+}
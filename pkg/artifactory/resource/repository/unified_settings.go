@@ -0,0 +1,466 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/samber/lo"
+)
+
+// typedValueMode controls how the attributes not set in `settings` are filled when converting
+// `settings` into a value of the typed repository resource schema.
+type typedValueMode int
+
+const (
+	// typedConfigValue leaves unset attributes null, as in a configuration
+	typedConfigValue typedValueMode = iota
+	// typedPlanValue applies the schema defaults and marks the other computed attributes as unknown
+	typedPlanValue
+	// typedStateValue applies the schema defaults and leaves the other computed attributes null
+	typedStateValue
+)
+
+// settingsValues returns the attributes of the `settings` object.
+func settingsValues(ctx context.Context, settings types.Dynamic) (map[string]tftypes.Value, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	values := map[string]tftypes.Value{}
+
+	if settings.IsNull() || settings.IsUnderlyingValueNull() {
+		return values, diags
+	}
+
+	tfValue, err := settings.UnderlyingValue().ToTerraformValue(ctx)
+	if err != nil {
+		diags.AddAttributeError(path.Root("settings"), "Invalid settings", err.Error())
+		return values, diags
+	}
+
+	switch tfValue.Type().(type) {
+	case tftypes.Object, tftypes.Map:
+	default:
+		diags.AddAttributeError(path.Root("settings"), "Invalid settings", "settings must be an object")
+		return values, diags
+	}
+
+	if err := tfValue.As(&values); err != nil {
+		diags.AddAttributeError(path.Root("settings"), "Invalid settings", err.Error())
+	}
+
+	return values, diags
+}
+
+// typedValue converts the repository key and `settings` into a value of the typed repository resource schema.
+func typedValue(ctx context.Context, typedSchema schema.Schema, key tftypes.Value, settings map[string]tftypes.Value, mode typedValueMode) (tftypes.Value, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	objectType := typedSchema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+
+	for name, attributeType := range objectType.AttributeTypes {
+		if name == "key" {
+			values[name] = key
+			continue
+		}
+
+		if value, ok := settings[name]; ok {
+			converted, err := convertSettingsValue(value, attributeType)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("settings"),
+					"Invalid settings",
+					fmt.Sprintf("%s: %s", name, err),
+				)
+				continue
+			}
+
+			values[name] = converted
+			continue
+		}
+
+		values[name] = tftypes.NewValue(attributeType, nil)
+
+		if block, ok := typedSchema.Blocks[name]; ok {
+			// blocks that are not configured are empty collections
+			switch block.(type) {
+			case schema.ListNestedBlock, schema.SetNestedBlock:
+				values[name] = tftypes.NewValue(attributeType, []tftypes.Value{})
+			}
+			continue
+		}
+
+		if mode == typedConfigValue {
+			continue
+		}
+
+		attribute := typedSchema.Attributes[name]
+
+		defaultValue, d := attributeDefault(ctx, attribute, path.Root(name))
+		diags.Append(d...)
+		if defaultValue != nil {
+			tfDefault, err := defaultValue.ToTerraformValue(ctx)
+			if err != nil {
+				diags.AddError("Failed to get default value", err.Error())
+				continue
+			}
+
+			values[name] = tfDefault
+			continue
+		}
+
+		if mode == typedPlanValue && attribute.IsComputed() {
+			values[name] = tftypes.NewValue(attributeType, tftypes.UnknownValue)
+		}
+	}
+
+	if diags.HasError() {
+		return tftypes.NewValue(objectType, nil), diags
+	}
+
+	return tftypes.NewValue(objectType, values), diags
+}
+
+// convertSettingsValue converts a value of the dynamic `settings` attribute into the type of the typed
+// repository resource attribute, e.g. a tuple into a set.
+func convertSettingsValue(value tftypes.Value, target tftypes.Type) (tftypes.Value, error) {
+	if !value.IsKnown() {
+		return tftypes.NewValue(target, tftypes.UnknownValue), nil
+	}
+
+	if value.IsNull() {
+		return tftypes.NewValue(target, nil), nil
+	}
+
+	switch t := target.(type) {
+	case tftypes.List, tftypes.Set:
+		var elementType tftypes.Type
+		if l, ok := t.(tftypes.List); ok {
+			elementType = l.ElementType
+		} else {
+			elementType = t.(tftypes.Set).ElementType
+		}
+
+		switch value.Type().(type) {
+		case tftypes.List, tftypes.Set, tftypes.Tuple:
+		default:
+			return value, fmt.Errorf("expected a list, got %s", typeName(value.Type()))
+		}
+
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return value, err
+		}
+
+		converted := make([]tftypes.Value, 0, len(elements))
+		for _, element := range elements {
+			c, err := convertSettingsValue(element, elementType)
+			if err != nil {
+				return value, err
+			}
+			converted = append(converted, c)
+		}
+
+		return tftypes.NewValue(target, converted), nil
+	case tftypes.Map, tftypes.Object:
+		switch value.Type().(type) {
+		case tftypes.Map, tftypes.Object:
+		default:
+			return value, fmt.Errorf("expected an object, got %s", typeName(value.Type()))
+		}
+
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			return value, err
+		}
+
+		converted := map[string]tftypes.Value{}
+
+		if m, ok := t.(tftypes.Map); ok {
+			for name, attribute := range attributes {
+				c, err := convertSettingsValue(attribute, m.ElementType)
+				if err != nil {
+					return value, fmt.Errorf("%s: %w", name, err)
+				}
+				converted[name] = c
+			}
+
+			return tftypes.NewValue(target, converted), nil
+		}
+
+		attributeTypes := t.(tftypes.Object).AttributeTypes
+		if unknownNames := lo.Without(lo.Keys(attributes), lo.Keys(attributeTypes)...); len(unknownNames) > 0 {
+			sort.Strings(unknownNames)
+			return value, fmt.Errorf("unsupported attribute(s): %s", strings.Join(unknownNames, ", "))
+		}
+
+		for name, attributeType := range attributeTypes {
+			attribute, ok := attributes[name]
+			if !ok {
+				converted[name] = tftypes.NewValue(attributeType, nil)
+				continue
+			}
+
+			c, err := convertSettingsValue(attribute, attributeType)
+			if err != nil {
+				return value, fmt.Errorf("%s: %w", name, err)
+			}
+			converted[name] = c
+		}
+
+		return tftypes.NewValue(target, converted), nil
+	}
+
+	if !value.Type().Equal(target) {
+		return value, fmt.Errorf("expected %s, got %s", typeName(target), typeName(value.Type()))
+	}
+
+	return value, nil
+}
+
+// toSettingsValue converts a value of the typed repository resource schema into the type used
+// by the HCL representation of the dynamic `settings` attribute, e.g. a set into a tuple.
+func toSettingsValue(value tftypes.Value) (tftypes.Value, error) {
+	if !value.IsKnown() || value.IsNull() {
+		return value, nil
+	}
+
+	switch value.Type().(type) {
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return value, err
+		}
+
+		converted := make([]tftypes.Value, 0, len(elements))
+		elementTypes := make([]tftypes.Type, 0, len(elements))
+		for _, element := range elements {
+			c, err := toSettingsValue(element)
+			if err != nil {
+				return value, err
+			}
+			converted = append(converted, c)
+			elementTypes = append(elementTypes, c.Type())
+		}
+
+		return tftypes.NewValue(tftypes.Tuple{ElementTypes: elementTypes}, converted), nil
+	case tftypes.Map, tftypes.Object:
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			return value, err
+		}
+
+		return settingsObject(attributes)
+	}
+
+	return value, nil
+}
+
+// settingsObject builds the `settings` object from its attributes.
+func settingsObject(attributes map[string]tftypes.Value) (tftypes.Value, error) {
+	converted := map[string]tftypes.Value{}
+	attributeTypes := map[string]tftypes.Type{}
+	for name, attribute := range attributes {
+		c, err := toSettingsValue(attribute)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		converted[name] = c
+		attributeTypes[name] = c.Type()
+	}
+
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: attributeTypes}, converted), nil
+}
+
+func typeName(t tftypes.Type) string {
+	switch t.(type) {
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		return "list"
+	case tftypes.Map, tftypes.Object:
+		return "object"
+	}
+
+	return strings.ToLower(strings.TrimPrefix(t.String(), "tftypes."))
+}
+
+// attributeDefault returns the schema default value of the attribute, or nil if it has none.
+func attributeDefault(ctx context.Context, attribute schema.Attribute, p path.Path) (attr.Value, diag.Diagnostics) {
+	switch a := attribute.(type) {
+	case schema.StringAttribute:
+		if a.Default != nil {
+			resp := defaults.StringResponse{}
+			a.Default.DefaultString(ctx, defaults.StringRequest{Path: p}, &resp)
+			return resp.PlanValue, resp.Diagnostics
+		}
+	case schema.BoolAttribute:
+		if a.Default != nil {
+			resp := defaults.BoolResponse{}
+			a.Default.DefaultBool(ctx, defaults.BoolRequest{Path: p}, &resp)
+			return resp.PlanValue, resp.Diagnostics
+		}
+	case schema.Int64Attribute:
+		if a.Default != nil {
+			resp := defaults.Int64Response{}
+			a.Default.DefaultInt64(ctx, defaults.Int64Request{Path: p}, &resp)
+			return resp.PlanValue, resp.Diagnostics
+		}
+	case schema.Float64Attribute:
+		if a.Default != nil {
+			resp := defaults.Float64Response{}
+			a.Default.DefaultFloat64(ctx, defaults.Float64Request{Path: p}, &resp)
+			return resp.PlanValue, resp.Diagnostics
+		}
+	case schema.ListAttribute:
+		if a.Default != nil {
+			resp := defaults.ListResponse{}
+			a.Default.DefaultList(ctx, defaults.ListRequest{Path: p}, &resp)
+			return resp.PlanValue, resp.Diagnostics
+		}
+	case schema.SetAttribute:
+		if a.Default != nil {
+			resp := defaults.SetResponse{}
+			a.Default.DefaultSet(ctx, defaults.SetRequest{Path: p}, &resp)
+			return resp.PlanValue, resp.Diagnostics
+		}
+	case schema.MapAttribute:
+		if a.Default != nil {
+			resp := defaults.MapResponse{}
+			a.Default.DefaultMap(ctx, defaults.MapRequest{Path: p}, &resp)
+			return resp.PlanValue, resp.Diagnostics
+		}
+	}
+
+	return nil, nil
+}
+
+// validateTypedConfig checks `settings` against the typed repository resource schema: unsupported,
+// missing required and computed only attributes, and the attribute validators.
+func validateTypedConfig(ctx context.Context, typedSchema schema.Schema, settings map[string]tftypes.Value, config tfsdk.Config) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if _, ok := settings["key"]; ok {
+		diags.AddAttributeError(path.Root("settings"), "Invalid settings", "key must be set with the `key` attribute")
+	}
+
+	names := append(lo.Keys(typedSchema.Attributes), lo.Keys(typedSchema.Blocks)...)
+	if unknownNames := lo.Without(lo.Keys(settings), append(names, "key")...); len(unknownNames) > 0 {
+		sort.Strings(unknownNames)
+		diags.AddAttributeError(
+			path.Root("settings"),
+			"Invalid settings",
+			fmt.Sprintf("unsupported attribute(s): %s", strings.Join(unknownNames, ", ")),
+		)
+	}
+
+	for name, attribute := range typedSchema.Attributes {
+		if name == "key" {
+			continue
+		}
+
+		value, isSet := settings[name]
+		isSet = isSet && !value.IsNull()
+
+		if attribute.IsRequired() && !isSet {
+			diags.AddAttributeError(path.Root("settings"), "Missing required setting", fmt.Sprintf("%s is required", name))
+			continue
+		}
+
+		if attribute.IsComputed() && !attribute.IsOptional() && !attribute.IsRequired() && isSet {
+			diags.AddAttributeError(path.Root("settings"), "Invalid settings", fmt.Sprintf("%s is read only", name))
+			continue
+		}
+
+		diags.Append(settingsDiagnostics(validateAttribute(ctx, attribute, path.Root(name), config))...)
+	}
+
+	return diags
+}
+
+func validateAttribute(ctx context.Context, attribute schema.Attribute, p path.Path, config tfsdk.Config) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	switch a := attribute.(type) {
+	case schema.StringAttribute:
+		var value types.String
+		diags.Append(config.GetAttribute(ctx, p, &value)...)
+		for _, v := range a.Validators {
+			resp := validator.StringResponse{}
+			v.ValidateString(ctx, validator.StringRequest{Path: p, PathExpression: p.Expression(), Config: config, ConfigValue: value}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	case schema.BoolAttribute:
+		var value types.Bool
+		diags.Append(config.GetAttribute(ctx, p, &value)...)
+		for _, v := range a.Validators {
+			resp := validator.BoolResponse{}
+			v.ValidateBool(ctx, validator.BoolRequest{Path: p, PathExpression: p.Expression(), Config: config, ConfigValue: value}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	case schema.Int64Attribute:
+		var value types.Int64
+		diags.Append(config.GetAttribute(ctx, p, &value)...)
+		for _, v := range a.Validators {
+			resp := validator.Int64Response{}
+			v.ValidateInt64(ctx, validator.Int64Request{Path: p, PathExpression: p.Expression(), Config: config, ConfigValue: value}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	case schema.ListAttribute:
+		var value types.List
+		diags.Append(config.GetAttribute(ctx, p, &value)...)
+		for _, v := range a.Validators {
+			resp := validator.ListResponse{}
+			v.ValidateList(ctx, validator.ListRequest{Path: p, PathExpression: p.Expression(), Config: config, ConfigValue: value}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	case schema.SetAttribute:
+		var value types.Set
+		diags.Append(config.GetAttribute(ctx, p, &value)...)
+		for _, v := range a.Validators {
+			resp := validator.SetResponse{}
+			v.ValidateSet(ctx, validator.SetRequest{Path: p, PathExpression: p.Expression(), Config: config, ConfigValue: value}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	}
+
+	return diags
+}
+
+// settingsDiagnostics moves attribute diagnostics of the typed repository resource to the `settings`
+// attribute, as their paths don't exist in the `artifactory_repository` schema.
+func settingsDiagnostics(diags diag.Diagnostics) diag.Diagnostics {
+	return lo.Map(diags, func(d diag.Diagnostic, _ int) diag.Diagnostic {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if !ok || withPath.Path().Equal(path.Empty()) {
+			return d
+		}
+
+		detail := fmt.Sprintf("%s: %s", withPath.Path(), d.Detail())
+		if d.Severity() == diag.SeverityWarning {
+			return diag.NewAttributeWarningDiagnostic(path.Root("settings"), d.Summary(), detail)
+		}
+		return diag.NewAttributeErrorDiagnostic(path.Root("settings"), d.Summary(), detail)
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// UpgradeStateSDKv2 returns a state upgrader for a schema version of a repository resource that was implemented
+// with SDKv2. The prior state is decoded as JSON and changed by the SDKv2 state upgrade functions in order, then
+// the attributes that are not in the current schema anymore, e.g. `id` and `package_type`, are removed.
+func UpgradeStateSDKv2(upgrades ...sdkv2_schema.StateUpgradeFunc) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			rawState := map[string]any{}
+			if err := json.Unmarshal(req.RawState.JSON, &rawState); err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to upgrade state",
+					err.Error(),
+				)
+				return
+			}
+
+			for _, upgrade := range upgrades {
+				var err error
+				rawState, err = upgrade(ctx, rawState, nil)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to upgrade state",
+						err.Error(),
+					)
+					return
+				}
+			}
+
+			attributeTypes := resp.State.Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes
+			for name := range rawState {
+				if _, ok := attributeTypes[name]; !ok {
+					delete(rawState, name)
+				}
+			}
+
+			upgraded, err := json.Marshal(rawState)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to upgrade state",
+					err.Error(),
+				)
+				return
+			}
+
+			resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+		},
+	}
+}
+
+// ResourceUpgradeAllowRename sets `allow_rename` to its default value in the state of the resources that didn't have
+// the attribute.
+func ResourceUpgradeAllowRename(_ context.Context, rawState map[string]any, _ any) (map[string]any, error) {
+	if _, ok := rawState["allow_rename"]; !ok {
+		rawState["allow_rename"] = false
+	}
+
+	return rawState, nil
+}
@@ -0,0 +1,231 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package virtual
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+)
+
+// memberRepository is the subset of the repository configuration needed to
+// validate virtual repository members and to expand nested virtual repositories.
+type memberRepository struct {
+	Key          string   `json:"key"`
+	Rclass       string   `json:"rclass"`
+	PackageType  string   `json:"packageType"`
+	Repositories []string `json:"repositories"`
+}
+
+// memberRepositoryLookup fetches repository configurations and caches the result,
+// including repositories that don't exist (nil value), for the duration of one plan or apply operation.
+type memberRepositoryLookup struct {
+	client *resty.Client
+	cache  map[string]*memberRepository
+}
+
+func newMemberRepositoryLookup(client *resty.Client) *memberRepositoryLookup {
+	return &memberRepositoryLookup{
+		client: client,
+		cache:  map[string]*memberRepository{},
+	}
+}
+
+// get returns nil without error when the repository doesn't exist.
+func (l *memberRepositoryLookup) get(key string) (*memberRepository, error) {
+	if repo, ok := l.cache[key]; ok {
+		return repo, nil
+	}
+
+	var repo memberRepository
+	var jfrogErrors util.JFrogErrors
+	response, err := l.client.R().
+		SetPathParam("key", key).
+		SetResult(&repo).
+		SetError(&jfrogErrors).
+		Get(repository.RepositoriesEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Artifactory returns 400 instead of 404 for non-existent repository
+	if response.StatusCode() == http.StatusBadRequest || response.StatusCode() == http.StatusNotFound {
+		l.cache[key] = nil
+		return nil, nil
+	}
+
+	if response.IsError() {
+		return nil, fmt.Errorf("failed to get repository %s: %s", key, jfrogErrors.String())
+	}
+
+	l.cache[key] = &repo
+	return &repo, nil
+}
+
+// compatiblePackageTypes lists the member package types, other than its own,
+// that a virtual repository of the given package type can aggregate.
+var compatiblePackageTypes = map[string][]string{
+	repository.MavenPackageType:  repository.PackageTypesLikeGradle,
+	repository.GradlePackageType: {repository.MavenPackageType, repository.SBTPackageType, repository.IvyPackageType},
+	repository.IvyPackageType:    {repository.MavenPackageType, repository.GradlePackageType, repository.SBTPackageType},
+	repository.SBTPackageType:    {repository.MavenPackageType, repository.GradlePackageType, repository.IvyPackageType},
+}
+
+func isCompatiblePackageType(virtualPackageType, memberPackageType string) bool {
+	virtualPackageType = strings.ToLower(virtualPackageType)
+	memberPackageType = strings.ToLower(memberPackageType)
+
+	return virtualPackageType == memberPackageType ||
+		slices.Contains(compatiblePackageTypes[virtualPackageType], memberPackageType)
+}
+
+// deployableRclasses are the repository classes that can be used as `default_deployment_repo`.
+var deployableRclasses = []string{"local", "federated"}
+
+type memberValidationResult struct {
+	// MissingRepositories are members that don't exist (yet). They may be created in the same apply
+	// so they are reported as warnings rather than errors.
+	MissingRepositories []string
+	Errors              []string
+}
+
+// validateMembers checks that all members of the virtual repository exist, share its package type,
+// and that the default deployment repository is a local (or federated) member.
+func validateMembers(lookup *memberRepositoryLookup, key, packageType string, repositories []string, defaultDeploymentRepo string) (memberValidationResult, error) {
+	result := memberValidationResult{}
+
+	for _, member := range repositories {
+		if member == key {
+			result.Errors = append(result.Errors, fmt.Sprintf("repository %s cannot include itself", key))
+			continue
+		}
+
+		repo, err := lookup.get(member)
+		if err != nil {
+			return result, err
+		}
+
+		if repo == nil {
+			result.MissingRepositories = append(result.MissingRepositories, member)
+			continue
+		}
+
+		if !isCompatiblePackageType(packageType, repo.PackageType) {
+			result.Errors = append(
+				result.Errors,
+				fmt.Sprintf("repository %s has package type %s, which is not compatible with virtual repository package type %s", member, repo.PackageType, packageType),
+			)
+		}
+	}
+
+	if defaultDeploymentRepo == "" {
+		return result, nil
+	}
+
+	if !slices.Contains(repositories, defaultDeploymentRepo) {
+		result.Errors = append(
+			result.Errors,
+			fmt.Sprintf("default_deployment_repo %s must be included in repositories", defaultDeploymentRepo),
+		)
+		return result, nil
+	}
+
+	repo, err := lookup.get(defaultDeploymentRepo)
+	if err != nil {
+		return result, err
+	}
+
+	if repo != nil && !slices.Contains(deployableRclasses, repo.Rclass) {
+		result.Errors = append(
+			result.Errors,
+			fmt.Sprintf("default_deployment_repo %s is a %s repository, must be one of: %s", defaultDeploymentRepo, repo.Rclass, strings.Join(deployableRclasses, ", ")),
+		)
+	}
+
+	return result, nil
+}
+
+// effectiveResolutionOrder expands nested virtual repositories and returns the order in which
+// Artifactory searches the aggregated repositories: local (and federated) repositories first, then
+// remote repositories, each group in the order they are listed.
+//
+// The returned bool is false when one or more members don't exist, in which case the order is
+// incomplete (missing members are kept in their listed position).
+func effectiveResolutionOrder(lookup *memberRepositoryLookup, key string, repositories []string) ([]string, bool, error) {
+	type resolvedMember struct {
+		key    string
+		rclass string
+	}
+
+	var members []resolvedMember
+	// mark the virtual repository itself as visited to guard against cyclic nesting
+	visited := map[string]bool{key: true}
+	complete := true
+
+	var expand func(keys []string) error
+	expand = func(keys []string) error {
+		for _, member := range keys {
+			if visited[member] {
+				continue
+			}
+			visited[member] = true
+
+			repo, err := lookup.get(member)
+			if err != nil {
+				return err
+			}
+
+			if repo == nil {
+				complete = false
+				members = append(members, resolvedMember{key: member})
+				continue
+			}
+
+			if repo.Rclass == Rclass {
+				if err := expand(repo.Repositories); err != nil {
+					return err
+				}
+				continue
+			}
+
+			members = append(members, resolvedMember{key: member, rclass: repo.Rclass})
+		}
+
+		return nil
+	}
+
+	if err := expand(repositories); err != nil {
+		return nil, false, err
+	}
+
+	remoteMembers, otherMembers := lo.FilterReject(members, func(m resolvedMember, _ int) bool {
+		return m.rclass == "remote"
+	})
+
+	order := lo.Map(
+		append(otherMembers, remoteMembers...),
+		func(m resolvedMember, _ int) string {
+			return m.key
+		},
+	)
+
+	return order, complete, nil
+}
@@ -15,55 +15,124 @@
 package virtual
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/samber/lo"
 )
 
-var alpineSchema = lo.Assign(
-	RetrievalCachePeriodSecondsSchema,
-	repository.PrimaryKeyPairRefSDKv2,
-	repository.RepoLayoutRefSDKv2Schema(Rclass, repository.AlpinePackageType),
-)
+func NewAlpineVirtualRepositoryResource() resource.Resource {
+	return &virtualAlpineResource{
+		virtualResource: NewVirtualRepositoryResource(
+			repository.AlpinePackageType,
+			"Alpine",
+			reflect.TypeFor[virtualAlpineResourceModel](),
+			reflect.TypeFor[VirtualAlpineAPIModel](),
+		),
+	}
+}
 
-var AlpineSchemas = GetSchemas(alpineSchema)
+type virtualAlpineResource struct {
+	virtualResource
+}
 
-func ResourceArtifactoryVirtualAlpineRepository() *schema.Resource {
-	type AlpineVirtualRepositoryParams struct {
-		RepositoryBaseParamsWithRetrievalCachePeriodSecs
-		repository.PrimaryKeyPairRefParam
-	}
+type virtualAlpineResourceModel struct {
+	VirtualResourceModel
+	RetrievalCachePeriodSeconds types.Int64  `tfsdk:"retrieval_cache_period_seconds"`
+	PrimaryKeyPairRef           types.String `tfsdk:"primary_keypair_ref"`
+}
 
-	var unpackAlpineVirtualRepository = func(s *schema.ResourceData) (interface{}, string, error) {
-		d := &utilsdk.ResourceData{ResourceData: s}
-
-		repo := AlpineVirtualRepositoryParams{
-			RepositoryBaseParamsWithRetrievalCachePeriodSecs: UnpackBaseVirtRepoWithRetrievalCachePeriodSecs(s, repository.AlpinePackageType),
-			PrimaryKeyPairRefParam: repository.PrimaryKeyPairRefParam{
-				PrimaryKeyPairRefSDKv2: d.GetString("primary_keypair_ref", false),
-			},
-		}
-		repo.PackageType = repository.AlpinePackageType
-		return &repo, repo.Key, nil
-	}
+func (r *virtualAlpineResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r virtualAlpineResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualAlpineResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
 
-	constructor := func() (interface{}, error) {
-		return &AlpineVirtualRepositoryParams{
-			RepositoryBaseParamsWithRetrievalCachePeriodSecs: RepositoryBaseParamsWithRetrievalCachePeriodSecs{
-				RepositoryBaseParams: RepositoryBaseParams{
-					Rclass:      Rclass,
-					PackageType: repository.AlpinePackageType,
-				},
-			},
-		}, nil
+func (r virtualAlpineResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualAlpineResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualAlpineResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualAlpineResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r virtualAlpineResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	virtualAPIModel, d := r.VirtualResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return repository.MkResourceSchema(
-		AlpineSchemas,
-		packer.Default(AlpineSchemas[CurrentSchemaVersion]),
-		unpackAlpineVirtualRepository,
-		constructor,
-	)
+	return VirtualAlpineAPIModel{
+		VirtualAPIModel:                 virtualAPIModel.(VirtualAPIModel),
+		VirtualRetrievalCachePeriodSecs: r.RetrievalCachePeriodSeconds.ValueInt64(),
+		PrimaryKeyPairRef:               r.PrimaryKeyPairRef.ValueString(),
+	}, diags
+}
+
+func (r *virtualAlpineResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*VirtualAlpineAPIModel)
+
+	diags.Append(r.VirtualResourceModel.FromAPIModel(ctx, model.VirtualAPIModel)...)
+	r.RetrievalCachePeriodSeconds = types.Int64Value(model.VirtualRetrievalCachePeriodSecs)
+	r.PrimaryKeyPairRef = types.StringValue(model.PrimaryKeyPairRef)
+
+	return diags
+}
+
+type VirtualAlpineAPIModel struct {
+	VirtualAPIModel
+	VirtualRetrievalCachePeriodSecs int64  `json:"virtualRetrievalCachePeriodSecs"`
+	PrimaryKeyPairRef               string `json:"primaryKeyPairRef"`
 }
+
+func (r *virtualAlpineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+			RetrievalCachePeriodSecondsAttribute,
+			repository.PrimaryKeyPairRefAttribute,
+		),
+		Description: r.Description,
+	}
+}
+
+// SDKv2
+var alpineSchema = lo.Assign(
+	RetrievalCachePeriodSecondsSchema,
+	repository.PrimaryKeyPairRefSDKv2,
+	repository.RepoLayoutRefSDKv2Schema(Rclass, repository.AlpinePackageType),
+)
+
+var AlpineSchemas = GetSchemas(alpineSchema)
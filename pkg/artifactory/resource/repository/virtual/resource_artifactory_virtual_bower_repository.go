@@ -15,38 +15,122 @@
 package virtual
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	"github.com/samber/lo"
 )
 
-var bowerSchema = lo.Assign(
-	externalDependenciesSchema,
-	repository.RepoLayoutRefSDKv2Schema(Rclass, repository.BowerPackageType),
-)
+func NewBowerVirtualRepositoryResource() resource.Resource {
+	return &virtualBowerResource{
+		virtualResource: NewVirtualRepositoryResource(
+			repository.BowerPackageType,
+			"Bower",
+			reflect.TypeFor[virtualBowerResourceModel](),
+			reflect.TypeFor[VirtualBowerAPIModel](),
+		),
+	}
+}
 
-var BowerSchemas = GetSchemas(bowerSchema)
+type virtualBowerResource struct {
+	virtualResource
+}
+
+type virtualBowerResourceModel struct {
+	VirtualResourceModel
+	ExternalDependenciesResourceModel
+}
 
-func ResourceArtifactoryVirtualBowerRepository() *schema.Resource {
-	var unpackBowerVirtualRepository = func(s *schema.ResourceData) (interface{}, string, error) {
-		repo := unpackExternalDependenciesVirtualRepository(s, repository.BowerPackageType)
-		return repo, repo.Id(), nil
+func (r *virtualBowerResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r virtualBowerResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualBowerResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualBowerResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualBowerResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualBowerResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualBowerResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r virtualBowerResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	virtualAPIModel, d := r.VirtualResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	constructor := func() (interface{}, error) {
-		return &ExternalDependenciesVirtualRepositoryParams{
-			RepositoryBaseParams: RepositoryBaseParams{
-				Rclass:      Rclass,
-				PackageType: repository.BowerPackageType,
-			},
-		}, nil
+	externalDependencies, d := r.ExternalDependenciesResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return repository.MkResourceSchema(
-		BowerSchemas,
-		packer.Default(BowerSchemas[CurrentSchemaVersion]),
-		unpackBowerVirtualRepository,
-		constructor,
-	)
+	return VirtualBowerAPIModel{
+		VirtualAPIModel:              virtualAPIModel.(VirtualAPIModel),
+		ExternalDependenciesAPIModel: externalDependencies,
+	}, diags
+}
+
+func (r *virtualBowerResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*VirtualBowerAPIModel)
+
+	diags.Append(r.VirtualResourceModel.FromAPIModel(ctx, model.VirtualAPIModel)...)
+	diags.Append(r.ExternalDependenciesResourceModel.FromAPIModel(ctx, model.ExternalDependenciesAPIModel)...)
+
+	return diags
+}
+
+type VirtualBowerAPIModel struct {
+	VirtualAPIModel
+	ExternalDependenciesAPIModel
 }
+
+func (r *virtualBowerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+			externalDependenciesAttributes,
+		),
+		Description: r.Description,
+	}
+}
+
+// SDKv2
+var bowerSchema = lo.Assign(
+	externalDependenciesSchema,
+	repository.RepoLayoutRefSDKv2Schema(Rclass, repository.BowerPackageType),
+)
+
+var BowerSchemas = GetSchemas(bowerSchema)
@@ -15,13 +15,123 @@
 package virtual
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/samber/lo"
 )
 
+func NewConanVirtualRepositoryResource() resource.Resource {
+	return &virtualConanResource{
+		virtualResource: NewVirtualRepositoryResource(
+			repository.ConanPackageType,
+			"Conan",
+			reflect.TypeFor[virtualConanResourceModel](),
+			reflect.TypeFor[VirtualConanAPIModel](),
+		),
+	}
+}
+
+type virtualConanResource struct {
+	virtualResource
+}
+
+type virtualConanResourceModel struct {
+	VirtualResourceModel
+	RetrievalCachePeriodSeconds types.Int64 `tfsdk:"retrieval_cache_period_seconds"`
+	repository.ConanResourceModel
+}
+
+func (r *virtualConanResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r virtualConanResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualConanResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualConanResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualConanResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualConanResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualConanResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r virtualConanResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	virtualAPIModel, d := r.VirtualResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
+	}
+
+	return VirtualConanAPIModel{
+		VirtualAPIModel:                 virtualAPIModel.(VirtualAPIModel),
+		VirtualRetrievalCachePeriodSecs: r.RetrievalCachePeriodSeconds.ValueInt64(),
+		ConanAPIModel: repository.ConanAPIModel{
+			EnableConanSupport:       true,
+			ForceConanAuthentication: r.ForceConanAuthentication.ValueBool(),
+		},
+	}, diags
+}
+
+func (r *virtualConanResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*VirtualConanAPIModel)
+
+	diags.Append(r.VirtualResourceModel.FromAPIModel(ctx, model.VirtualAPIModel)...)
+	r.RetrievalCachePeriodSeconds = types.Int64Value(model.VirtualRetrievalCachePeriodSecs)
+	r.ForceConanAuthentication = types.BoolValue(model.ForceConanAuthentication)
+
+	return diags
+}
+
+type VirtualConanAPIModel struct {
+	VirtualAPIModel
+	VirtualRetrievalCachePeriodSecs int64 `json:"virtualRetrievalCachePeriodSecs"`
+	repository.ConanAPIModel
+}
+
+func (r *virtualConanResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+			RetrievalCachePeriodSecondsAttribute,
+			repository.ConanAttributes,
+		),
+		Description: r.Description,
+	}
+}
+
+// SDKv2
 var conanSchema = lo.Assign(
 	RetrievalCachePeriodSecondsSchema,
 	repository.ConanBaseSchemaSDKv2,
@@ -34,38 +144,3 @@ type ConanRepoParams struct {
 	RepositoryBaseParamsWithRetrievalCachePeriodSecs
 	repository.ConanBaseParams
 }
-
-func ResourceArtifactoryVirtualConanRepository() *schema.Resource {
-	var unpackConanRepository = func(s *schema.ResourceData) (interface{}, string, error) {
-		d := &utilsdk.ResourceData{ResourceData: s}
-		repo := ConanRepoParams{
-			RepositoryBaseParamsWithRetrievalCachePeriodSecs: UnpackBaseVirtRepoWithRetrievalCachePeriodSecs(s, repository.ConanPackageType),
-			ConanBaseParams: repository.ConanBaseParams{
-				EnableConanSupport:       true,
-				ForceConanAuthentication: d.GetBool("force_conan_authentication", false),
-			},
-		}
-		return repo, repo.Id(), nil
-	}
-
-	constructor := func() (interface{}, error) {
-		return &ConanRepoParams{
-			RepositoryBaseParamsWithRetrievalCachePeriodSecs: RepositoryBaseParamsWithRetrievalCachePeriodSecs{
-				RepositoryBaseParams: RepositoryBaseParams{
-					Rclass:      Rclass,
-					PackageType: repository.ConanPackageType,
-				},
-			},
-			ConanBaseParams: repository.ConanBaseParams{
-				EnableConanSupport: true,
-			},
-		}, nil
-	}
-
-	return repository.MkResourceSchema(
-		ConanSchemas,
-		packer.Default(ConanSchemas[CurrentSchemaVersion]),
-		unpackConanRepository,
-		constructor,
-	)
-}
@@ -15,34 +15,194 @@
 package virtual
 
 import (
+	"context"
+	"reflect"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/samber/lo"
 )
 
+func NewDebianVirtualRepositoryResource() resource.Resource {
+	return &virtualDebianResource{
+		virtualResource: NewVirtualRepositoryResource(
+			repository.DebianPackageType,
+			"Debian",
+			reflect.TypeFor[virtualDebianResourceModel](),
+			reflect.TypeFor[VirtualDebianAPIModel](),
+		),
+	}
+}
+
+type virtualDebianResource struct {
+	virtualResource
+}
+
+type virtualDebianResourceModel struct {
+	VirtualResourceModel
+	RetrievalCachePeriodSeconds     types.Int64  `tfsdk:"retrieval_cache_period_seconds"`
+	PrimaryKeyPairRef               types.String `tfsdk:"primary_keypair_ref"`
+	SecondaryKeyPairRef             types.String `tfsdk:"secondary_keypair_ref"`
+	OptionalIndexCompressionFormats types.Set    `tfsdk:"optional_index_compression_formats"`
+	DebianDefaultArchitectures      types.String `tfsdk:"debian_default_architectures"`
+}
+
+func (r *virtualDebianResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r virtualDebianResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualDebianResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualDebianResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualDebianResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualDebianResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualDebianResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r virtualDebianResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	virtualAPIModel, d := r.VirtualResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
+	}
+
+	var compressionFormats []string
+	d = r.OptionalIndexCompressionFormats.ElementsAs(ctx, &compressionFormats, false)
+	if d != nil {
+		diags.Append(d...)
+	}
+
+	return VirtualDebianAPIModel{
+		VirtualAPIModel:                 virtualAPIModel.(VirtualAPIModel),
+		VirtualRetrievalCachePeriodSecs: r.RetrievalCachePeriodSeconds.ValueInt64(),
+		PrimaryKeyPairRef:               r.PrimaryKeyPairRef.ValueString(),
+		SecondaryKeyPairRef:             r.SecondaryKeyPairRef.ValueString(),
+		OptionalIndexCompressionFormats: compressionFormats,
+		DebianDefaultArchitectures:      r.DebianDefaultArchitectures.ValueString(),
+	}, diags
+}
+
+func (r *virtualDebianResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*VirtualDebianAPIModel)
+
+	diags.Append(r.VirtualResourceModel.FromAPIModel(ctx, model.VirtualAPIModel)...)
+	r.RetrievalCachePeriodSeconds = types.Int64Value(model.VirtualRetrievalCachePeriodSecs)
+	r.PrimaryKeyPairRef = types.StringValue(model.PrimaryKeyPairRef)
+	r.SecondaryKeyPairRef = types.StringValue(model.SecondaryKeyPairRef)
+
+	compressionFormats, d := types.SetValueFrom(ctx, types.StringType, model.OptionalIndexCompressionFormats)
+	if d != nil {
+		diags.Append(d...)
+	}
+	r.OptionalIndexCompressionFormats = compressionFormats
+	r.DebianDefaultArchitectures = types.StringValue(model.DebianDefaultArchitectures)
+
+	return diags
+}
+
+type VirtualDebianAPIModel struct {
+	VirtualAPIModel
+	VirtualRetrievalCachePeriodSecs int64    `json:"virtualRetrievalCachePeriodSecs"`
+	PrimaryKeyPairRef               string   `json:"primaryKeyPairRef"`
+	SecondaryKeyPairRef             string   `json:"secondaryKeyPairRef"`
+	OptionalIndexCompressionFormats []string `json:"optionalIndexCompressionFormats"`
+	DebianDefaultArchitectures      string   `json:"debianDefaultArchitectures"`
+}
+
+func (r *virtualDebianResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+			RetrievalCachePeriodSecondsAttribute,
+			repository.PrimaryKeyPairRefAttribute,
+			repository.SecondaryKeyPairRefAttribute,
+			map[string]schema.Attribute{
+				"optional_index_compression_formats": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Computed:    true,
+					Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("bz2")})),
+					Validators: []validator.Set{
+						setvalidator.ValueStringsAre(stringvalidator.OneOf("bz2", "lzma", "xz")),
+					},
+					MarkdownDescription: "Index file formats you would like to create in addition to the default Gzip (.gzip extension). Supported values are `bz2`, `lzma` and `xz`. Default value is `bz2`.",
+				},
+				"debian_default_architectures": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString("amd64,i386"),
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+						stringvalidator.RegexMatches(regexp.MustCompile(`.+(?:,.+)*`), "must be comma separated string"),
+					},
+					MarkdownDescription: "Specifying architectures will speed up Artifactory's initial metadata indexing process. The default architecture values are `amd64` and `i386`.",
+				},
+			},
+		),
+		Description: r.Description,
+	}
+}
+
+// SDKv2
 var debianSchema = lo.Assign(
 	RetrievalCachePeriodSecondsSchema,
 	repository.PrimaryKeyPairRefSDKv2,
 	repository.SecondaryKeyPairRefSDKv2,
-	map[string]*schema.Schema{
+	map[string]*sdkv2_schema.Schema{
 		"optional_index_compression_formats": {
-			Type:     schema.TypeSet,
+			Type:     sdkv2_schema.TypeSet,
 			Optional: true,
 			MinItems: 0,
 			Computed: true,
-			Elem: &schema.Schema{
-				Type:         schema.TypeString,
+			Elem: &sdkv2_schema.Schema{
+				Type:         sdkv2_schema.TypeString,
 				ValidateFunc: validation.StringInSlice([]string{"bz2", "lzma", "xz"}, false),
 			},
 			Description: `Index file formats you would like to create in addition to the default Gzip (.gzip extension). Supported values are 'bz2','lzma' and 'xz'. Default value is 'bz2'.`,
 		},
 		"debian_default_architectures": {
-			Type:             schema.TypeString,
+			Type:             sdkv2_schema.TypeString,
 			Optional:         true,
 			Default:          "amd64,i386",
 			ValidateDiagFunc: validation.ToDiagFunc(validation.All(validation.StringIsNotEmpty, validation.StringMatch(regexp.MustCompile(`.+(?:,.+)*`), "must be comma separated string"))),
@@ -53,50 +213,3 @@ var debianSchema = lo.Assign(
 )
 
 var DebianSchemas = GetSchemas(debianSchema)
-
-func ResourceArtifactoryVirtualDebianRepository() *schema.Resource {
-
-	type DebianVirtualRepositoryParams struct {
-		RepositoryBaseParamsWithRetrievalCachePeriodSecs
-		repository.PrimaryKeyPairRefParam
-		repository.SecondaryKeyPairRefParam
-		OptionalIndexCompressionFormats []string `json:"optionalIndexCompressionFormats"`
-		DebianDefaultArchitectures      string   `json:"debianDefaultArchitectures"`
-	}
-
-	var unpackDebianVirtualRepository = func(s *schema.ResourceData) (interface{}, string, error) {
-		d := &utilsdk.ResourceData{ResourceData: s}
-
-		repo := DebianVirtualRepositoryParams{
-			RepositoryBaseParamsWithRetrievalCachePeriodSecs: UnpackBaseVirtRepoWithRetrievalCachePeriodSecs(s, repository.DebianPackageType),
-			PrimaryKeyPairRefParam: repository.PrimaryKeyPairRefParam{
-				PrimaryKeyPairRefSDKv2: d.GetString("primary_keypair_ref", false),
-			},
-			SecondaryKeyPairRefParam: repository.SecondaryKeyPairRefParam{
-				SecondaryKeyPairRefSDKv2: d.GetString("secondary_keypair_ref", false),
-			},
-			OptionalIndexCompressionFormats: d.GetSet("optional_index_compression_formats"),
-			DebianDefaultArchitectures:      d.GetString("debian_default_architectures", false),
-		}
-		repo.PackageType = repository.DebianPackageType
-		return &repo, repo.Key, nil
-	}
-
-	constructor := func() (interface{}, error) {
-		return &DebianVirtualRepositoryParams{
-			RepositoryBaseParamsWithRetrievalCachePeriodSecs: RepositoryBaseParamsWithRetrievalCachePeriodSecs{
-				RepositoryBaseParams: RepositoryBaseParams{
-					Rclass:      Rclass,
-					PackageType: repository.DebianPackageType,
-				},
-			},
-		}, nil
-	}
-
-	return repository.MkResourceSchema(
-		DebianSchemas,
-		packer.Default(DebianSchemas[CurrentSchemaVersion]),
-		unpackDebianVirtualRepository,
-		constructor,
-	)
-}
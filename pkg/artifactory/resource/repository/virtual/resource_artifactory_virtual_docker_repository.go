@@ -15,17 +15,128 @@
 package virtual
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/samber/lo"
 )
 
+func NewDockerVirtualRepositoryResource() resource.Resource {
+	return &virtualDockerResource{
+		virtualResource: NewVirtualRepositoryResource(
+			repository.DockerPackageType,
+			"Docker",
+			reflect.TypeFor[virtualDockerResourceModel](),
+			reflect.TypeFor[VirtualDockerAPIModel](),
+		),
+	}
+}
+
+type virtualDockerResource struct {
+	virtualResource
+}
+
+type virtualDockerResourceModel struct {
+	VirtualResourceModel
+	ResolveDockerTagsByTimestamp types.Bool `tfsdk:"resolve_docker_tags_by_timestamp"`
+}
+
+func (r *virtualDockerResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r virtualDockerResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualDockerResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualDockerResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualDockerResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualDockerResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualDockerResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r virtualDockerResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	virtualAPIModel, d := r.VirtualResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
+	}
+
+	return VirtualDockerAPIModel{
+		VirtualAPIModel:              virtualAPIModel.(VirtualAPIModel),
+		ResolveDockerTagsByTimestamp: r.ResolveDockerTagsByTimestamp.ValueBool(),
+	}, diags
+}
+
+func (r *virtualDockerResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*VirtualDockerAPIModel)
+
+	diags.Append(r.VirtualResourceModel.FromAPIModel(ctx, model.VirtualAPIModel)...)
+	r.ResolveDockerTagsByTimestamp = types.BoolValue(model.ResolveDockerTagsByTimestamp)
+
+	return diags
+}
+
+type VirtualDockerAPIModel struct {
+	VirtualAPIModel
+	ResolveDockerTagsByTimestamp bool `json:"resolveDockerTagsByTimestamp"`
+}
+
+func (r *virtualDockerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+			map[string]schema.Attribute{
+				"resolve_docker_tags_by_timestamp": schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+					MarkdownDescription: "When enabled, in cases where the same Docker tag exists in two or more of the aggregated repositories, Artifactory will return the tag that has the latest timestamp.",
+				},
+			},
+		),
+		Description: r.Description,
+	}
+}
+
+// SDKv2
 var dockerSchema = lo.Assign(
-	map[string]*schema.Schema{
+	map[string]*sdkv2_schema.Schema{
 		"resolve_docker_tags_by_timestamp": {
-			Type:        schema.TypeBool,
+			Type:        sdkv2_schema.TypeBool,
 			Optional:    true,
 			Default:     false,
 			Description: "When enabled, in cases where the same Docker tag exists in two or more of the aggregated repositories, Artifactory will return the tag that has the latest timestamp.",
@@ -35,37 +146,3 @@ var dockerSchema = lo.Assign(
 )
 
 var DockerSchemas = GetSchemas(dockerSchema)
-
-func ResourceArtifactoryVirtualDockerRepository() *schema.Resource {
-
-	type DockerVirtualRepositoryParams struct {
-		RepositoryBaseParams
-		ResolveDockerTagsByTimestamp bool `json:"resolveDockerTagsByTimestamp"`
-	}
-
-	unpackDockerVirtualRepository := func(data *schema.ResourceData) (interface{}, string, error) {
-		d := &utilsdk.ResourceData{ResourceData: data}
-		repo := DockerVirtualRepositoryParams{
-			RepositoryBaseParams:         UnpackBaseVirtRepo(data, repository.DockerPackageType),
-			ResolveDockerTagsByTimestamp: d.GetBool("resolve_docker_tags_by_timestamp", false),
-		}
-
-		return repo, repo.Id(), nil
-	}
-
-	constructor := func() (interface{}, error) {
-		return &DockerVirtualRepositoryParams{
-			RepositoryBaseParams: RepositoryBaseParams{
-				Rclass:      Rclass,
-				PackageType: repository.DockerPackageType,
-			},
-		}, nil
-	}
-
-	return repository.MkResourceSchema(
-		DockerSchemas,
-		packer.Default(DockerSchemas[CurrentSchemaVersion]),
-		unpackDockerVirtualRepository,
-		constructor,
-	)
-}
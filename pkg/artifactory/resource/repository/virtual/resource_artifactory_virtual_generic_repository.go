@@ -15,64 +15,194 @@
 package virtual
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	"github.com/samber/lo"
 )
 
-func ResourceArtifactoryVirtualGenericRepository(packageType string) *schema.Resource {
-	constructor := func() (interface{}, error) {
-		return &RepositoryBaseParams{
-			PackageType: packageType,
-			Rclass:      Rclass,
-		}, nil
+func NewGenericVirtualRepositoryResource(packageType string) func() resource.Resource {
+	return func() resource.Resource {
+		return &virtualGenericResource{
+			virtualResource: NewVirtualRepositoryResource(
+				packageType,
+				repository.PackageNameLookup[packageType],
+				reflect.TypeFor[virtualGenericResourceModel](),
+				reflect.TypeFor[VirtualAPIModel](),
+			),
+		}
 	}
-	unpack := func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := UnpackBaseVirtRepo(data, packageType)
-		return repo, repo.Id(), nil
+}
+
+type virtualGenericResource struct {
+	virtualResource
+}
+
+type virtualGenericResourceModel struct {
+	VirtualResourceModel
+}
+
+func (r *virtualGenericResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r virtualGenericResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualGenericResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualGenericResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualGenericResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualGenericResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualGenericResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualGenericResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	return r.VirtualResourceModel.FromAPIModel(ctx, *apiModel.(*VirtualAPIModel))
+}
+
+func (r *virtualGenericResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+		),
+		Description: r.Description,
 	}
+}
 
-	genericSchemas := GetSchemas(repository.RepoLayoutRefSDKv2Schema(Rclass, packageType))
+func NewGenericWithRetrievalCachePeriodSecsVirtualRepositoryResource(packageType string) func() resource.Resource {
+	return func() resource.Resource {
+		return &virtualGenericWithRetrievalCachePeriodSecsResource{
+			virtualResource: NewVirtualRepositoryResource(
+				packageType,
+				repository.PackageNameLookup[packageType],
+				reflect.TypeFor[virtualGenericWithRetrievalCachePeriodSecsResourceModel](),
+				reflect.TypeFor[VirtualGenericWithRetrievalCachePeriodSecsAPIModel](),
+			),
+		}
+	}
+}
 
-	return repository.MkResourceSchema(
-		genericSchemas,
-		packer.Default(genericSchemas[CurrentSchemaVersion]),
-		unpack,
-		constructor,
-	)
+type virtualGenericWithRetrievalCachePeriodSecsResource struct {
+	virtualResource
 }
 
-var RepoWithRetrivalCachePeriodSecsVirtualSchemas = func(packageType string) map[int16]map[string]*schema.Schema {
-	var repoWithRetrivalCachePeriodSecsVirtualSchema = lo.Assign(
-		RetrievalCachePeriodSecondsSchema,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, packageType),
-	)
+type virtualGenericWithRetrievalCachePeriodSecsResourceModel struct {
+	VirtualResourceModel
+	RetrievalCachePeriodSeconds types.Int64 `tfsdk:"retrieval_cache_period_seconds"`
+}
 
-	return GetSchemas(repoWithRetrivalCachePeriodSecsVirtualSchema)
+func (r *virtualGenericWithRetrievalCachePeriodSecsResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r virtualGenericWithRetrievalCachePeriodSecsResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualGenericWithRetrievalCachePeriodSecsResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualGenericWithRetrievalCachePeriodSecsResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualGenericWithRetrievalCachePeriodSecsResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualGenericWithRetrievalCachePeriodSecsResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualGenericWithRetrievalCachePeriodSecsResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
 }
 
-func ResourceArtifactoryVirtualRepositoryWithRetrievalCachePeriodSecs(packageType string) *schema.Resource {
-	repoWithRetrivalCachePeriodSecsVirtualSchemas := RepoWithRetrivalCachePeriodSecsVirtualSchemas(packageType)
+func (r virtualGenericWithRetrievalCachePeriodSecsResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
 
-	constructor := func() (interface{}, error) {
-		return &RepositoryBaseParamsWithRetrievalCachePeriodSecs{
-			RepositoryBaseParams: RepositoryBaseParams{
-				Rclass:      Rclass,
-				PackageType: packageType,
-			},
-		}, nil
+	virtualAPIModel, d := r.VirtualResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	unpack := func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := UnpackBaseVirtRepoWithRetrievalCachePeriodSecs(data, packageType)
-		return repo, repo.Id(), nil
+	return VirtualGenericWithRetrievalCachePeriodSecsAPIModel{
+		VirtualAPIModel:                 virtualAPIModel.(VirtualAPIModel),
+		VirtualRetrievalCachePeriodSecs: r.RetrievalCachePeriodSeconds.ValueInt64(),
+	}, diags
+}
+
+func (r *virtualGenericWithRetrievalCachePeriodSecsResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*VirtualGenericWithRetrievalCachePeriodSecsAPIModel)
+
+	diags.Append(r.VirtualResourceModel.FromAPIModel(ctx, model.VirtualAPIModel)...)
+	r.RetrievalCachePeriodSeconds = types.Int64Value(model.VirtualRetrievalCachePeriodSecs)
+
+	return diags
+}
+
+type VirtualGenericWithRetrievalCachePeriodSecsAPIModel struct {
+	VirtualAPIModel
+	VirtualRetrievalCachePeriodSecs int64 `json:"virtualRetrievalCachePeriodSecs"`
+}
+
+func (r *virtualGenericWithRetrievalCachePeriodSecsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+			RetrievalCachePeriodSecondsAttribute,
+		),
+		Description: r.Description,
 	}
+}
 
-	return repository.MkResourceSchema(
-		repoWithRetrivalCachePeriodSecsVirtualSchemas,
-		packer.Default(repoWithRetrivalCachePeriodSecsVirtualSchemas[CurrentSchemaVersion]),
-		unpack,
-		constructor,
+// SDKv2
+var RepoWithRetrivalCachePeriodSecsVirtualSchemas = func(packageType string) map[int16]map[string]*sdkv2_schema.Schema {
+	var repoWithRetrivalCachePeriodSecsVirtualSchema = lo.Assign(
+		RetrievalCachePeriodSecondsSchema,
+		repository.RepoLayoutRefSDKv2Schema(Rclass, packageType),
 	)
+
+	return GetSchemas(repoWithRetrivalCachePeriodSecsVirtualSchema)
 }
@@ -15,26 +15,167 @@
 package virtual
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/samber/lo"
 )
 
+func NewGoVirtualRepositoryResource() resource.Resource {
+	return &virtualGoResource{
+		virtualResource: NewVirtualRepositoryResource(
+			repository.GoPackageType,
+			"Go",
+			reflect.TypeFor[virtualGoResourceModel](),
+			reflect.TypeFor[VirtualGoAPIModel](),
+		),
+	}
+}
+
+type virtualGoResource struct {
+	virtualResource
+}
+
+type virtualGoResourceModel struct {
+	VirtualResourceModel
+	ExternalDependenciesEnabled  types.Bool `tfsdk:"external_dependencies_enabled"`
+	ExternalDependenciesPatterns types.List `tfsdk:"external_dependencies_patterns"`
+}
+
+func (r *virtualGoResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r virtualGoResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualGoResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualGoResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualGoResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualGoResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualGoResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r virtualGoResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	virtualAPIModel, d := r.VirtualResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
+	}
+
+	var externalDependenciesPatterns []string
+	d = r.ExternalDependenciesPatterns.ElementsAs(ctx, &externalDependenciesPatterns, false)
+	if d != nil {
+		diags.Append(d...)
+	}
+
+	return VirtualGoAPIModel{
+		VirtualAPIModel:              virtualAPIModel.(VirtualAPIModel),
+		ExternalDependenciesEnabled:  r.ExternalDependenciesEnabled.ValueBool(),
+		ExternalDependenciesPatterns: externalDependenciesPatterns,
+	}, diags
+}
+
+func (r *virtualGoResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*VirtualGoAPIModel)
+
+	diags.Append(r.VirtualResourceModel.FromAPIModel(ctx, model.VirtualAPIModel)...)
+	r.ExternalDependenciesEnabled = types.BoolValue(model.ExternalDependenciesEnabled)
+
+	r.ExternalDependenciesPatterns = types.ListNull(types.StringType)
+	if len(model.ExternalDependenciesPatterns) > 0 {
+		externalDependenciesPatterns, d := types.ListValueFrom(ctx, types.StringType, model.ExternalDependenciesPatterns)
+		if d != nil {
+			diags.Append(d...)
+		}
+		r.ExternalDependenciesPatterns = externalDependenciesPatterns
+	}
+
+	return diags
+}
+
+type VirtualGoAPIModel struct {
+	VirtualAPIModel
+	ExternalDependenciesEnabled  bool     `json:"externalDependenciesEnabled"`
+	ExternalDependenciesPatterns []string `json:"externalDependenciesPatterns,omitempty"`
+}
+
+func (r *virtualGoResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+			map[string]schema.Attribute{
+				"external_dependencies_enabled": schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(true),
+					MarkdownDescription: "When set (default), Artifactory will automatically follow remote VCS roots in 'go-import' meta tags to download remote modules.",
+				},
+				"external_dependencies_patterns": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Validators: []validator.List{
+						listvalidator.AlsoRequires(path.MatchRoot("external_dependencies_enabled")),
+					},
+					MarkdownDescription: "An allow list of Ant-style path patterns that determine which remote VCS roots Artifactory will " +
+						"follow to download remote modules from, when presented with 'go-import' meta tags in the remote repository response.",
+				},
+			},
+		),
+		Description: r.Description,
+	}
+}
+
+// SDKv2
 var goSchema = lo.Assign(
-	map[string]*schema.Schema{
+	map[string]*sdkv2_schema.Schema{
 		"external_dependencies_enabled": {
-			Type:        schema.TypeBool,
+			Type:        sdkv2_schema.TypeBool,
 			Default:     true,
 			Optional:    true,
 			Description: "When set (default), Artifactory will automatically follow remote VCS roots in 'go-import' meta tags to download remote modules.",
 		},
 		"external_dependencies_patterns": {
-			Type:     schema.TypeList,
+			Type:     sdkv2_schema.TypeList,
 			Optional: true,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
+			Elem: &sdkv2_schema.Schema{
+				Type: sdkv2_schema.TypeString,
 			},
 			RequiredWith: []string{"external_dependencies_enabled"},
 			Description: "An allow list of Ant-style path patterns that determine which remote VCS roots Artifactory will " +
@@ -45,38 +186,3 @@ var goSchema = lo.Assign(
 )
 
 var GoSchemas = GetSchemas(goSchema)
-
-func ResourceArtifactoryVirtualGoRepository() *schema.Resource {
-	type GoVirtualRepositoryParams struct {
-		RepositoryBaseParams
-		ExternalDependenciesEnabled  bool     `hcl:"external_dependencies_enabled" json:"externalDependenciesEnabled,omitempty"`
-		ExternalDependenciesPatterns []string `hcl:"external_dependencies_patterns" json:"externalDependenciesPatterns,omitempty"`
-	}
-
-	var unpackGoVirtualRepository = func(s *schema.ResourceData) (interface{}, string, error) {
-		d := &utilsdk.ResourceData{ResourceData: s}
-
-		repo := GoVirtualRepositoryParams{
-			RepositoryBaseParams:         UnpackBaseVirtRepo(s, repository.GoPackageType),
-			ExternalDependenciesPatterns: d.GetList("external_dependencies_patterns"),
-			ExternalDependenciesEnabled:  d.GetBool("external_dependencies_enabled", false),
-		}
-		return &repo, repo.Key, nil
-	}
-
-	constructor := func() (interface{}, error) {
-		return &GoVirtualRepositoryParams{
-			RepositoryBaseParams: RepositoryBaseParams{
-				Rclass:      Rclass,
-				PackageType: repository.GoPackageType,
-			},
-		}, nil
-	}
-
-	return repository.MkResourceSchema(
-		GoSchemas,
-		packer.Default(GoSchemas[CurrentSchemaVersion]),
-		unpackGoVirtualRepository,
-		constructor,
-	)
-}
@@ -15,18 +15,134 @@
 package virtual
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/samber/lo"
 )
 
+func NewHelmVirtualRepositoryResource() resource.Resource {
+	return &virtualHelmResource{
+		virtualResource: NewVirtualRepositoryResource(
+			repository.HelmPackageType,
+			"Helm",
+			reflect.TypeFor[virtualHelmResourceModel](),
+			reflect.TypeFor[VirtualHelmAPIModel](),
+		),
+	}
+}
+
+type virtualHelmResource struct {
+	virtualResource
+}
+
+type virtualHelmResourceModel struct {
+	VirtualResourceModel
+	RetrievalCachePeriodSeconds types.Int64 `tfsdk:"retrieval_cache_period_seconds"`
+	UseNamespaces               types.Bool  `tfsdk:"use_namespaces"`
+}
+
+func (r *virtualHelmResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r virtualHelmResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualHelmResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualHelmResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualHelmResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualHelmResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualHelmResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r virtualHelmResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	virtualAPIModel, d := r.VirtualResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
+	}
+
+	return VirtualHelmAPIModel{
+		VirtualAPIModel:                 virtualAPIModel.(VirtualAPIModel),
+		VirtualRetrievalCachePeriodSecs: r.RetrievalCachePeriodSeconds.ValueInt64(),
+		UseNamespaces:                   r.UseNamespaces.ValueBool(),
+	}, diags
+}
+
+func (r *virtualHelmResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*VirtualHelmAPIModel)
+
+	diags.Append(r.VirtualResourceModel.FromAPIModel(ctx, model.VirtualAPIModel)...)
+	r.RetrievalCachePeriodSeconds = types.Int64Value(model.VirtualRetrievalCachePeriodSecs)
+	r.UseNamespaces = types.BoolValue(model.UseNamespaces)
+
+	return diags
+}
+
+type VirtualHelmAPIModel struct {
+	VirtualAPIModel
+	VirtualRetrievalCachePeriodSecs int64 `json:"virtualRetrievalCachePeriodSecs"`
+	UseNamespaces                   bool  `json:"useNamespaces"`
+}
+
+func (r *virtualHelmResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+			RetrievalCachePeriodSecondsAttribute,
+			map[string]schema.Attribute{
+				"use_namespaces": schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+					MarkdownDescription: "From Artifactory 7.24.1 (SaaS Version), you can explicitly state a specific aggregated local or remote repository to fetch from a virtual by assigning namespaces to local and remote repositories. See https://www.jfrog.com/confluence/display/JFROG/Kubernetes+Helm+Chart+Repositories#KubernetesHelmChartRepositories-NamespaceSupportforHelmVirtualRepositories. Default to 'false'",
+				},
+			},
+		),
+		Description: r.Description,
+	}
+}
+
+// SDKv2
 var helmSchema = lo.Assign(
 	RetrievalCachePeriodSecondsSchema,
-	map[string]*schema.Schema{
+	map[string]*sdkv2_schema.Schema{
 		"use_namespaces": {
-			Type:        schema.TypeBool,
+			Type:        sdkv2_schema.TypeBool,
 			Optional:    true,
 			Default:     false,
 			Description: "From Artifactory 7.24.1 (SaaS Version), you can explicitly state a specific aggregated local or remote repository to fetch from a virtual by assigning namespaces to local and remote repositories\nSee https://www.jfrog.com/confluence/display/JFROG/Kubernetes+Helm+Chart+Repositories#KubernetesHelmChartRepositories-NamespaceSupportforHelmVirtualRepositories. Default to 'false'",
@@ -36,39 +152,3 @@ var helmSchema = lo.Assign(
 )
 
 var HelmSchemas = GetSchemas(helmSchema)
-
-func ResourceArtifactoryVirtualHelmRepository() *schema.Resource {
-	type HelmVirtualRepositoryParams struct {
-		RepositoryBaseParamsWithRetrievalCachePeriodSecs
-		UseNamespaces bool `json:"useNamespaces"`
-	}
-
-	unpackHelmVirtualRepository := func(data *schema.ResourceData) (interface{}, string, error) {
-		d := &utilsdk.ResourceData{ResourceData: data}
-		repo := HelmVirtualRepositoryParams{
-			RepositoryBaseParamsWithRetrievalCachePeriodSecs: UnpackBaseVirtRepoWithRetrievalCachePeriodSecs(data, repository.HelmPackageType),
-			UseNamespaces: d.GetBool("use_namespaces", false),
-		}
-
-		return repo, repo.Id(), nil
-	}
-
-	constructor := func() (interface{}, error) {
-		return &HelmVirtualRepositoryParams{
-			RepositoryBaseParamsWithRetrievalCachePeriodSecs: RepositoryBaseParamsWithRetrievalCachePeriodSecs{
-				RepositoryBaseParams: RepositoryBaseParams{
-					Rclass:      Rclass,
-					PackageType: repository.HelmPackageType,
-				},
-			},
-			UseNamespaces: false,
-		}, nil
-	}
-
-	return repository.MkResourceSchema(
-		HelmSchemas,
-		packer.Default(HelmSchemas[CurrentSchemaVersion]),
-		unpackHelmVirtualRepository,
-		constructor,
-	)
-}
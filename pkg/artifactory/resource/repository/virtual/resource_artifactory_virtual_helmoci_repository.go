@@ -15,17 +15,128 @@
 package virtual
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/samber/lo"
 )
 
+func NewHelmOCIVirtualRepositoryResource() resource.Resource {
+	return &virtualHelmOCIResource{
+		virtualResource: NewVirtualRepositoryResource(
+			repository.HelmOCIPackageType,
+			"Helm OCI",
+			reflect.TypeFor[virtualHelmOCIResourceModel](),
+			reflect.TypeFor[VirtualHelmOCIAPIModel](),
+		),
+	}
+}
+
+type virtualHelmOCIResource struct {
+	virtualResource
+}
+
+type virtualHelmOCIResourceModel struct {
+	VirtualResourceModel
+	ResolveOCITagsByTimestamp types.Bool `tfsdk:"resolve_oci_tags_by_timestamp"`
+}
+
+func (r *virtualHelmOCIResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r virtualHelmOCIResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualHelmOCIResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualHelmOCIResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualHelmOCIResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualHelmOCIResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualHelmOCIResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r virtualHelmOCIResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	virtualAPIModel, d := r.VirtualResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
+	}
+
+	return VirtualHelmOCIAPIModel{
+		VirtualAPIModel:           virtualAPIModel.(VirtualAPIModel),
+		ResolveOCITagsByTimestamp: r.ResolveOCITagsByTimestamp.ValueBool(),
+	}, diags
+}
+
+func (r *virtualHelmOCIResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*VirtualHelmOCIAPIModel)
+
+	diags.Append(r.VirtualResourceModel.FromAPIModel(ctx, model.VirtualAPIModel)...)
+	r.ResolveOCITagsByTimestamp = types.BoolValue(model.ResolveOCITagsByTimestamp)
+
+	return diags
+}
+
+type VirtualHelmOCIAPIModel struct {
+	VirtualAPIModel
+	ResolveOCITagsByTimestamp bool `json:"resolveDockerTagsByTimestamp"`
+}
+
+func (r *virtualHelmOCIResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+			map[string]schema.Attribute{
+				"resolve_oci_tags_by_timestamp": schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+					MarkdownDescription: "When enabled, in cases where the same OCI tag exists in two or more of the aggregated repositories, Artifactory will return the tag that has the latest timestamp.",
+				},
+			},
+		),
+		Description: r.Description,
+	}
+}
+
+// SDKv2
 var helmOCISchema = lo.Assign(
-	map[string]*schema.Schema{
+	map[string]*sdkv2_schema.Schema{
 		"resolve_oci_tags_by_timestamp": {
-			Type:        schema.TypeBool,
+			Type:        sdkv2_schema.TypeBool,
 			Optional:    true,
 			Default:     false,
 			Description: "When enabled, in cases where the same OCI tag exists in two or more of the aggregated repositories, Artifactory will return the tag that has the latest timestamp.",
@@ -35,36 +146,3 @@ var helmOCISchema = lo.Assign(
 )
 
 var HelmOCISchemas = GetSchemas(helmOCISchema)
-
-type HelmOciVirtualRepositoryParams struct {
-	RepositoryBaseParams
-	ResolveOCITagsByTimestamp bool `hcl:"resolve_oci_tags_by_timestamp" json:"resolveDockerTagsByTimestamp"`
-}
-
-func ResourceArtifactoryVirtualHelmOciRepository() *schema.Resource {
-	unpackVirtualRepository := func(data *schema.ResourceData) (interface{}, string, error) {
-		d := &utilsdk.ResourceData{ResourceData: data}
-		repo := HelmOciVirtualRepositoryParams{
-			RepositoryBaseParams:      UnpackBaseVirtRepo(data, repository.HelmOCIPackageType),
-			ResolveOCITagsByTimestamp: d.GetBool("resolve_oci_tags_by_timestamp", false),
-		}
-
-		return repo, repo.Id(), nil
-	}
-
-	constructor := func() (interface{}, error) {
-		return &HelmOciVirtualRepositoryParams{
-			RepositoryBaseParams: RepositoryBaseParams{
-				Rclass:      Rclass,
-				PackageType: repository.HelmOCIPackageType,
-			},
-		}, nil
-	}
-
-	return repository.MkResourceSchema(
-		HelmOCISchemas,
-		packer.Default(HelmOCISchemas[CurrentSchemaVersion]),
-		unpackVirtualRepository,
-		constructor,
-	)
-}
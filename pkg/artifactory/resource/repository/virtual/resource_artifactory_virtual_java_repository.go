@@ -15,34 +15,164 @@
 package virtual
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/samber/lo"
 )
 
-type CommonJavaVirtualRepositoryParams struct {
-	ForceMavenAuthentication             bool   `json:"forceMavenAuthentication,omitempty"`
-	PomRepositoryReferencesCleanupPolicy string `hcl:"pom_repository_references_cleanup_policy" json:"pomRepositoryReferencesCleanupPolicy,omitempty"`
-	KeyPair                              string `hcl:"key_pair" json:"keyPair,omitempty"`
+func NewJavaVirtualRepositoryResource(packageType string) func() resource.Resource {
+	return func() resource.Resource {
+		return &virtualJavaResource{
+			virtualResource: NewVirtualRepositoryResource(
+				packageType,
+				repository.PackageNameLookup[packageType],
+				reflect.TypeFor[virtualJavaResourceModel](),
+				reflect.TypeFor[VirtualJavaAPIModel](),
+			),
+		}
+	}
+}
+
+type virtualJavaResource struct {
+	virtualResource
+}
+
+type virtualJavaResourceModel struct {
+	VirtualResourceModel
+	ForceMavenAuthentication             types.Bool   `tfsdk:"force_maven_authentication"`
+	PomRepositoryReferencesCleanupPolicy types.String `tfsdk:"pom_repository_references_cleanup_policy"`
+	KeyPair                              types.String `tfsdk:"key_pair"`
+}
+
+func (r *virtualJavaResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r virtualJavaResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualJavaResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
 }
 
-type JavaVirtualRepositoryParams struct {
-	RepositoryBaseParams
-	CommonJavaVirtualRepositoryParams
+func (r virtualJavaResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
 }
 
-var JavaSchema = map[string]*schema.Schema{
+func (r *virtualJavaResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualJavaResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualJavaResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r virtualJavaResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	virtualAPIModel, d := r.VirtualResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
+	}
+
+	return VirtualJavaAPIModel{
+		VirtualAPIModel:                      virtualAPIModel.(VirtualAPIModel),
+		ForceMavenAuthentication:             r.ForceMavenAuthentication.ValueBool(),
+		PomRepositoryReferencesCleanupPolicy: r.PomRepositoryReferencesCleanupPolicy.ValueString(),
+		KeyPair:                              r.KeyPair.ValueString(),
+	}, diags
+}
+
+func (r *virtualJavaResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*VirtualJavaAPIModel)
+
+	diags.Append(r.VirtualResourceModel.FromAPIModel(ctx, model.VirtualAPIModel)...)
+	r.ForceMavenAuthentication = types.BoolValue(model.ForceMavenAuthentication)
+	r.PomRepositoryReferencesCleanupPolicy = types.StringValue(model.PomRepositoryReferencesCleanupPolicy)
+	r.KeyPair = types.StringValue(model.KeyPair)
+
+	return diags
+}
+
+type VirtualJavaAPIModel struct {
+	VirtualAPIModel
+	ForceMavenAuthentication             bool   `json:"forceMavenAuthentication"`
+	PomRepositoryReferencesCleanupPolicy string `json:"pomRepositoryReferencesCleanupPolicy,omitempty"`
+	KeyPair                              string `json:"keyPair"`
+}
+
+func (r *virtualJavaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+			map[string]schema.Attribute{
+				"force_maven_authentication": schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+					MarkdownDescription: "User authentication is required when accessing the repository. An anonymous request will display an HTTP 401 error. This is also enforced when aggregated repositories support anonymous requests.",
+				},
+				"pom_repository_references_cleanup_policy": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString("discard_active_reference"),
+					Validators: []validator.String{
+						stringvalidator.OneOf("discard_active_reference", "discard_any_reference", "nothing"),
+					},
+					MarkdownDescription: "(1: discard_active_reference) Discard Active References - Removes repository elements that are declared directly under project or under a profile in the same POM that is activeByDefault.\n" +
+						"(2: discard_any_reference) Discard Any References - Removes all repository elements regardless of whether they are included in an active profile or not.\n" +
+						"(3: nothing) Nothing - Does not remove any repository elements declared in the POM.",
+				},
+				"key_pair": schema.StringAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(""),
+					MarkdownDescription: "The keypair used to sign artifacts",
+				},
+			},
+		),
+		Description: r.Description,
+	}
+}
+
+// SDKv2
+var JavaSchema = map[string]*sdkv2_schema.Schema{
 	"force_maven_authentication": {
-		Type:        schema.TypeBool,
+		Type:        sdkv2_schema.TypeBool,
 		Computed:    true,
 		Optional:    true,
 		Description: "User authentication is required when accessing the repository. An anonymous request will display an HTTP 401 error. This is also enforced when aggregated repositories support anonymous requests.",
 	},
 	"pom_repository_references_cleanup_policy": {
-		Type:     schema.TypeString,
+		Type:     sdkv2_schema.TypeString,
 		Optional: true,
 		Computed: true,
 		ValidateFunc: validation.StringInSlice(
@@ -53,49 +183,8 @@ var JavaSchema = map[string]*schema.Schema{
 			"(3: nothing) Nothing - Does not remove any repository elements declared in the POM.",
 	},
 	"key_pair": {
-		Type:        schema.TypeString,
+		Type:        sdkv2_schema.TypeString,
 		Optional:    true,
 		Description: "The keypair used to sign artifacts",
 	},
 }
-
-func ResourceArtifactoryVirtualJavaRepository(packageType string) *schema.Resource {
-	var mavenSchema = lo.Assign(
-		JavaSchema,
-		repository.RepoLayoutRefSDKv2Schema(Rclass, packageType),
-	)
-
-	var mavenSchemas = GetSchemas(mavenSchema)
-
-	var unpackMavenVirtualRepository = func(s *schema.ResourceData) (interface{}, string, error) {
-		d := &utilsdk.ResourceData{ResourceData: s}
-
-		repo := JavaVirtualRepositoryParams{
-			RepositoryBaseParams: UnpackBaseVirtRepo(s, packageType),
-			CommonJavaVirtualRepositoryParams: CommonJavaVirtualRepositoryParams{
-				KeyPair:                              d.GetString("key_pair", false),
-				ForceMavenAuthentication:             d.GetBool("force_maven_authentication", false),
-				PomRepositoryReferencesCleanupPolicy: d.GetString("pom_repository_references_cleanup_policy", false),
-			},
-		}
-		repo.PackageType = packageType
-
-		return &repo, repo.Key, nil
-	}
-
-	constructor := func() (interface{}, error) {
-		return &JavaVirtualRepositoryParams{
-			RepositoryBaseParams: RepositoryBaseParams{
-				Rclass:      Rclass,
-				PackageType: packageType,
-			},
-		}, nil
-	}
-
-	return repository.MkResourceSchema(
-		mavenSchemas,
-		packer.Default(mavenSchemas[CurrentSchemaVersion]),
-		unpackMavenVirtualRepository,
-		constructor,
-	)
-}
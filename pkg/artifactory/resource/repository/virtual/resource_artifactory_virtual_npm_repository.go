@@ -15,52 +15,129 @@
 package virtual
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/samber/lo"
 )
 
-var npmSchema = lo.Assign(
-	RetrievalCachePeriodSecondsSchema,
-	externalDependenciesSchema,
-	repository.RepoLayoutRefSDKv2Schema(Rclass, repository.NPMPackageType),
-)
+func NewNPMVirtualRepositoryResource() resource.Resource {
+	return &virtualNPMResource{
+		virtualResource: NewVirtualRepositoryResource(
+			repository.NPMPackageType,
+			"Npm",
+			reflect.TypeFor[virtualNPMResourceModel](),
+			reflect.TypeFor[VirtualNPMAPIModel](),
+		),
+	}
+}
 
-var NPMSchemas = GetSchemas(npmSchema)
+type virtualNPMResource struct {
+	virtualResource
+}
+
+type virtualNPMResourceModel struct {
+	VirtualResourceModel
+	RetrievalCachePeriodSeconds types.Int64 `tfsdk:"retrieval_cache_period_seconds"`
+	ExternalDependenciesResourceModel
+}
+
+func (r *virtualNPMResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r virtualNPMResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualNPMResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualNPMResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualNPMResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualNPMResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
 
-type NpmVirtualRepositoryParams struct {
-	ExternalDependenciesVirtualRepositoryParams
-	VirtualRetrievalCachePeriodSecs int `hcl:"retrieval_cache_period_seconds" json:"virtualRetrievalCachePeriodSecs"`
+func (r virtualNPMResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
 }
 
-func ResourceArtifactoryVirtualNpmRepository() *schema.Resource {
-	var unpackNpmVirtualRepository = func(s *schema.ResourceData) (interface{}, string, error) {
-		d := &utilsdk.ResourceData{ResourceData: s}
+func (r virtualNPMResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
 
-		repo := NpmVirtualRepositoryParams{
-			VirtualRetrievalCachePeriodSecs:             d.GetInt("retrieval_cache_period_seconds", false),
-			ExternalDependenciesVirtualRepositoryParams: unpackExternalDependenciesVirtualRepository(s, repository.NPMPackageType),
-		}
-		return &repo, repo.Key, nil
+	virtualAPIModel, d := r.VirtualResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	constructor := func() (interface{}, error) {
-		return &NpmVirtualRepositoryParams{
-			ExternalDependenciesVirtualRepositoryParams: ExternalDependenciesVirtualRepositoryParams{
-				RepositoryBaseParams: RepositoryBaseParams{
-					Rclass:      Rclass,
-					PackageType: repository.NPMPackageType,
-				},
-			},
-		}, nil
+	externalDependencies, d := r.ExternalDependenciesResourceModel.ToAPIModel(ctx)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return repository.MkResourceSchema(
-		NPMSchemas,
-		packer.Default(NPMSchemas[CurrentSchemaVersion]),
-		unpackNpmVirtualRepository,
-		constructor,
-	)
+	return VirtualNPMAPIModel{
+		VirtualAPIModel:                 virtualAPIModel.(VirtualAPIModel),
+		VirtualRetrievalCachePeriodSecs: r.RetrievalCachePeriodSeconds.ValueInt64(),
+		ExternalDependenciesAPIModel:    externalDependencies,
+	}, diags
 }
+
+func (r *virtualNPMResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*VirtualNPMAPIModel)
+
+	diags.Append(r.VirtualResourceModel.FromAPIModel(ctx, model.VirtualAPIModel)...)
+	r.RetrievalCachePeriodSeconds = types.Int64Value(model.VirtualRetrievalCachePeriodSecs)
+	diags.Append(r.ExternalDependenciesResourceModel.FromAPIModel(ctx, model.ExternalDependenciesAPIModel)...)
+
+	return diags
+}
+
+type VirtualNPMAPIModel struct {
+	VirtualAPIModel
+	VirtualRetrievalCachePeriodSecs int64 `json:"virtualRetrievalCachePeriodSecs"`
+	ExternalDependenciesAPIModel
+}
+
+func (r *virtualNPMResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+			RetrievalCachePeriodSecondsAttribute,
+			externalDependenciesAttributes,
+		),
+		Description: r.Description,
+	}
+}
+
+// SDKv2
+var npmSchema = lo.Assign(
+	RetrievalCachePeriodSecondsSchema,
+	externalDependenciesSchema,
+	repository.RepoLayoutRefSDKv2Schema(Rclass, repository.NPMPackageType),
+)
+
+var NPMSchemas = GetSchemas(npmSchema)
@@ -15,17 +15,128 @@
 package virtual
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/samber/lo"
 )
 
+func NewNugetVirtualRepositoryResource() resource.Resource {
+	return &virtualNugetResource{
+		virtualResource: NewVirtualRepositoryResource(
+			repository.NugetPackageType,
+			"NuGet",
+			reflect.TypeFor[virtualNugetResourceModel](),
+			reflect.TypeFor[VirtualNugetAPIModel](),
+		),
+	}
+}
+
+type virtualNugetResource struct {
+	virtualResource
+}
+
+type virtualNugetResourceModel struct {
+	VirtualResourceModel
+	ForceNugetAuthentication types.Bool `tfsdk:"force_nuget_authentication"`
+}
+
+func (r *virtualNugetResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r virtualNugetResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualNugetResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualNugetResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualNugetResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualNugetResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualNugetResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r virtualNugetResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	virtualAPIModel, d := r.VirtualResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
+	}
+
+	return VirtualNugetAPIModel{
+		VirtualAPIModel:          virtualAPIModel.(VirtualAPIModel),
+		ForceNugetAuthentication: r.ForceNugetAuthentication.ValueBool(),
+	}, diags
+}
+
+func (r *virtualNugetResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*VirtualNugetAPIModel)
+
+	diags.Append(r.VirtualResourceModel.FromAPIModel(ctx, model.VirtualAPIModel)...)
+	r.ForceNugetAuthentication = types.BoolValue(model.ForceNugetAuthentication)
+
+	return diags
+}
+
+type VirtualNugetAPIModel struct {
+	VirtualAPIModel
+	ForceNugetAuthentication bool `json:"forceNugetAuthentication"`
+}
+
+func (r *virtualNugetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+			map[string]schema.Attribute{
+				"force_nuget_authentication": schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+					MarkdownDescription: "If set, user authentication is required when accessing the repository. An anonymous request will display an HTTP 401 error. This is also enforced when aggregated repositories support anonymous requests.",
+				},
+			},
+		),
+		Description: r.Description,
+	}
+}
+
+// SDKv2
 var nugetSchema = lo.Assign(
-	map[string]*schema.Schema{
+	map[string]*sdkv2_schema.Schema{
 		"force_nuget_authentication": {
-			Type:        schema.TypeBool,
+			Type:        sdkv2_schema.TypeBool,
 			Optional:    true,
 			Default:     false,
 			Description: "If set, user authentication is required when accessing the repository. An anonymous request will display an HTTP 401 error. This is also enforced when aggregated repositories support anonymous requests.",
@@ -35,38 +146,3 @@ var nugetSchema = lo.Assign(
 )
 
 var NugetSchemas = GetSchemas(nugetSchema)
-
-func ResourceArtifactoryVirtualNugetRepository() *schema.Resource {
-
-	type NugetVirtualRepositoryParams struct {
-		RepositoryBaseParams
-		ForceNugetAuthentication bool `json:"forceNugetAuthentication"`
-	}
-
-	var unpackNugetVirtualRepository = func(s *schema.ResourceData) (interface{}, string, error) {
-		d := &utilsdk.ResourceData{ResourceData: s}
-
-		repo := NugetVirtualRepositoryParams{
-			RepositoryBaseParams:     UnpackBaseVirtRepo(s, repository.NugetPackageType),
-			ForceNugetAuthentication: d.GetBool("force_nuget_authentication", false),
-		}
-		repo.PackageType = repository.NugetPackageType
-		return &repo, repo.Key, nil
-	}
-
-	constructor := func() (interface{}, error) {
-		return &NugetVirtualRepositoryParams{
-			RepositoryBaseParams: RepositoryBaseParams{
-				Rclass:      Rclass,
-				PackageType: repository.NugetPackageType,
-			},
-		}, nil
-	}
-
-	return repository.MkResourceSchema(
-		NugetSchemas,
-		packer.Default(NugetSchemas[CurrentSchemaVersion]),
-		unpackNugetVirtualRepository,
-		constructor,
-	)
-}
@@ -15,17 +15,128 @@
 package virtual
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/samber/lo"
 )
 
+func NewOCIVirtualRepositoryResource() resource.Resource {
+	return &virtualOCIResource{
+		virtualResource: NewVirtualRepositoryResource(
+			repository.OCIPackageType,
+			"OCI",
+			reflect.TypeFor[virtualOCIResourceModel](),
+			reflect.TypeFor[VirtualOCIAPIModel](),
+		),
+	}
+}
+
+type virtualOCIResource struct {
+	virtualResource
+}
+
+type virtualOCIResourceModel struct {
+	VirtualResourceModel
+	ResolveOCITagsByTimestamp types.Bool `tfsdk:"resolve_oci_tags_by_timestamp"`
+}
+
+func (r *virtualOCIResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r virtualOCIResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualOCIResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualOCIResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualOCIResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualOCIResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualOCIResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r virtualOCIResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	virtualAPIModel, d := r.VirtualResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
+	}
+
+	return VirtualOCIAPIModel{
+		VirtualAPIModel:           virtualAPIModel.(VirtualAPIModel),
+		ResolveOCITagsByTimestamp: r.ResolveOCITagsByTimestamp.ValueBool(),
+	}, diags
+}
+
+func (r *virtualOCIResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*VirtualOCIAPIModel)
+
+	diags.Append(r.VirtualResourceModel.FromAPIModel(ctx, model.VirtualAPIModel)...)
+	r.ResolveOCITagsByTimestamp = types.BoolValue(model.ResolveOCITagsByTimestamp)
+
+	return diags
+}
+
+type VirtualOCIAPIModel struct {
+	VirtualAPIModel
+	ResolveOCITagsByTimestamp bool `json:"resolveDockerTagsByTimestamp"`
+}
+
+func (r *virtualOCIResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+			map[string]schema.Attribute{
+				"resolve_oci_tags_by_timestamp": schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+					MarkdownDescription: "When enabled, in cases where the same OCI tag exists in two or more of the aggregated repositories, Artifactory will return the tag that has the latest timestamp.",
+				},
+			},
+		),
+		Description: r.Description,
+	}
+}
+
+// SDKv2
 var ociSchema = lo.Assign(
-	map[string]*schema.Schema{
+	map[string]*sdkv2_schema.Schema{
 		"resolve_oci_tags_by_timestamp": {
-			Type:        schema.TypeBool,
+			Type:        sdkv2_schema.TypeBool,
 			Optional:    true,
 			Default:     false,
 			Description: "When enabled, in cases where the same OCI tag exists in two or more of the aggregated repositories, Artifactory will return the tag that has the latest timestamp.",
@@ -35,35 +146,3 @@ var ociSchema = lo.Assign(
 )
 
 var OCISchemas = GetSchemas(ociSchema)
-
-func ResourceArtifactoryVirtualOciRepository() *schema.Resource {
-	type OciVirtualRepositoryParams struct {
-		RepositoryBaseParams
-		ResolveOciTagsByTimestamp bool `hcl:"resolve_oci_tags_by_timestamp" json:"resolveDockerTagsByTimestamp"`
-	}
-
-	unpackOciVirtualRepository := func(data *schema.ResourceData) (interface{}, string, error) {
-		d := &utilsdk.ResourceData{ResourceData: data}
-		repo := OciVirtualRepositoryParams{
-			RepositoryBaseParams:      UnpackBaseVirtRepo(data, repository.OCIPackageType),
-			ResolveOciTagsByTimestamp: d.GetBool("resolve_oci_tags_by_timestamp", false),
-		}
-		return repo, repo.Id(), nil
-	}
-
-	constructor := func() (interface{}, error) {
-		return &OciVirtualRepositoryParams{
-			RepositoryBaseParams: RepositoryBaseParams{
-				Rclass:      Rclass,
-				PackageType: repository.OCIPackageType,
-			},
-		}, nil
-	}
-
-	return repository.MkResourceSchema(
-		OCISchemas,
-		packer.Default(OCISchemas[CurrentSchemaVersion]),
-		unpackOciVirtualRepository,
-		constructor,
-	)
-}
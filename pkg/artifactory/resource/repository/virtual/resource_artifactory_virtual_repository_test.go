@@ -23,6 +23,7 @@ import (
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository/virtual"
@@ -53,7 +54,6 @@ func TestAccVirtualRepository_basic(t *testing.T) {
 				Config: fmt.Sprintf(virtualRepositoryBasic, name, name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "maven"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.#", "0"),
 				),
 			},
@@ -67,6 +67,48 @@ func TestAccVirtualRepository_basic(t *testing.T) {
 	})
 }
 
+func TestAccVirtualRepository_UpgradeFromSDKv2(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("virtual-npm-", "artifactory_virtual_npm_repository")
+
+	config := util.ExecuteTemplate("TestAccVirtualRepository_UpgradeFromSDKv2", `
+		resource "artifactory_virtual_npm_repository" "{{ .name }}" {
+			key                            = "{{ .name }}"
+			description                    = "Test virtual repo for {{ .name }}"
+			retrieval_cache_period_seconds = 650
+		}
+	`, map[string]interface{}{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: acctest.VerifyDeleted(t, fqrn, "key", acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"artifactory": {
+						Source:            "jfrog/artifactory",
+						VersionConstraint: "12.11.7",
+					},
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "retrieval_cache_period_seconds", "650"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Config:                   config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccVirtualRepository_reset_default_deployment_repo(t *testing.T) {
 	id := testutil.RandomInt()
 	name := fmt.Sprintf("foo%d", id)
@@ -105,7 +147,6 @@ func TestAccVirtualRepository_reset_default_deployment_repo(t *testing.T) {
 				Config: fmt.Sprintf(virtualRepositoryWithoutDefaultDeploymentRepo, localRepoName, name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "maven"),
 					resource.TestCheckResourceAttr(fqrn, "default_deployment_repo", ""),
 				),
 			},
@@ -113,7 +154,6 @@ func TestAccVirtualRepository_reset_default_deployment_repo(t *testing.T) {
 				Config: fmt.Sprintf(virtualRepositoryWithDefaultDeploymentRepo, localRepoName, name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "maven"),
 					resource.TestCheckResourceAttr(fqrn, "default_deployment_repo", localRepoName),
 				),
 			},
@@ -121,7 +161,6 @@ func TestAccVirtualRepository_reset_default_deployment_repo(t *testing.T) {
 				Config: fmt.Sprintf(virtualRepositoryWithoutDefaultDeploymentRepo, localRepoName, name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "maven"),
 					resource.TestCheckResourceAttr(fqrn, "default_deployment_repo", ""),
 				),
 			},
@@ -135,6 +174,129 @@ func TestAccVirtualRepository_reset_default_deployment_repo(t *testing.T) {
 	})
 }
 
+func TestAccVirtualRepository_invalid_members(t *testing.T) {
+	id := testutil.RandomInt()
+	name := fmt.Sprintf("foo%d", id)
+	npmRepoName := fmt.Sprintf("%s-npm-local", name)
+	virtualRepoName := fmt.Sprintf("%s-maven-virtual", name)
+
+	const mismatchedPackageType = `
+		resource "artifactory_virtual_maven_repository" "%[1]s" {
+			key          = "%[1]s"
+			repositories = ["%[2]s"]
+		}
+	`
+
+	const invalidDefaultDeploymentRepo = `
+		resource "artifactory_virtual_maven_repository" "%[1]s" {
+			key                     = "%[1]s"
+			repositories            = ["%[2]s"]
+			default_deployment_repo = "%[2]s"
+		}
+	`
+
+	const defaultDeploymentRepoNotMember = `
+		resource "artifactory_virtual_maven_repository" "%[1]s" {
+			key                     = "%[1]s"
+			repositories            = []
+			default_deployment_repo = "%[2]s"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateRepo(t, npmRepoName, "local", repository.NPMPackageType, false, false)
+			acctest.CreateRepo(t, virtualRepoName, "virtual", repository.MavenPackageType, false, false)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6MuxProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			acctest.DeleteRepo(t, npmRepoName)
+			acctest.DeleteRepo(t, virtualRepoName)
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(mismatchedPackageType, name, npmRepoName),
+				ExpectError: regexp.MustCompile(`(?s)not\s+compatible\s+with\s+virtual\s+repository`),
+			},
+			{
+				Config:      fmt.Sprintf(invalidDefaultDeploymentRepo, name, virtualRepoName),
+				ExpectError: regexp.MustCompile(`(?s)is\s+a\s+virtual\s+repository`),
+			},
+			{
+				Config:      fmt.Sprintf(defaultDeploymentRepoNotMember, name, virtualRepoName),
+				ExpectError: regexp.MustCompile(`(?s)must\s+be\s+included\s+in\s+repositories`),
+			},
+		},
+	})
+}
+
+func TestAccVirtualRepository_effective_resolution_order(t *testing.T) {
+	id := testutil.RandomInt()
+	name := fmt.Sprintf("foo%d", id)
+	fqrn := fmt.Sprintf("artifactory_virtual_maven_repository.%s", name)
+	nestedName := fmt.Sprintf("%s-nested", name)
+	localRepoName := fmt.Sprintf("%s-local", name)
+	nestedLocalRepoName := fmt.Sprintf("%s-nested-local", name)
+	remoteRepoName := fmt.Sprintf("%s-remote", name)
+
+	const config = `
+		resource "artifactory_local_maven_repository" "%[3]s" {
+			key = "%[3]s"
+		}
+
+		resource "artifactory_local_maven_repository" "%[4]s" {
+			key = "%[4]s"
+		}
+
+		resource "artifactory_remote_maven_repository" "%[5]s" {
+			key = "%[5]s"
+			url = "https://repo1.maven.org/maven2/"
+		}
+
+		resource "artifactory_virtual_maven_repository" "%[2]s" {
+			key          = "%[2]s"
+			repositories = [
+				artifactory_remote_maven_repository.%[5]s.key,
+				artifactory_local_maven_repository.%[4]s.key,
+			]
+		}
+
+		resource "artifactory_virtual_maven_repository" "%[1]s" {
+			key          = "%[1]s"
+			repositories = [
+				artifactory_virtual_maven_repository.%[2]s.key,
+				artifactory_local_maven_repository.%[3]s.key,
+			]
+			default_deployment_repo = artifactory_local_maven_repository.%[3]s.key
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6MuxProviderFactories,
+		CheckDestroy:             acctest.VerifyDeleted(t, fqrn, "key", acctest.CheckRepo),
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, name, nestedName, localRepoName, nestedLocalRepoName, remoteRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "effective_resolution_order.#", "3"),
+					resource.TestCheckResourceAttr(fqrn, "effective_resolution_order.0", nestedLocalRepoName),
+					resource.TestCheckResourceAttr(fqrn, "effective_resolution_order.1", localRepoName),
+					resource.TestCheckResourceAttr(fqrn, "effective_resolution_order.2", remoteRepoName),
+				),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateCheck:  validator.CheckImportState(name, "key"),
+			},
+		},
+	})
+}
+
 func TestAccVirtualGoRepository_basic(t *testing.T) {
 	_, fqrn, name := testutil.MkNames("foo", "artifactory_virtual_go_repository")
 	const packageType = "go"
@@ -164,7 +326,6 @@ func TestAccVirtualGoRepository_basic(t *testing.T) {
 				// we check to make sure some of the base params are picked up
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", packageType),
 					resource.TestCheckResourceAttr(fqrn, "external_dependencies_enabled", "true"),
 					resource.TestCheckResourceAttr(fqrn, "external_dependencies_patterns.0", "**/github.com/**"),
 					resource.TestCheckResourceAttr(fqrn, "external_dependencies_patterns.1", "**/go.googlesource.com/**"),
@@ -210,7 +371,6 @@ func TestAccVirtualConanRepository_basic(t *testing.T) {
 				// we check to make sure some of the base params are picked up
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "conan"),
 					resource.TestCheckResourceAttr(fqrn, "retrieval_cache_period_seconds", "7100"),
 				),
 			},
@@ -248,7 +408,6 @@ func TestAccVirtualGenericRepository_basic(t *testing.T) {
 				// we check to make sure some of the base params are picked up
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", packageType),
 					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", func() string {
 						r, _ := repository.GetDefaultRepoLayoutRef(virtual.Rclass, packageType)
 						return r
@@ -294,7 +453,6 @@ func TestAccVirtualMavenRepository_basic(t *testing.T) {
 				// we check to make sure some of the base params are picked up
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", packageType),
 					resource.TestCheckResourceAttr(fqrn, "force_maven_authentication", "true"),
 					// to test key pair, we'd have to be able to create them on the fly and we currently can't.
 					resource.TestCheckResourceAttr(fqrn, "key_pair", ""),
@@ -340,7 +498,6 @@ func TestAccVirtualHelmRepository_basic(t *testing.T) {
 				Config: virtualRepositoryBasic,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "helm"),
 					resource.TestCheckResourceAttr(fqrn, "use_namespaces", fmt.Sprintf("%t", useNamespaces)),
 					resource.TestCheckResourceAttr(fqrn, "retrieval_cache_period_seconds", "650"),
 				),
@@ -438,7 +595,6 @@ EOF
 				Config: virtualRepositoryBasic,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", packageType),
 					resource.TestCheckResourceAttr(fqrn, "primary_keypair_ref", kpName),
 					resource.TestCheckResourceAttr(fqrn, "secondary_keypair_ref", kpName2),
 					resource.TestCheckResourceAttr(fqrn, "retrieval_cache_period_seconds", "650"),
@@ -489,7 +645,6 @@ func TestAccVirtualRepository_update(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
 					resource.TestCheckResourceAttr(fqrn, "description", "Before"),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "maven"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.#", "0"),
 					resource.TestCheckResourceAttr(fqrn, "artifactory_requests_can_retrieve_remote_artifacts", "true"),
 				),
@@ -499,7 +654,6 @@ func TestAccVirtualRepository_update(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
 					resource.TestCheckResourceAttr(fqrn, "description", "After"),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "maven"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.#", "0"),
 					resource.TestCheckResourceAttr(fqrn, "artifactory_requests_can_retrieve_remote_artifacts", "false"),
 				),
@@ -541,7 +695,6 @@ func TestAccVirtualNugetRepository_PackageCreationFull(t *testing.T) {
 				Config: fmt.Sprintf(virtualRepositoryFull, name, name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "nuget"),
 					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "nuget-default"),
 					resource.TestCheckResourceAttr(fqrn, "force_nuget_authentication", "true"),
 				),
@@ -583,7 +736,6 @@ func TestAccVirtualRepository_full(t *testing.T) {
 				Config: fmt.Sprintf(virtualRepositoryFull, name, name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "maven"),
 					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "maven-1-default"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.#", "0"),
 					resource.TestCheckResourceAttr(fqrn, "description", "A test virtual repo"),
@@ -746,7 +898,6 @@ func mkNewVirtualTestCase(packageType string, t *testing.T, extraFields map[stri
 	`
 	extraChecks := testutil.MapToTestChecks(fqrn, extraFields)
 	defaultChecks := testutil.MapToTestChecks(fqrn, allFields)
-	defaultChecks = append(defaultChecks, resource.TestCheckResourceAttr(fqrn, "package_type", packageType))
 
 	checks := append(defaultChecks, extraChecks...)
 	config := fmt.Sprintf(virtualRepoFull, packageType, name, remoteRepoName, allFieldsHcl)
@@ -758,7 +909,6 @@ func mkNewVirtualTestCase(packageType string, t *testing.T, extraFields map[stri
 	updatedFieldsHcl := utilsdk.FmtMapToHcl(updatedFields)
 	updatedConfig := fmt.Sprintf(virtualRepoFull, packageType, name, remoteRepoName, updatedFieldsHcl)
 	updatedChecks := testutil.MapToTestChecks(fqrn, updatedFields)
-	updatedChecks = append(updatedChecks, resource.TestCheckResourceAttr(fqrn, "package_type", packageType))
 
 	return t, resource.TestCase{
 		ProtoV6ProviderFactories: acctest.ProtoV6MuxProviderFactories,
@@ -878,7 +1028,6 @@ func TestAccVirtualBowerExternalDependenciesRepository(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "bower"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.#", "1"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.0", remoteRepoName),
 					resource.TestCheckResourceAttr(fqrn, "external_dependencies_enabled", "true"),
@@ -891,7 +1040,6 @@ func TestAccVirtualBowerExternalDependenciesRepository(t *testing.T) {
 				Config: updatedConfig,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "bower"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.#", "1"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.0", remoteRepoName),
 					resource.TestCheckResourceAttr(fqrn, "external_dependencies_enabled", "true"),
@@ -967,7 +1115,6 @@ func TestAccVirtualGoExternalDependenciesRepository(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "go"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.#", "1"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.0", remoteRepoName),
 					resource.TestCheckResourceAttr(fqrn, "external_dependencies_enabled", "true"),
@@ -983,7 +1130,6 @@ func TestAccVirtualGoExternalDependenciesRepository(t *testing.T) {
 				Config: updatedConfig,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "go"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.#", "1"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.0", remoteRepoName),
 					resource.TestCheckResourceAttr(fqrn, "external_dependencies_enabled", "true"),
@@ -1064,7 +1210,6 @@ func TestAccVirtualNpmExternalDependenciesRepository(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "npm"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.#", "1"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.0", remoteRepoName),
 					resource.TestCheckResourceAttr(fqrn, "retrieval_cache_period_seconds", "650"),
@@ -1078,7 +1223,6 @@ func TestAccVirtualNpmExternalDependenciesRepository(t *testing.T) {
 				Config: updatedConfig,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "npm"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.#", "1"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.0", remoteRepoName),
 					resource.TestCheckResourceAttr(fqrn, "retrieval_cache_period_seconds", "650"),
@@ -1130,7 +1274,6 @@ func TestAccVirtualDebianRepository_full(t *testing.T) {
 				Config: fmt.Sprintf(virtualRepositoryBasic, name, name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "package_type", "debian"),
 					resource.TestCheckResourceAttr(fqrn, "retrieval_cache_period_seconds", "650"),
 					resource.TestCheckResourceAttr(fqrn, "repositories.#", "0"),
 				),
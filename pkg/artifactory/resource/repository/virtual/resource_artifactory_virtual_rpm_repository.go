@@ -15,67 +15,130 @@
 package virtual
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/samber/lo"
 )
 
-var rpmSchema = lo.Assign(
-	repository.PrimaryKeyPairRefSDKv2,
-	repository.SecondaryKeyPairRefSDKv2,
-	RetrievalCachePeriodSecondsSchema,
-	repository.RepoLayoutRefSDKv2Schema(Rclass, repository.RPMPackageType),
-)
+func NewRPMVirtualRepositoryResource() resource.Resource {
+	return &virtualRPMResource{
+		virtualResource: NewVirtualRepositoryResource(
+			repository.RPMPackageType,
+			"RPM",
+			reflect.TypeFor[virtualRPMResourceModel](),
+			reflect.TypeFor[VirtualRPMAPIModel](),
+		),
+	}
+}
 
-var RPMSchemas = GetSchemas(rpmSchema)
+type virtualRPMResource struct {
+	virtualResource
+}
 
-func ResourceArtifactoryVirtualRpmRepository() *schema.Resource {
-	type CommonRpmDebianVirtualRepositoryParams struct {
-		repository.PrimaryKeyPairRefParam
-		repository.SecondaryKeyPairRefParam
-	}
+type virtualRPMResourceModel struct {
+	VirtualResourceModel
+	RetrievalCachePeriodSeconds types.Int64  `tfsdk:"retrieval_cache_period_seconds"`
+	PrimaryKeyPairRef           types.String `tfsdk:"primary_keypair_ref"`
+	SecondaryKeyPairRef         types.String `tfsdk:"secondary_keypair_ref"`
+}
 
-	type RpmVirtualRepositoryParams struct {
-		RepositoryBaseParamsWithRetrievalCachePeriodSecs
-		CommonRpmDebianVirtualRepositoryParams
-	}
+func (r *virtualRPMResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
 
-	var unpackRpmVirtualRepository = func(s *schema.ResourceData) (interface{}, string, error) {
-		d := &utilsdk.ResourceData{ResourceData: s}
-
-		repo := RpmVirtualRepositoryParams{
-			RepositoryBaseParamsWithRetrievalCachePeriodSecs: UnpackBaseVirtRepoWithRetrievalCachePeriodSecs(s, "rpm"),
-			CommonRpmDebianVirtualRepositoryParams: CommonRpmDebianVirtualRepositoryParams{
-				PrimaryKeyPairRefParam: repository.PrimaryKeyPairRefParam{
-					PrimaryKeyPairRefSDKv2: d.GetString("primary_keypair_ref", false),
-				},
-				SecondaryKeyPairRefParam: repository.SecondaryKeyPairRefParam{
-					SecondaryKeyPairRefSDKv2: d.GetString("secondary_keypair_ref", false),
-				},
-			},
-		}
-		repo.PackageType = repository.RPMPackageType
-
-		return &repo, repo.Key, nil
-	}
+func (r virtualRPMResourceModel) SetCreateResourceStateData(ctx context.Context, resp *resource.CreateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r *virtualRPMResourceModel) GetReadResourceStateData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualRPMResourceModel) SetReadResourceStateData(ctx context.Context, resp *resource.ReadResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
 
-	constructor := func() (interface{}, error) {
-		return &RpmVirtualRepositoryParams{
-			RepositoryBaseParamsWithRetrievalCachePeriodSecs: RepositoryBaseParamsWithRetrievalCachePeriodSecs{
-				RepositoryBaseParams: RepositoryBaseParams{
-					Rclass:      Rclass,
-					PackageType: repository.RPMPackageType,
-				},
-			},
-		}, nil
+func (r *virtualRPMResourceModel) GetUpdateResourcePlanData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, r)...)
+}
+
+func (r *virtualRPMResourceModel) GetUpdateResourceStateData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, r)...)
+}
+
+func (r virtualRPMResourceModel) SetUpdateResourceStateData(ctx context.Context, resp *resource.UpdateResponse) {
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &r)...)
+}
+
+func (r virtualRPMResourceModel) ToAPIModel(ctx context.Context, packageType string) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	virtualAPIModel, d := r.VirtualResourceModel.ToAPIModel(ctx, packageType)
+	if d != nil {
+		diags.Append(d...)
 	}
 
-	return repository.MkResourceSchema(
-		RPMSchemas,
-		packer.Default(RPMSchemas[CurrentSchemaVersion]),
-		unpackRpmVirtualRepository,
-		constructor,
-	)
+	return VirtualRPMAPIModel{
+		VirtualAPIModel:                 virtualAPIModel.(VirtualAPIModel),
+		VirtualRetrievalCachePeriodSecs: r.RetrievalCachePeriodSeconds.ValueInt64(),
+		PrimaryKeyPairRef:               r.PrimaryKeyPairRef.ValueString(),
+		SecondaryKeyPairRef:             r.SecondaryKeyPairRef.ValueString(),
+	}, diags
+}
+
+func (r *virtualRPMResourceModel) FromAPIModel(ctx context.Context, apiModel interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model := apiModel.(*VirtualRPMAPIModel)
+
+	diags.Append(r.VirtualResourceModel.FromAPIModel(ctx, model.VirtualAPIModel)...)
+	r.RetrievalCachePeriodSeconds = types.Int64Value(model.VirtualRetrievalCachePeriodSecs)
+	r.PrimaryKeyPairRef = types.StringValue(model.PrimaryKeyPairRef)
+	r.SecondaryKeyPairRef = types.StringValue(model.SecondaryKeyPairRef)
+
+	return diags
 }
+
+type VirtualRPMAPIModel struct {
+	VirtualAPIModel
+	VirtualRetrievalCachePeriodSecs int64  `json:"virtualRetrievalCachePeriodSecs"`
+	PrimaryKeyPairRef               string `json:"primaryKeyPairRef"`
+	SecondaryKeyPairRef             string `json:"secondaryKeyPairRef"`
+}
+
+func (r *virtualRPMResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: CurrentSchemaVersion,
+		Attributes: lo.Assign(
+			VirtualAttributes,
+			repository.RepoLayoutRefAttribute(Rclass, r.PackageType),
+			RetrievalCachePeriodSecondsAttribute,
+			repository.PrimaryKeyPairRefAttribute,
+			repository.SecondaryKeyPairRefAttribute,
+		),
+		Description: r.Description,
+	}
+}
+
+// SDKv2
+var rpmSchema = lo.Assign(
+	repository.PrimaryKeyPairRefSDKv2,
+	repository.SecondaryKeyPairRefSDKv2,
+	RetrievalCachePeriodSecondsSchema,
+	repository.RepoLayoutRefSDKv2Schema(Rclass, repository.RPMPackageType),
+)
+
+var RPMSchemas = GetSchemas(rpmSchema)
@@ -16,20 +16,27 @@ package virtual
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/repository"
-	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/samber/lo"
 )
 
@@ -38,6 +45,9 @@ const (
 	CurrentSchemaVersion = 1
 )
 
+const effectiveResolutionOrderDescription = "The order in which Artifactory searches the aggregated repositories, with nested virtual repositories expanded. " +
+	"Local and federated repositories are searched first, followed by remote repositories, each in the order they are listed."
+
 // Framework Support
 
 func NewVirtualRepositoryResource(packageType, packageName string, resourceModelType, apiModelType reflect.Type) virtualResource {
@@ -50,12 +60,173 @@ type virtualResource struct {
 	repository.BaseResource
 }
 
+func (r *virtualResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.BaseResource.ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip on destroy or when the provider has not been configured
+	if req.Plan.Raw.IsNull() || r.ProviderData == nil {
+		return
+	}
+
+	var key, defaultDeploymentRepo types.String
+	var repositories types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("key"), &key)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("repositories"), &repositories)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("default_deployment_repo"), &defaultDeploymentRepo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	effectiveOrderPath := path.Root("effective_resolution_order")
+
+	if key.IsUnknown() || repositories.IsUnknown() || defaultDeploymentRepo.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, effectiveOrderPath, types.ListUnknown(types.StringType))...)
+		return
+	}
+
+	var members []types.String
+	resp.Diagnostics.Append(repositories.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if lo.ContainsBy(members, func(m types.String) bool { return m.IsUnknown() }) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, effectiveOrderPath, types.ListUnknown(types.StringType))...)
+		return
+	}
+
+	repositoryKeys := lo.Map(members, func(m types.String, _ int) string { return m.ValueString() })
+	lookup := newMemberRepositoryLookup(r.ProviderData.Client)
+
+	result, err := validateMembers(lookup, key.ValueString(), r.PackageType, repositoryKeys, defaultDeploymentRepo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to validate repositories",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, missing := range result.MissingRepositories {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("repositories"),
+			"Repository not found",
+			fmt.Sprintf("Repository %s does not exist. Ignore this warning if it is created in the same apply.", missing),
+		)
+	}
+
+	for _, e := range result.Errors {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("repositories"),
+			"Invalid repositories",
+			e,
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, complete, err := effectiveResolutionOrder(lookup, key.ValueString(), repositoryKeys)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get effective resolution order",
+			err.Error(),
+		)
+		return
+	}
+
+	if !complete {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, effectiveOrderPath, types.ListUnknown(types.StringType))...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, effectiveOrderPath, order)...)
+}
+
+// setEffectiveResolutionOrder computes `effective_resolution_order` from the repository members in state.
+func (r *virtualResource) setEffectiveResolutionOrder(ctx context.Context, state *tfsdk.State) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	var key types.String
+	var repositories []string
+	diags.Append(state.GetAttribute(ctx, path.Root("key"), &key)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("repositories"), &repositories)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	order, _, err := effectiveResolutionOrder(newMemberRepositoryLookup(r.ProviderData.Client), key.ValueString(), repositories)
+	if err != nil {
+		diags.AddError(
+			"Failed to get effective resolution order",
+			err.Error(),
+		)
+		return diags
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root("effective_resolution_order"), order)...)
+
+	return diags
+}
+
+func (r *virtualResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.BaseResource.Create(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plannedOrder types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("effective_resolution_order"), &plannedOrder)...)
+
+	// Only resolve after apply if the order could not be determined during plan,
+	// otherwise the planned value must be kept.
+	if plannedOrder.IsUnknown() {
+		resp.Diagnostics.Append(r.setEffectiveResolutionOrder(ctx, &resp.State)...)
+	}
+}
+
+func (r *virtualResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.BaseResource.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() || resp.State.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setEffectiveResolutionOrder(ctx, &resp.State)...)
+}
+
+func (r *virtualResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseResource.Update(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plannedOrder types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("effective_resolution_order"), &plannedOrder)...)
+
+	if plannedOrder.IsUnknown() {
+		resp.Diagnostics.Append(r.setEffectiveResolutionOrder(ctx, &resp.State)...)
+	}
+}
+
+// UpgradeState migrates the state of the resources implemented with SDKv2 before, which share the schema version 1.
+func (r *virtualResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// the schema hasn't changed, except the removal of default value from `project_key` attribute
+		0: repository.UpgradeStateSDKv2(repository.ResourceUpgradeProjectKey, repository.ResourceUpgradeAllowRename),
+	}
+}
+
 type VirtualResourceModel struct {
 	repository.BaseResourceModel
 	Repositories                                  types.List   `tfsdk:"repositories"`
 	ArtifactoryRequestsCanRetrieveRemoteArtifacts types.Bool   `tfsdk:"artifactory_requests_can_retrieve_remote_artifacts"`
 	DefaultDeploymentRepo                         types.String `tfsdk:"default_deployment_repo"`
 	RepoLayoutRef                                 types.String `tfsdk:"repo_layout_ref"`
+	EffectiveResolutionOrder                      types.List   `tfsdk:"effective_resolution_order"`
 }
 
 func (r *VirtualResourceModel) GetCreateResourcePlanData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -182,34 +353,99 @@ var VirtualAttributes = lo.Assign(
 			Optional:            true,
 			Computed:            true,
 			Default:             stringdefault.StaticString(""),
-			MarkdownDescription: "Default repository to deploy artifacts.",
+			MarkdownDescription: "Default repository to deploy artifacts. Must be a local or federated repository included in `repositories`.",
+		},
+		"effective_resolution_order": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			MarkdownDescription: effectiveResolutionOrderDescription,
 		},
 	},
 )
 
-type RepositoryBaseParams struct {
-	Key                                           string   `hcl:"key" json:"key,omitempty"`
-	ProjectKey                                    string   `json:"projectKey"`
-	ProjectEnvironments                           []string `json:"environments"`
-	Rclass                                        string   `json:"rclass"`
-	PackageType                                   string   `hcl:"package_type" json:"packageType,omitempty"`
-	Description                                   string   `json:"description"`
-	Notes                                         string   `json:"notes"`
-	IncludesPattern                               string   `json:"includesPattern"`
-	ExcludesPattern                               string   `json:"excludesPattern"`
-	RepoLayoutRef                                 string   `hcl:"repo_layout_ref" json:"repoLayoutRef,omitempty"`
-	Repositories                                  []string `hcl:"repositories" json:"repositories,omitempty"`
-	ArtifactoryRequestsCanRetrieveRemoteArtifacts bool     `hcl:"artifactory_requests_can_retrieve_remote_artifacts" json:"artifactoryRequestsCanRetrieveRemoteArtifacts"`
-	DefaultDeploymentRepo                         string   `hcl:"default_deployment_repo" json:"defaultDeploymentRepo,omitempty"`
+var RetrievalCachePeriodSecondsAttribute = map[string]schema.Attribute{
+	"retrieval_cache_period_seconds": schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		Default:  int64default.StaticInt64(7200),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+		MarkdownDescription: "This value refers to the number of seconds to cache metadata files before checking for newer " +
+			"versions on aggregated repositories. A value of 0 indicates no caching.",
+	},
 }
 
-type RepositoryBaseParamsWithRetrievalCachePeriodSecs struct {
-	RepositoryBaseParams
-	VirtualRetrievalCachePeriodSecs int `hcl:"retrieval_cache_period_seconds" json:"virtualRetrievalCachePeriodSecs"`
+type ExternalDependenciesResourceModel struct {
+	ExternalDependenciesEnabled    types.Bool   `tfsdk:"external_dependencies_enabled"`
+	ExternalDependenciesRemoteRepo types.String `tfsdk:"external_dependencies_remote_repo"`
+	ExternalDependenciesPatterns   types.List   `tfsdk:"external_dependencies_patterns"`
 }
 
-func (bp RepositoryBaseParams) Id() string {
-	return bp.Key
+func (r ExternalDependenciesResourceModel) ToAPIModel(ctx context.Context) (ExternalDependenciesAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	apiModel := ExternalDependenciesAPIModel{
+		ExternalDependenciesEnabled:    r.ExternalDependenciesEnabled.ValueBool(),
+		ExternalDependenciesRemoteRepo: r.ExternalDependenciesRemoteRepo.ValueString(),
+	}
+
+	if r.ExternalDependenciesEnabled.ValueBool() {
+		diags.Append(r.ExternalDependenciesPatterns.ElementsAs(ctx, &apiModel.ExternalDependenciesPatterns, false)...)
+	}
+
+	return apiModel, diags
+}
+
+func (r *ExternalDependenciesResourceModel) FromAPIModel(ctx context.Context, apiModel ExternalDependenciesAPIModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	r.ExternalDependenciesEnabled = types.BoolValue(apiModel.ExternalDependenciesEnabled)
+	r.ExternalDependenciesRemoteRepo = types.StringValue(apiModel.ExternalDependenciesRemoteRepo)
+
+	// the patterns are only used, and kept by Artifactory, when external dependencies are enabled
+	r.ExternalDependenciesPatterns = types.ListNull(types.StringType)
+	if apiModel.ExternalDependenciesEnabled {
+		patterns, d := types.ListValueFrom(ctx, types.StringType, apiModel.ExternalDependenciesPatterns)
+		diags.Append(d...)
+		r.ExternalDependenciesPatterns = patterns
+	}
+
+	return diags
+}
+
+type ExternalDependenciesAPIModel struct {
+	ExternalDependenciesEnabled    bool     `json:"externalDependenciesEnabled"`
+	ExternalDependenciesRemoteRepo string   `json:"externalDependenciesRemoteRepo"`
+	ExternalDependenciesPatterns   []string `json:"externalDependenciesPatterns,omitempty"`
+}
+
+var externalDependenciesAttributes = map[string]schema.Attribute{
+	"external_dependencies_enabled": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "When set, external dependencies are rewritten. Default value is false.",
+	},
+	"external_dependencies_remote_repo": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(""),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.AlsoRequires(path.MatchRoot("external_dependencies_enabled")),
+		},
+		MarkdownDescription: "The remote repository aggregated by this virtual repository in which the external dependency will be cached.",
+	},
+	"external_dependencies_patterns": schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.AlsoRequires(path.MatchRoot("external_dependencies_enabled")),
+		},
+		MarkdownDescription: "An Allow List of Ant-style path expressions that specify where external dependencies may be downloaded from. " +
+			"By default, this is set to ** which means that dependencies may be downloaded from any external source.",
+	},
 }
 
 var PackageTypesLikeGeneric = []string{
@@ -233,6 +469,32 @@ var PackageTypesLikeGenericWithRetrievalCachePeriodSecs = []string{
 	repository.CranPackageType,
 }
 
+// SDKv2
+type RepositoryBaseParams struct {
+	Key                                           string   `hcl:"key" json:"key,omitempty"`
+	ProjectKey                                    string   `json:"projectKey"`
+	ProjectEnvironments                           []string `json:"environments"`
+	Rclass                                        string   `json:"rclass"`
+	PackageType                                   string   `hcl:"package_type" json:"packageType,omitempty"`
+	Description                                   string   `json:"description"`
+	Notes                                         string   `json:"notes"`
+	IncludesPattern                               string   `json:"includesPattern"`
+	ExcludesPattern                               string   `json:"excludesPattern"`
+	RepoLayoutRef                                 string   `hcl:"repo_layout_ref" json:"repoLayoutRef,omitempty"`
+	Repositories                                  []string `hcl:"repositories" json:"repositories,omitempty"`
+	ArtifactoryRequestsCanRetrieveRemoteArtifacts bool     `hcl:"artifactory_requests_can_retrieve_remote_artifacts" json:"artifactoryRequestsCanRetrieveRemoteArtifacts"`
+	DefaultDeploymentRepo                         string   `hcl:"default_deployment_repo" json:"defaultDeploymentRepo,omitempty"`
+}
+
+type RepositoryBaseParamsWithRetrievalCachePeriodSecs struct {
+	RepositoryBaseParams
+	VirtualRetrievalCachePeriodSecs int `hcl:"retrieval_cache_period_seconds" json:"virtualRetrievalCachePeriodSecs"`
+}
+
+func (bp RepositoryBaseParams) Id() string {
+	return bp.Key
+}
+
 var baseSchema = map[string]*sdkv2_schema.Schema{
 	"repositories": {
 		Type:        sdkv2_schema.TypeList,
@@ -271,35 +533,6 @@ var GetSchemas = func(s map[string]*sdkv2_schema.Schema) map[int16]map[string]*s
 	}
 }
 
-func UnpackBaseVirtRepo(s *sdkv2_schema.ResourceData, packageType string) RepositoryBaseParams {
-	d := &utilsdk.ResourceData{ResourceData: s}
-
-	return RepositoryBaseParams{
-		Key:                 d.GetString("key", false),
-		Rclass:              Rclass,
-		ProjectKey:          d.GetString("project_key", false),
-		ProjectEnvironments: d.GetSet("project_environments"),
-		PackageType:         packageType, // must be set independently
-		IncludesPattern:     d.GetString("includes_pattern", false),
-		ExcludesPattern:     d.GetString("excludes_pattern", false),
-		RepoLayoutRef:       d.GetString("repo_layout_ref", false),
-		ArtifactoryRequestsCanRetrieveRemoteArtifacts: d.GetBool("artifactory_requests_can_retrieve_remote_artifacts", false),
-		Repositories:          d.GetList("repositories"),
-		Description:           d.GetString("description", false),
-		Notes:                 d.GetString("notes", false),
-		DefaultDeploymentRepo: repository.HandleResetWithNonExistentValue(d, "default_deployment_repo"),
-	}
-}
-
-func UnpackBaseVirtRepoWithRetrievalCachePeriodSecs(s *sdkv2_schema.ResourceData, packageType string) RepositoryBaseParamsWithRetrievalCachePeriodSecs {
-	d := &utilsdk.ResourceData{ResourceData: s}
-
-	return RepositoryBaseParamsWithRetrievalCachePeriodSecs{
-		RepositoryBaseParams:            UnpackBaseVirtRepo(s, packageType),
-		VirtualRetrievalCachePeriodSecs: d.GetInt("retrieval_cache_period_seconds", false),
-	}
-}
-
 var externalDependenciesSchema = map[string]*sdkv2_schema.Schema{
 	"external_dependencies_enabled": {
 		Type:        sdkv2_schema.TypeBool,
@@ -326,24 +559,6 @@ var externalDependenciesSchema = map[string]*sdkv2_schema.Schema{
 	},
 }
 
-type ExternalDependenciesVirtualRepositoryParams struct {
-	RepositoryBaseParams
-	ExternalDependenciesEnabled    bool     `json:"externalDependenciesEnabled"`
-	ExternalDependenciesRemoteRepo string   `json:"externalDependenciesRemoteRepo"`
-	ExternalDependenciesPatterns   []string `json:"externalDependenciesPatterns"`
-}
-
-var unpackExternalDependenciesVirtualRepository = func(s *sdkv2_schema.ResourceData, packageType string) ExternalDependenciesVirtualRepositoryParams {
-	d := &utilsdk.ResourceData{ResourceData: s}
-
-	return ExternalDependenciesVirtualRepositoryParams{
-		RepositoryBaseParams:           UnpackBaseVirtRepo(s, packageType),
-		ExternalDependenciesEnabled:    d.GetBool("external_dependencies_enabled", false),
-		ExternalDependenciesRemoteRepo: d.GetString("external_dependencies_remote_repo", false),
-		ExternalDependenciesPatterns:   d.GetList("external_dependencies_patterns"),
-	}
-}
-
 var RetrievalCachePeriodSecondsSchema = map[string]*sdkv2_schema.Schema{
 	"retrieval_cache_period_seconds": {
 		Type:     sdkv2_schema.TypeInt,
@@ -23,6 +23,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	"golang.org/x/net/context"
@@ -76,7 +79,7 @@ func (r *ArtifactoryAnonymousUserResource) Configure(ctx context.Context, req re
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), r.TypeName)
 }
 
 func (r *ArtifactoryAnonymousUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -90,7 +93,7 @@ func (r *ArtifactoryAnonymousUserResource) readUser(req *resty.Request, artifact
 	endpoint := GetUserEndpointPath(artifactoryVersion)
 
 	// 7.84.3 or later, use Access API
-	if ok, err := util.CheckVersion(artifactoryVersion, AccessAPIArtifactoryVersion); err == nil && ok {
+	if capability.AccessUsersAPI.SupportedBy(artifactoryVersion) {
 		return req.
 			SetPathParam("name", name).
 			SetResult(&result).
@@ -114,7 +117,7 @@ func (r *ArtifactoryAnonymousUserResource) readUser(req *resty.Request, artifact
 }
 
 func (r *ArtifactoryAnonymousUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var data *ArtifactoryAnonymousUserResourceModel
 
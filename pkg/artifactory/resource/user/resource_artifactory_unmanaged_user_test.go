@@ -25,7 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/acctest"
-	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/resource/user"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-shared/validator"
@@ -618,7 +618,7 @@ func testAccCheckUserDestroy(id string) func(*terraform.State) error {
 		var resp *resty.Response
 		var err error
 		// 7.84.3 or later, use Access API
-		if ok, e := util.CheckVersion(acctest.Provider.Meta().(util.ProviderMetadata).ArtifactoryVersion, user.AccessAPIArtifactoryVersion); e == nil && ok {
+		if capability.AccessUsersAPI.SupportedBy(acctest.Provider.Meta().(util.ProviderMetadata).ArtifactoryVersion) {
 			r, er := client.R().Get("access/api/v2/users/" + rs.Primary.ID)
 			resp = r
 			err = er
@@ -54,6 +54,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/capability"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/httplog"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	"github.com/samber/lo"
@@ -61,8 +64,7 @@ import (
 )
 
 const (
-	AccessAPIArtifactoryVersion = "7.84.3"
-	UserGroupEndpointPath       = "access/api/v2/users/{name}/groups"
+	UserGroupEndpointPath = "access/api/v2/users/{name}/groups"
 )
 
 type ArtifactoryBaseUserResource struct {
@@ -297,7 +299,7 @@ func (r *ArtifactoryBaseUserResource) Configure(ctx context.Context, req resourc
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = httplog.ForResource(req.ProviderData.(util.ProviderMetadata), r.TypeName)
 }
 
 func (r ArtifactoryBaseUserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -358,7 +360,7 @@ func (r *ArtifactoryBaseUserResource) syncReadersGroup(_ context.Context, client
 }
 
 func GetUsersEndpointPath(artifactoryVersion string) string {
-	if ok, err := util.CheckVersion(artifactoryVersion, AccessAPIArtifactoryVersion); err == nil && ok {
+	if capability.AccessUsersAPI.SupportedBy(artifactoryVersion) {
 		return "access/api/v2/users"
 	}
 
@@ -366,7 +368,7 @@ func GetUsersEndpointPath(artifactoryVersion string) string {
 }
 
 func GetUserEndpointPath(artifactoryVersion string) string {
-	if ok, err := util.CheckVersion(artifactoryVersion, AccessAPIArtifactoryVersion); err == nil && ok {
+	if capability.AccessUsersAPI.SupportedBy(artifactoryVersion) {
 		return "access/api/v2/users/{name}"
 	}
 
@@ -387,7 +389,7 @@ type ArtifactoryUserAPIModel struct {
 
 func (r *ArtifactoryBaseUserResource) createUser(_ context.Context, req *resty.Request, artifactoryVersion string, user ArtifactoryUserResourceAPIModel, result *ArtifactoryUserResourceAPIModel, artifactoryError *artifactory.ArtifactoryErrorsResponse) (*resty.Response, error) {
 	// 7.84.3 or later, use Access API
-	if ok, err := util.CheckVersion(artifactoryVersion, AccessAPIArtifactoryVersion); err == nil && ok {
+	if capability.AccessUsersAPI.SupportedBy(artifactoryVersion) {
 		return req.
 			SetBody(user).
 			SetResult(result).
@@ -435,7 +437,7 @@ func (r *ArtifactoryBaseUserResource) readUser(req *resty.Request, artifactoryVe
 	endpoint := GetUserEndpointPath(artifactoryVersion)
 
 	// 7.84.3 or later, use Access API
-	if ok, err := util.CheckVersion(artifactoryVersion, AccessAPIArtifactoryVersion); err == nil && ok {
+	if capability.AccessUsersAPI.SupportedBy(artifactoryVersion) {
 		return req.
 			SetPathParam("name", name).
 			SetResult(&result).
@@ -468,7 +470,7 @@ func (r *ArtifactoryBaseUserResource) updateUser(req *resty.Request, artifactory
 	endpoint := GetUserEndpointPath(artifactoryVersion)
 
 	// 7.84.3 or later, use Access API
-	if ok, err := util.CheckVersion(artifactoryVersion, AccessAPIArtifactoryVersion); err == nil && ok {
+	if capability.AccessUsersAPI.SupportedBy(artifactoryVersion) {
 		return req.
 			SetPathParam("name", user.Name).
 			SetBody(user).
@@ -625,7 +627,7 @@ func (r *ArtifactoryBaseUserResource) validatePasswordByPolicy(plan ArtifactoryU
 }
 
 func (r *ArtifactoryBaseUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ArtifactoryUserResourceModel
 	// Read Terraform plan data into the model
@@ -716,7 +718,7 @@ func (r *ArtifactoryBaseUserResource) Create(ctx context.Context, req resource.C
 }
 
 func (r *ArtifactoryBaseUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ArtifactoryUserResourceModel
 
@@ -765,7 +767,7 @@ func (r *ArtifactoryBaseUserResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *ArtifactoryBaseUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ArtifactoryUserResourceModel
 
@@ -851,7 +853,7 @@ func (r *ArtifactoryBaseUserResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *ArtifactoryBaseUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ArtifactoryUserResourceModel
 
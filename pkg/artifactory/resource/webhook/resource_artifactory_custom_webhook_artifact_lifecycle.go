@@ -21,7 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 )
 
 var _ resource.Resource = &ArtifactLifecycleCustomWebhookResource{}
@@ -59,7 +59,7 @@ func (r *ArtifactLifecycleCustomWebhookResource) Configure(ctx context.Context,
 }
 
 func (r *ArtifactLifecycleCustomWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ArtifactLifecycleCustomWebhookResourceModel
 
@@ -85,7 +85,7 @@ func (r *ArtifactLifecycleCustomWebhookResource) Create(ctx context.Context, req
 }
 
 func (r *ArtifactLifecycleCustomWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ArtifactLifecycleCustomWebhookResourceModel
 
@@ -115,7 +115,7 @@ func (r *ArtifactLifecycleCustomWebhookResource) Read(ctx context.Context, req r
 }
 
 func (r *ArtifactLifecycleCustomWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ArtifactLifecycleCustomWebhookResourceModel
 
@@ -141,7 +141,7 @@ func (r *ArtifactLifecycleCustomWebhookResource) Update(ctx context.Context, req
 }
 
 func (r *ArtifactLifecycleCustomWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ArtifactLifecycleCustomWebhookResourceModel
 
@@ -22,7 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 )
 
 var _ resource.Resource = &BuildCustomWebhookResource{}
@@ -71,7 +71,7 @@ func (r BuildCustomWebhookResource) ValidateConfig(ctx context.Context, req reso
 }
 
 func (r *BuildCustomWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan BuildCustomWebhookResourceModel
 
@@ -97,7 +97,7 @@ func (r *BuildCustomWebhookResource) Create(ctx context.Context, req resource.Cr
 }
 
 func (r *BuildCustomWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state BuildCustomWebhookResourceModel
 
@@ -127,7 +127,7 @@ func (r *BuildCustomWebhookResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *BuildCustomWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan BuildCustomWebhookResourceModel
 
@@ -153,7 +153,7 @@ func (r *BuildCustomWebhookResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *BuildCustomWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state BuildWebhookResourceModel
 
@@ -22,7 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 )
 
 var _ resource.Resource = &ReleaseBundleCustomWebhookResource{}
@@ -111,7 +111,7 @@ func (r ReleaseBundleCustomWebhookResource) ValidateConfig(ctx context.Context,
 }
 
 func (r *ReleaseBundleCustomWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ReleaseBundleCustomWebhookResourceModel
 
@@ -137,7 +137,7 @@ func (r *ReleaseBundleCustomWebhookResource) Create(ctx context.Context, req res
 }
 
 func (r *ReleaseBundleCustomWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ReleaseBundleCustomWebhookResourceModel
 
@@ -167,7 +167,7 @@ func (r *ReleaseBundleCustomWebhookResource) Read(ctx context.Context, req resou
 }
 
 func (r *ReleaseBundleCustomWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ReleaseBundleCustomWebhookResourceModel
 
@@ -193,7 +193,7 @@ func (r *ReleaseBundleCustomWebhookResource) Update(ctx context.Context, req res
 }
 
 func (r *ReleaseBundleCustomWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ReleaseBundleCustomWebhookResourceModel
 
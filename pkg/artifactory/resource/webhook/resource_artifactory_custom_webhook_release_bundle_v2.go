@@ -22,7 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 )
 
 var _ resource.Resource = &ReleaseBundleV2CustomWebhookResource{}
@@ -71,7 +71,7 @@ func (r ReleaseBundleV2CustomWebhookResource) ValidateConfig(ctx context.Context
 }
 
 func (r *ReleaseBundleV2CustomWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ReleaseBundleV2CustomWebhookResourceModel
 
@@ -97,7 +97,7 @@ func (r *ReleaseBundleV2CustomWebhookResource) Create(ctx context.Context, req r
 }
 
 func (r *ReleaseBundleV2CustomWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ReleaseBundleV2CustomWebhookResourceModel
 
@@ -127,7 +127,7 @@ func (r *ReleaseBundleV2CustomWebhookResource) Read(ctx context.Context, req res
 }
 
 func (r *ReleaseBundleV2CustomWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ReleaseBundleV2CustomWebhookResourceModel
 
@@ -153,7 +153,7 @@ func (r *ReleaseBundleV2CustomWebhookResource) Update(ctx context.Context, req r
 }
 
 func (r *ReleaseBundleV2CustomWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ReleaseBundleV2CustomWebhookResourceModel
 
@@ -22,7 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 )
 
 var _ resource.Resource = &ReleaseBundleV2PromotionCustomWebhookResource{}
@@ -60,7 +60,7 @@ func (r *ReleaseBundleV2PromotionCustomWebhookResource) Configure(ctx context.Co
 }
 
 func (r *ReleaseBundleV2PromotionCustomWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ReleaseBundleV2PromotionCustomWebhookResourceModel
 
@@ -86,7 +86,7 @@ func (r *ReleaseBundleV2PromotionCustomWebhookResource) Create(ctx context.Conte
 }
 
 func (r *ReleaseBundleV2PromotionCustomWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ReleaseBundleV2PromotionCustomWebhookResourceModel
 
@@ -116,7 +116,7 @@ func (r *ReleaseBundleV2PromotionCustomWebhookResource) Read(ctx context.Context
 }
 
 func (r *ReleaseBundleV2PromotionCustomWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ReleaseBundleV2PromotionCustomWebhookResourceModel
 
@@ -142,7 +142,7 @@ func (r *ReleaseBundleV2PromotionCustomWebhookResource) Update(ctx context.Conte
 }
 
 func (r *ReleaseBundleV2PromotionCustomWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ReleaseBundleV2PromotionCustomWebhookResourceModel
 
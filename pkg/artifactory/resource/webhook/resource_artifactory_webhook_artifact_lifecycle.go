@@ -21,7 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 )
 
 var _ resource.Resource = &ArtifactLifecycleWebhookResource{}
@@ -57,7 +57,7 @@ func (r *ArtifactLifecycleWebhookResource) Configure(ctx context.Context, req re
 }
 
 func (r *ArtifactLifecycleWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ArtifactLifecycleWebhookResourceModel
 
@@ -83,7 +83,7 @@ func (r *ArtifactLifecycleWebhookResource) Create(ctx context.Context, req resou
 }
 
 func (r *ArtifactLifecycleWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ArtifactLifecycleWebhookResourceModel
 
@@ -113,7 +113,7 @@ func (r *ArtifactLifecycleWebhookResource) Read(ctx context.Context, req resourc
 }
 
 func (r *ArtifactLifecycleWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ArtifactLifecycleWebhookResourceModel
 
@@ -139,7 +139,7 @@ func (r *ArtifactLifecycleWebhookResource) Update(ctx context.Context, req resou
 }
 
 func (r *ArtifactLifecycleWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ArtifactLifecycleWebhookResourceModel
 
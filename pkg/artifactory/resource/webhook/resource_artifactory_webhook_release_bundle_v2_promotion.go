@@ -26,7 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-artifactory/v12/pkg/artifactory/usage"
 	"github.com/samber/lo"
 )
 
@@ -84,7 +84,7 @@ func (r *ReleaseBundleV2PromotionWebhookResource) Configure(ctx context.Context,
 }
 
 func (r *ReleaseBundleV2PromotionWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceCreate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ReleaseBundleV2PromotionWebhookResourceModel
 
@@ -110,7 +110,7 @@ func (r *ReleaseBundleV2PromotionWebhookResource) Create(ctx context.Context, re
 }
 
 func (r *ReleaseBundleV2PromotionWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceRead(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ReleaseBundleV2PromotionWebhookResourceModel
 
@@ -140,7 +140,7 @@ func (r *ReleaseBundleV2PromotionWebhookResource) Read(ctx context.Context, req
 }
 
 func (r *ReleaseBundleV2PromotionWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceUpdate(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var plan ReleaseBundleV2PromotionWebhookResourceModel
 
@@ -166,7 +166,7 @@ func (r *ReleaseBundleV2PromotionWebhookResource) Update(ctx context.Context, re
 }
 
 func (r *ReleaseBundleV2PromotionWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	usage.RecordResourceDelete(r.ProviderData.Client, r.ProviderData.ProductId, r.TypeName)
 
 	var state ReleaseBundleV2PromotionWebhookResourceModel
 
@@ -0,0 +1,168 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package usage collects the features used by the provider and reports them to Artifactory in a
+// single request when the provider shuts down, instead of one request per resource operation.
+package usage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const (
+	// partnerFeatureId is sent with every report, as done by util.SendUsage.
+	partnerFeatureId = "Partner/ACC-007450"
+
+	// FlushTimeout bounds the time spent reporting usage on shutdown. Terraform kills a provider that
+	// doesn't exit within a few seconds of being asked to stop.
+	FlushTimeout = time.Second
+)
+
+type batch struct {
+	client    *resty.Client
+	productId string
+	features  []string
+	seen      map[string]bool
+}
+
+var (
+	mu sync.Mutex
+	// batches are keyed by Artifactory URL and product ID so that the SDKv2 and framework providers,
+	// and the per resource type clients created for the HTTP log, share a single report.
+	batches = map[string]*batch{}
+)
+
+func batchKey(client *resty.Client, productId string) string {
+	return client.BaseURL + "|" + productId
+}
+
+// Enable starts collecting the usage of the provider configured with client. Usage recorded for a
+// client that was not enabled, i.e. with usage reporting disabled, is dropped.
+func Enable(client *resty.Client, productId string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	key := batchKey(client, productId)
+	if _, ok := batches[key]; ok {
+		return
+	}
+
+	batches[key] = &batch{
+		client:    client,
+		productId: productId,
+		seen:      map[string]bool{},
+	}
+}
+
+// Record adds features to the next usage report.
+func Record(client *resty.Client, productId string, features ...string) {
+	if client == nil {
+		return
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	b, ok := batches[batchKey(client, productId)]
+	if !ok {
+		return
+	}
+
+	for _, feature := range features {
+		if !b.seen[feature] {
+			b.seen[feature] = true
+			b.features = append(b.features, feature)
+		}
+	}
+}
+
+// RecordResource records an operation of a resource or data source, e.g. `CREATE`.
+func RecordResource(client *resty.Client, productId, resourceName, method string) {
+	Record(client, productId, fmt.Sprintf("Resource/%s/%s", resourceName, method))
+}
+
+func RecordResourceCreate(client *resty.Client, productId, resourceName string) {
+	RecordResource(client, productId, resourceName, "CREATE")
+}
+
+func RecordResourceRead(client *resty.Client, productId, resourceName string) {
+	RecordResource(client, productId, resourceName, "READ")
+}
+
+func RecordResourceUpdate(client *resty.Client, productId, resourceName string) {
+	RecordResource(client, productId, resourceName, "UPDATE")
+}
+
+func RecordResourceDelete(client *resty.Client, productId, resourceName string) {
+	RecordResource(client, productId, resourceName, "DELETE")
+}
+
+// Flush sends the recorded usage, one request per Artifactory instance, and gives up when ctx is
+// done. Failures are only logged, reporting usage is best effort.
+func Flush(ctx context.Context) {
+	mu.Lock()
+	pending := make([]*batch, 0, len(batches))
+	for key, b := range batches {
+		if len(b.features) > 0 {
+			pending = append(pending, b)
+		}
+		delete(batches, key)
+	}
+	mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, b := range pending {
+		wg.Add(1)
+		go func(b *batch) {
+			defer wg.Done()
+			if err := b.send(ctx); err != nil {
+				log.Printf("[DEBUG] failed to send usage: %v", err)
+			}
+		}(b)
+	}
+	wg.Wait()
+}
+
+func (b *batch) send(ctx context.Context) error {
+	features := []util.Feature{{FeatureId: partnerFeatureId}}
+	for _, feature := range b.features {
+		features = append(features, util.Feature{FeatureId: feature})
+	}
+
+	// Retrying would only delay the provider shutdown.
+	resp, err := b.client.Clone().
+		SetRetryCount(0).
+		R().
+		SetContext(ctx).
+		SetBody(util.UsageStruct{
+			ProductId: b.productId,
+			Features:  features,
+		}).
+		Post("artifactory/api/system/usage")
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("%s", resp.String())
+	}
+
+	return nil
+}
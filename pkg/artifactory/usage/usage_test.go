@@ -0,0 +1,132 @@
+package usage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+type usageServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	reports []util.UsageStruct
+}
+
+func newUsageServer(t *testing.T, delay time.Duration) *usageServer {
+	s := &usageServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/artifactory/api/system/usage" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		var report util.UsageStruct
+		if err := json.NewDecoder(r.Body).Decode(&report); err != nil {
+			t.Errorf("failed to decode usage: %v", err)
+		}
+
+		s.mu.Lock()
+		s.reports = append(s.reports, report)
+		s.mu.Unlock()
+
+		time.Sleep(delay)
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *usageServer) received() []util.UsageStruct {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]util.UsageStruct{}, s.reports...)
+}
+
+func TestFlush(t *testing.T) {
+	server := newUsageServer(t, 0)
+	client := resty.New().SetBaseURL(server.URL)
+
+	Enable(client, "test-product")
+	Record(client, "test-product", "Terraform/1.9.0")
+	RecordResourceCreate(client, "test-product", "artifactory_local_generic_repository")
+	RecordResourceRead(client, "test-product", "artifactory_local_generic_repository")
+	RecordResourceRead(client, "test-product", "artifactory_local_generic_repository")
+
+	// Clones, e.g. the per resource type clients of the HTTP log, are part of the same report.
+	RecordResourceDelete(client.Clone(), "test-product", "artifactory_user")
+
+	Flush(context.Background())
+
+	reports := server.received()
+	if len(reports) != 1 {
+		t.Fatalf("expected a single usage report, got %d", len(reports))
+	}
+
+	report := reports[0]
+	if report.ProductId != "test-product" {
+		t.Fatalf("unexpected product ID: %s", report.ProductId)
+	}
+
+	var features []string
+	for _, feature := range report.Features {
+		features = append(features, feature.FeatureId)
+	}
+	expected := []string{
+		partnerFeatureId,
+		"Terraform/1.9.0",
+		"Resource/artifactory_local_generic_repository/CREATE",
+		"Resource/artifactory_local_generic_repository/READ",
+		"Resource/artifactory_user/DELETE",
+	}
+	if len(features) != len(expected) {
+		t.Fatalf("expected features %v, got %v", expected, features)
+	}
+	for i := range expected {
+		if features[i] != expected[i] {
+			t.Fatalf("expected features %v, got %v", expected, features)
+		}
+	}
+
+	// The recorded usage is only sent once.
+	Flush(context.Background())
+	if reports := server.received(); len(reports) != 1 {
+		t.Fatalf("expected no new usage report, got %d", len(reports)-1)
+	}
+}
+
+func TestRecordDisabled(t *testing.T) {
+	server := newUsageServer(t, 0)
+	client := resty.New().SetBaseURL(server.URL)
+
+	RecordResourceCreate(client, "test-product", "artifactory_user")
+	Flush(context.Background())
+
+	if reports := server.received(); len(reports) != 0 {
+		t.Fatalf("expected no usage report when usage reporting is not enabled, got %d", len(reports))
+	}
+}
+
+func TestFlushTimeout(t *testing.T) {
+	server := newUsageServer(t, time.Second)
+	client := resty.New().SetBaseURL(server.URL).SetRetryCount(3)
+
+	Enable(client, "test-product")
+	RecordResourceCreate(client, "test-product", "artifactory_user")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	Flush(ctx)
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("expected Flush to give up after the timeout, took %s", elapsed)
+	}
+}
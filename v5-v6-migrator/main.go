@@ -18,17 +18,20 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"regexp"
-	"strings"
+	"path/filepath"
 
 	"github.com/hashicorp/hcl/v2"
-	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/urfave/cli/v2"
 )
 
 var isDebug bool
-var repositoryVariableLookup = map[string]string{}
+
+func debugf(format string, args ...interface{}) {
+	if isDebug {
+		fmt.Printf(format, args...)
+	}
+}
 
 func readInputFile(inputFileName string) (*hclwrite.File, error) {
 	fmt.Printf("Reading configuration from file\n")
@@ -44,114 +47,103 @@ func readInputFile(inputFileName string) (*hclwrite.File, error) {
 		return nil, fmt.Errorf("failed to parse HCL: %s", diags)
 	}
 
-	if isDebug {
-		fmt.Printf("Input configuration: %v\n", string(tfFile.Bytes()))
-	}
+	debugf("Input configuration: %v\n", string(tfFile.Bytes()))
 
 	return tfFile, nil
 }
 
-func getAttributeValueAsString(attr *hclwrite.Attribute) (string, error) {
-	tokens := attr.Expr().BuildTokens(nil)
+func writeOutputFile(outputFileName string, tfFile *hclwrite.File) error {
+	fmt.Printf("Writing configuration to file\n")
 
-	for _, token := range tokens {
-		if isDebug {
-			fmt.Printf("token: %v\n", token)
-		}
+	output := hclwrite.Format(tfFile.Bytes())
+	debugf("Output configuration: %v\n", string(output))
 
-		if token.Type == hclsyntax.TokenQuotedLit {
-			return string(token.Bytes), nil
-		}
+	if err := os.WriteFile(outputFileName, output, 0644); err != nil {
+		return err
 	}
 
-	return "", fmt.Errorf("No string token found")
+	fmt.Printf("Configuration saved to %s\n", outputFileName)
+	return nil
 }
 
-func migrateConfiguration(file *hclwrite.File) error {
-	fmt.Printf("Renaming resource type\n")
-
-	// loop through every block to replace generic resource type with package specific resource type
-	for _, block := range file.Body().Blocks() {
-		labels := block.Labels()
-		if block.Type() == "resource" {
-			fmt.Printf("resource type: %s\n", strings.Join(labels, "."))
-			resourceType := labels[0]
-
-			re := regexp.MustCompile(`artifactory_(local|remote|virtual)_repository`)
-			matches := re.FindStringSubmatch(resourceType)
-			if len(matches) > 1 {
-				repoType := matches[1]
-
-				packageType, err := getAttributeValueAsString(block.Body().GetAttribute("package_type"))
-				if err != nil {
-					fmt.Printf("Unable to find attribute 'package_type'. Skipping...\n")
-					continue
-				}
-				newResourceType := fmt.Sprintf("artifactory_%s_%s_repository", repoType, packageType)
-				block.SetLabels([]string{newResourceType, labels[1]})
-				block.Body().RemoveAttribute("package_type")
+// updateReferences rewrites the references to the renamed resources in the .tf files of dir, other than the
+// input and output files.
+func updateReferences(dir string, u *upgrade, skipFileNames ...string) error {
+	fileNames, err := filepath.Glob(filepath.Join(dir, "*.tf"))
+	if err != nil {
+		return err
+	}
 
-				// Insert old and new resource type into lookup map
-				key, err := getAttributeValueAsString(block.Body().GetAttribute("key"))
-				repositoryVariableLookup[strings.Join(labels, ".")] = strings.Join([]string{newResourceType, labels[1], key}, ".")
-			}
+	skip := map[string]bool{}
+	for _, fileName := range skipFileNames {
+		absFileName, err := filepath.Abs(fileName)
+		if err != nil {
+			return err
 		}
+		skip[absFileName] = true
 	}
 
-	if isDebug {
-		fmt.Printf("repositoryVariableLookup: %v\n", repositoryVariableLookup)
-	}
+	for _, fileName := range fileNames {
+		absFileName, err := filepath.Abs(fileName)
+		if err != nil {
+			return err
+		}
+		if skip[absFileName] {
+			continue
+		}
 
-	// loop through everything to rename attribute prefix
-	fmt.Printf("Renaming variable references\n")
-	for _, block := range file.Body().Blocks() {
-		for name, attr := range block.Body().Attributes() {
-			if isDebug {
-				tokens := attr.Expr().BuildTokens(nil)
-				fmt.Printf("Attribute name: %s, value: %s\n", name, string(tokens.Bytes()))
-			}
+		tfFile, err := readInputFile(fileName)
+		if err != nil {
+			return err
+		}
 
-			for old, new := range repositoryVariableLookup {
-				attr.Expr().RenameVariablePrefix(strings.Split(old, "."), strings.Split(new, ".")[:2])
-			}
+		if !u.renameReferencesInFile(tfFile) {
+			continue
+		}
+
+		if err := writeOutputFile(fileName, tfFile); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-func writeOutputFile(outputFileName string, tfFile *hclwrite.File) error {
-	fmt.Printf("Writing configuration to file\n")
-
-	outputFile, err := os.Create(outputFileName)
-	if err != nil {
-		return err
+func printRules() {
+	for _, r := range rules {
+		fmt.Printf("%-28s %s\n", r.name, r.description)
 	}
+}
 
-	if isDebug {
-		fmt.Printf("Output configuration: %v\n", string(tfFile.Bytes()))
+func printReport(findings []finding) {
+	if len(findings) == 0 {
+		fmt.Printf("All matching resources were converted\n")
+		return
 	}
 
-	outputFile.Write(tfFile.Bytes())
-	fmt.Printf("Configuration saved to %s", outputFileName)
-	return nil
+	fmt.Printf("Review the following:\n")
+	for _, f := range findings {
+		fmt.Printf("  %s (%s): %s\n", f.address, f.rule, f.message)
+	}
 }
 
 func main() {
 	var inputFileName string
 	var outputFileName string
 	var outputImport bool
+	var referencesDir string
+	var listRules bool
 
 	app := &cli.App{
 		Name:  "v5-v6-migrator",
-		Usage: "Artifactory Terraform V5-V6 HCL migrator - Migrate generic repository resources to package specific repository resources",
+		Usage: "Artifactory Terraform HCL upgrader - Rewrite removed and deprecated resources to their replacements",
 		Authors: []*cli.Author{
 			&cli.Author{
 				Name:  "Alex Hung",
 				Email: "alexh@jfrog.com",
 			},
 		},
-		Version:              "0.1.0",
+		Version:              "0.2.0",
 		EnableBashCompletion: true,
 		Suggest:              true,
 		Flags: []cli.Flag{
@@ -165,50 +157,74 @@ func main() {
 				Usage:       ".tf `FILE` to migrate",
 				Aliases:     []string{"i"},
 				Destination: &inputFileName,
-				Required:    true,
 			},
 			&cli.StringFlag{
 				Name:        "output",
 				Usage:       "Output .tf `FILE`",
 				Aliases:     []string{"o"},
 				Destination: &outputFileName,
-				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "references-dir",
+				Usage:       "Rewrite the references to the renamed resources in the other .tf files of `DIR`",
+				Destination: &referencesDir,
 			},
 			&cli.BoolFlag{
 				Name:        "import",
-				Usage:       "Output TF import statements",
+				Usage:       "Output TF import statements for the v5 repository resources",
 				Value:       false,
 				Destination: &outputImport,
 			},
+			&cli.BoolFlag{
+				Name:        "list-rules",
+				Usage:       "List the upgrade rules and exit",
+				Value:       false,
+				Destination: &listRules,
+			},
 		},
 		Action: func(ctx *cli.Context) error {
-			if isDebug {
-				fmt.Printf("Input: %s\n", inputFileName)
-				fmt.Printf("Output: %s\n", outputFileName)
+			if listRules {
+				printRules()
+				return nil
 			}
 
+			if inputFileName == "" || outputFileName == "" {
+				return cli.Exit("Both --input and --output are required", 1)
+			}
+
+			debugf("Input: %s\n", inputFileName)
+			debugf("Output: %s\n", outputFileName)
+
 			tfFile, err := readInputFile(inputFileName)
 			if err != nil {
 				return cli.Exit(fmt.Sprintf("Failed to read configuration: %s", err), 1)
 			}
 
-			err = migrateConfiguration(tfFile)
-			if err != nil {
-				return cli.Exit(fmt.Sprintf("Failed to migrate configuration: %s", err), 1)
-			}
+			fmt.Printf("Applying upgrade rules\n")
+			u := newUpgrade(tfFile)
+			u.run(rules)
 
 			err = writeOutputFile(outputFileName, tfFile)
 			if err != nil {
 				return cli.Exit(fmt.Sprintf("Failed to write configuration: %s", err), 2)
 			}
 
+			if referencesDir != "" {
+				if err := updateReferences(referencesDir, u, inputFileName, outputFileName); err != nil {
+					return cli.Exit(fmt.Sprintf("Failed to update references: %s", err), 2)
+				}
+			} else {
+				u.noteExternalReferences()
+			}
+
 			if outputImport {
-				for _, new := range repositoryVariableLookup {
-					components := strings.Split(new, ".")
-					fmt.Printf("terraform import %s.%s %s\n", components[0], components[1], components[2])
+				for _, command := range u.importCommands() {
+					fmt.Println(command)
 				}
 			}
 
+			printReport(u.report())
+
 			return nil
 		},
 	}
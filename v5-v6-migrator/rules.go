@@ -0,0 +1,227 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// rule rewrites the resource blocks of a removed or deprecated resource type.
+type rule struct {
+	name        string
+	description string
+	matches     func(resourceType string) bool
+	apply       func(u *upgrade, name string, block *hclwrite.Block)
+}
+
+func resourceTypes(types ...string) func(string) bool {
+	return func(resourceType string) bool {
+		return slices.Contains(types, resourceType)
+	}
+}
+
+var rules = []rule{
+	{
+		name:        "v5-generic-repository",
+		description: "artifactory_(local|remote|virtual)_repository -> artifactory_<rclass>_<package_type>_repository",
+		matches:     genericRepositoryTypeRegex.MatchString,
+		apply:       upgradeGenericRepository,
+	},
+	{
+		name:        "push-replication",
+		description: "artifactory_push_replication -> artifactory_local_repository_multi_replication",
+		matches:     resourceTypes("artifactory_push_replication"),
+		apply:       upgradePushReplication,
+	},
+	{
+		name:        "pull-replication",
+		description: "artifactory_pull_replication -> artifactory_remote_repository_replication",
+		matches:     resourceTypes("artifactory_pull_replication"),
+		apply:       upgradePullReplication,
+	},
+	{
+		name:        "federated-docker-repository",
+		description: "artifactory_federated_docker_repository -> artifactory_federated_docker_v2_repository",
+		matches:     resourceTypes("artifactory_federated_docker_repository"),
+		apply:       upgradeFederatedDockerRepository,
+	},
+	{
+		name:        "ldap-setting",
+		description: "artifactory_ldap_setting -> artifactory_ldap_setting_v2",
+		matches:     resourceTypes("artifactory_ldap_setting"),
+		apply:       upgradeLdapSetting,
+	},
+	{
+		name:        "policy-conditions-in-days",
+		description: "search_criteria.*_before_in_months -> search_criteria.*_before_in_days",
+		matches:     resourceTypes("artifactory_package_cleanup_policy", "artifactory_archive_policy"),
+		apply:       upgradePolicyConditionsInDays,
+	},
+}
+
+var genericRepositoryTypeRegex = regexp.MustCompile(`^artifactory_(local|remote|virtual)_repository$`)
+
+// upgradeGenericRepository replaces the generic repository resources of v5 with the package specific ones.
+// The v5 resource types no longer exist so their state has to be imported again.
+func upgradeGenericRepository(u *upgrade, name string, block *hclwrite.Block) {
+	rclass := genericRepositoryTypeRegex.FindStringSubmatch(block.Labels()[0])[1]
+
+	attr := block.Body().GetAttribute("package_type")
+	if attr == nil {
+		u.note(block, name, "attribute 'package_type' not found, resource left unchanged")
+		return
+	}
+
+	packageType, err := literalString(attr.Expr().BuildTokens(nil))
+	if err != nil {
+		u.note(block, name, "attribute 'package_type' is not a string literal, resource left unchanged")
+		return
+	}
+
+	block.Body().RemoveAttribute("package_type")
+	u.rename(block, fmt.Sprintf("artifactory_%s_%s_repository", rclass, packageType), migrateManually)
+}
+
+// upgradePushReplication moves to artifactory_local_repository_multi_replication, which renames
+// the `replications` blocks to `replication` and their `path_prefix` to `include_path_prefix_pattern`.
+func upgradePushReplication(u *upgrade, name string, block *hclwrite.Block) {
+	for _, nested := range block.Body().Blocks() {
+		switch {
+		case nested.Type() == "replications":
+			nested.SetType("replication")
+			nested.Body().RenameAttribute("path_prefix", "include_path_prefix_pattern")
+		case nested.Type() == "dynamic" && len(nested.Labels()) == 1 && nested.Labels()[0] == "replications":
+			// keep the iterator name so the references in `content` still resolve
+			if nested.Body().GetAttribute("iterator") == nil {
+				nested.Body().SetAttributeRaw("iterator", hclwrite.TokensForIdentifier("replications"))
+			}
+			nested.SetLabels([]string{"replication"})
+			for _, content := range nested.Body().Blocks() {
+				if content.Type() == "content" {
+					content.Body().RenameAttribute("path_prefix", "include_path_prefix_pattern")
+				}
+			}
+		}
+	}
+
+	u.rename(block, "artifactory_local_repository_multi_replication", migrateWithMoved)
+}
+
+// pullReplicationRemovedAttributes have no counterpart in artifactory_remote_repository_replication,
+// it replicates with the URL, credentials and proxy of the remote repository.
+var pullReplicationRemovedAttributes = []string{
+	"url",
+	"username",
+	"password",
+	"proxy",
+	"socket_timeout_millis",
+	"sync_statistics",
+}
+
+func upgradePullReplication(u *upgrade, name string, block *hclwrite.Block) {
+	u.rename(block, "artifactory_remote_repository_replication", migrateWithMoved)
+
+	body := block.Body()
+	body.RenameAttribute("path_prefix", "include_path_prefix_pattern")
+
+	for _, attrName := range pullReplicationRemovedAttributes {
+		if body.RemoveAttribute(attrName) != nil {
+			u.note(block, name, "attribute '%s' removed, the remote repository settings are used instead", attrName)
+		}
+	}
+}
+
+// upgradeFederatedDockerRepository moves from the alias to the docker V2 resource. The docker V2
+// resource doesn't implement MoveState for the alias, so the resource is imported again under
+// the new type.
+func upgradeFederatedDockerRepository(u *upgrade, name string, block *hclwrite.Block) {
+	if block.Body().GetAttribute("key") == nil {
+		u.note(block, name, "attribute 'key' not found, resource left unchanged")
+		return
+	}
+
+	u.rename(block, "artifactory_federated_docker_v2_repository", migrateWithImport)
+}
+
+func upgradeLdapSetting(u *upgrade, name string, block *hclwrite.Block) {
+	u.rename(block, "artifactory_ldap_setting_v2", migrateWithMoved)
+}
+
+// daysPerMonth converts the months-based policy conditions, Artifactory counts a month as 30 days.
+const daysPerMonth = 30
+
+var policyConditionsInDays = map[string]string{
+	"created_before_in_months":         "created_before_in_days",
+	"last_downloaded_before_in_months": "last_downloaded_before_in_days",
+}
+
+// upgradePolicyConditionsInDays rewrites the months-based conditions of `search_criteria`. It is an object
+// attribute, not a block, so the conditions are rewritten at the token level. Conditions whose value is not
+// a number literal are left unchanged.
+func upgradePolicyConditionsInDays(u *upgrade, name string, block *hclwrite.Block) {
+	attr := block.Body().GetAttribute("search_criteria")
+	if attr == nil {
+		return
+	}
+
+	tokens := copyTokens(attr.Expr().BuildTokens(nil))
+	changed := false
+
+	for i, token := range tokens {
+		days, ok := policyConditionsInDays[string(token.Bytes)]
+		if token.Type != hclsyntax.TokenIdent || !ok {
+			continue
+		}
+
+		// expect `<condition> = <number>` followed by a separator
+		if i+2 >= len(tokens) ||
+			(tokens[i+1].Type != hclsyntax.TokenEqual && tokens[i+1].Type != hclsyntax.TokenColon) ||
+			tokens[i+2].Type != hclsyntax.TokenNumberLit ||
+			(i+3 < len(tokens) && !isSeparator(tokens[i+3])) {
+			u.note(block, name, "'search_criteria.%s' is not a number literal, convert it to '%s' manually", token.Bytes, days)
+			continue
+		}
+
+		months, err := strconv.ParseInt(string(tokens[i+2].Bytes), 10, 64)
+		if err != nil {
+			u.note(block, name, "'search_criteria.%s' is not an integer, convert it to '%s' manually", token.Bytes, days)
+			continue
+		}
+
+		u.note(block, name, "'search_criteria.%s = %d' converted to '%s = %d', requires Artifactory 7.111.2 or later", token.Bytes, months, days, months*daysPerMonth)
+		token.Bytes = []byte(days)
+		tokens[i+2].Bytes = []byte(strconv.FormatInt(months*daysPerMonth, 10))
+		changed = true
+	}
+
+	if changed {
+		block.Body().SetAttributeRaw("search_criteria", tokens)
+	}
+}
+
+func isSeparator(token *hclwrite.Token) bool {
+	switch token.Type {
+	case hclsyntax.TokenNewline, hclsyntax.TokenComma, hclsyntax.TokenCBrace, hclsyntax.TokenComment:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,227 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// stateMigration is how the Terraform state of a renamed resource follows its configuration.
+type stateMigration int
+
+const (
+	// migrateWithMoved emits a `moved` block, the target resource implements MoveState for the old type.
+	migrateWithMoved stateMigration = iota
+	// migrateWithImport emits a `removed` block for the old address and an `import` block for the new one.
+	migrateWithImport
+	// migrateManually leaves the state alone, the resource has to be imported with `terraform import`.
+	migrateManually
+)
+
+type rename struct {
+	from      []string
+	to        []string
+	migration stateMigration
+	// importID holds the tokens of the import ID expression, usually the resource's `key`.
+	importID hclwrite.Tokens
+}
+
+// finding is something a rule could not convert, or converted in a way that needs a review.
+type finding struct {
+	address string
+	rule    string
+	message string
+}
+
+// upgrade is a single run of the rules over a configuration file.
+type upgrade struct {
+	file     *hclwrite.File
+	renames  []rename
+	findings []finding
+}
+
+func newUpgrade(file *hclwrite.File) *upgrade {
+	return &upgrade{file: file}
+}
+
+// run applies every matching rule to the resource blocks, then updates the references to renamed
+// resources and appends the blocks that migrate their state.
+func (u *upgrade) run(rules []rule) {
+	for _, block := range u.file.Body().Blocks() {
+		labels := block.Labels()
+		if block.Type() != "resource" || len(labels) != 2 {
+			continue
+		}
+
+		for _, r := range rules {
+			if r.matches(labels[0]) {
+				debugf("applying rule %s to %s\n", r.name, strings.Join(labels, "."))
+				r.apply(u, r.name, block)
+				break
+			}
+		}
+	}
+
+	u.renameReferences(u.file.Body())
+	u.appendStateMigrations()
+}
+
+// rename changes the resource type of block and records how its state is migrated.
+func (u *upgrade) rename(block *hclwrite.Block, resourceType string, migration stateMigration) {
+	labels := block.Labels()
+	r := rename{
+		from:      labels,
+		to:        []string{resourceType, labels[1]},
+		migration: migration,
+	}
+	if attr := block.Body().GetAttribute("key"); attr != nil {
+		r.importID = copyTokens(attr.Expr().BuildTokens(nil))
+	}
+
+	block.SetLabels(r.to)
+	u.renames = append(u.renames, r)
+}
+
+func (u *upgrade) note(block *hclwrite.Block, rule string, format string, args ...interface{}) {
+	u.findings = append(u.findings, finding{
+		address: strings.Join(block.Labels(), "."),
+		rule:    rule,
+		message: fmt.Sprintf(format, args...),
+	})
+}
+
+// renameReferences rewrites every reference to a renamed resource, including the ones in nested blocks.
+func (u *upgrade) renameReferences(body *hclwrite.Body) {
+	for _, attr := range body.Attributes() {
+		for _, r := range u.renames {
+			attr.Expr().RenameVariablePrefix(r.from, r.to)
+		}
+	}
+
+	for _, block := range body.Blocks() {
+		u.renameReferences(block.Body())
+	}
+}
+
+// renameReferencesInFile rewrites the references to the renamed resources in another configuration file of
+// the module. It returns true if any reference was rewritten.
+func (u *upgrade) renameReferencesInFile(file *hclwrite.File) bool {
+	before := string(file.Bytes())
+	u.renameReferences(file.Body())
+
+	return string(file.Bytes()) != before
+}
+
+// noteExternalReferences reports the renamed resources when the references to them in the other files of
+// the module are not rewritten.
+func (u *upgrade) noteExternalReferences() {
+	for _, r := range u.renames {
+		u.findings = append(u.findings, finding{
+			address: strings.Join(r.to, "."),
+			rule:    "references",
+			message: fmt.Sprintf("references to %s in other files are not updated, rename them by hand or use --references-dir", strings.Join(r.from, ".")),
+		})
+	}
+}
+
+func (u *upgrade) appendStateMigrations() {
+	body := u.file.Body()
+
+	for _, r := range u.renames {
+		switch r.migration {
+		case migrateWithMoved:
+			body.AppendNewline()
+			moved := body.AppendNewBlock("moved", nil).Body()
+			moved.SetAttributeTraversal("from", traversal(r.from))
+			moved.SetAttributeTraversal("to", traversal(r.to))
+		case migrateWithImport:
+			body.AppendNewline()
+			removed := body.AppendNewBlock("removed", nil).Body()
+			removed.SetAttributeTraversal("from", traversal(r.from))
+			removed.AppendNewBlock("lifecycle", nil).Body().SetAttributeValue("destroy", cty.False)
+
+			body.AppendNewline()
+			imported := body.AppendNewBlock("import", nil).Body()
+			imported.SetAttributeTraversal("to", traversal(r.to))
+			imported.SetAttributeRaw("id", r.importID)
+		}
+	}
+}
+
+// importCommands returns the `terraform import` commands for the resources that are migrated manually.
+func (u *upgrade) importCommands() []string {
+	var commands []string
+	for _, r := range u.renames {
+		if r.migration != migrateManually {
+			continue
+		}
+
+		id, err := literalString(r.importID)
+		if err != nil {
+			id = "<" + strings.TrimSpace(string(r.importID.Bytes())) + ">"
+		}
+		commands = append(commands, fmt.Sprintf("terraform import %s %s", strings.Join(r.to, "."), id))
+	}
+
+	return commands
+}
+
+// report returns the findings sorted by resource address.
+func (u *upgrade) report() []finding {
+	findings := append([]finding{}, u.findings...)
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].address < findings[j].address
+	})
+
+	return findings
+}
+
+func traversal(address []string) hcl.Traversal {
+	t := hcl.Traversal{hcl.TraverseRoot{Name: address[0]}}
+	for _, name := range address[1:] {
+		t = append(t, hcl.TraverseAttr{Name: name})
+	}
+
+	return t
+}
+
+func copyTokens(tokens hclwrite.Tokens) hclwrite.Tokens {
+	copied := make(hclwrite.Tokens, len(tokens))
+	for i, token := range tokens {
+		t := *token
+		copied[i] = &t
+	}
+
+	return copied
+}
+
+// literalString returns the value of a plain quoted string expression, e.g. `"npm"`.
+func literalString(tokens hclwrite.Tokens) (string, error) {
+	if len(tokens) != 3 ||
+		tokens[0].Type != hclsyntax.TokenOQuote ||
+		tokens[1].Type != hclsyntax.TokenQuotedLit ||
+		tokens[2].Type != hclsyntax.TokenCQuote {
+		return "", fmt.Errorf("expression is not a string literal")
+	}
+
+	return string(tokens[1].Bytes), nil
+}
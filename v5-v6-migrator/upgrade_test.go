@@ -0,0 +1,271 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+func runUpgrade(t *testing.T, config string) (string, *upgrade) {
+	t.Helper()
+
+	file, diags := hclwrite.ParseConfig([]byte(config), "", hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		t.Fatalf("failed to parse config: %s", diags)
+	}
+
+	u := newUpgrade(file)
+	u.run(rules)
+
+	return string(hclwrite.Format(file.Bytes())), u
+}
+
+func assertContains(t *testing.T, output string, expected ...string) {
+	t.Helper()
+
+	for _, e := range expected {
+		if !strings.Contains(output, e) {
+			t.Fatalf("expected output to contain %q, got:\n%s", e, output)
+		}
+	}
+}
+
+func TestUpgradeGenericRepository(t *testing.T) {
+	input, err := os.ReadFile("sample.v5.tf")
+	if err != nil {
+		t.Fatalf("failed to read sample: %s", err)
+	}
+	expected, err := os.ReadFile("sample.v6.tf")
+	if err != nil {
+		t.Fatalf("failed to read sample: %s", err)
+	}
+
+	output, u := runUpgrade(t, string(input))
+	if output != string(expected) {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, output)
+	}
+
+	commands := u.importCommands()
+	if len(commands) != 6 || commands[0] != "terraform import artifactory_local_npm_repository.alexh-npm-local alexh-npm-local-key" {
+		t.Fatalf("unexpected import commands: %v", commands)
+	}
+}
+
+func TestUpgradePushReplication(t *testing.T) {
+	output, u := runUpgrade(t, `
+resource "artifactory_push_replication" "foo" {
+  repo_key = "foo"
+  cron_exp = "0 0 * * * ?"
+
+  replications {
+    url         = "https://example.com/artifactory/foo"
+    username    = "admin"
+    password    = var.password
+    path_prefix = "/bar"
+  }
+}
+
+output "repo" {
+  value = artifactory_push_replication.foo.repo_key
+}
+`)
+
+	assertContains(t, output,
+		`resource "artifactory_local_repository_multi_replication" "foo" {`,
+		"  replication {\n",
+		`include_path_prefix_pattern = "/bar"`,
+		"value = artifactory_local_repository_multi_replication.foo.repo_key",
+		"moved {\n  from = artifactory_push_replication.foo\n  to   = artifactory_local_repository_multi_replication.foo\n}",
+	)
+	if len(u.report()) != 0 {
+		t.Fatalf("expected no findings, got %v", u.report())
+	}
+}
+
+func TestUpgradePushReplicationDynamic(t *testing.T) {
+	output, _ := runUpgrade(t, `
+resource "artifactory_push_replication" "foo" {
+  repo_key = "foo"
+  cron_exp = "0 0 * * * ?"
+
+  dynamic "replications" {
+    for_each = var.targets
+    content {
+      url         = replications.value.url
+      path_prefix = replications.value.prefix
+    }
+  }
+}
+`)
+
+	assertContains(t, output,
+		`dynamic "replication" {`,
+		"iterator = replications",
+		"include_path_prefix_pattern = replications.value.prefix",
+	)
+}
+
+func TestUpgradePullReplication(t *testing.T) {
+	output, u := runUpgrade(t, `
+resource "artifactory_pull_replication" "foo" {
+  repo_key    = "foo"
+  cron_exp    = "0 0 * * * ?"
+  url         = "https://example.com/artifactory/foo"
+  username    = "admin"
+  path_prefix = "/bar"
+}
+`)
+
+	assertContains(t, output,
+		`resource "artifactory_remote_repository_replication" "foo" {`,
+		`include_path_prefix_pattern = "/bar"`,
+		"moved {\n  from = artifactory_pull_replication.foo\n  to   = artifactory_remote_repository_replication.foo\n}",
+	)
+	if strings.Contains(output, "username") || strings.Contains(output, "https://example.com") {
+		t.Fatalf("expected connection attributes to be removed, got:\n%s", output)
+	}
+
+	findings := u.report()
+	if len(findings) != 2 || findings[0].address != "artifactory_remote_repository_replication.foo" {
+		t.Fatalf("expected findings for url and username, got %v", findings)
+	}
+}
+
+func TestUpgradeFederatedDockerRepository(t *testing.T) {
+	output, _ := runUpgrade(t, `
+resource "artifactory_federated_docker_repository" "foo" {
+  key = "${var.prefix}-docker"
+
+  member {
+    url     = "https://example.com/artifactory/foo"
+    enabled = true
+  }
+}
+`)
+
+	assertContains(t, output,
+		`resource "artifactory_federated_docker_v2_repository" "foo" {`,
+		"removed {\n  from = artifactory_federated_docker_repository.foo\n  lifecycle {\n    destroy = false\n  }\n}",
+		"import {\n  to = artifactory_federated_docker_v2_repository.foo\n  id = \"${var.prefix}-docker\"\n}",
+	)
+}
+
+func TestUpgradeLdapSetting(t *testing.T) {
+	output, _ := runUpgrade(t, `
+resource "artifactory_ldap_setting" "ldap" {
+  key      = "ldap"
+  ldap_url = "ldap://ldap.example.com/dc=example,dc=com"
+}
+`)
+
+	assertContains(t, output,
+		`resource "artifactory_ldap_setting_v2" "ldap" {`,
+		"moved {\n  from = artifactory_ldap_setting.ldap\n  to   = artifactory_ldap_setting_v2.ldap\n}",
+	)
+}
+
+func TestUpgradePolicyConditionsInDays(t *testing.T) {
+	output, u := runUpgrade(t, `
+resource "artifactory_package_cleanup_policy" "foo" {
+  key = "foo"
+
+  search_criteria = {
+    package_types                    = ["docker"]
+    repos                            = ["**"]
+    created_before_in_months         = 6
+    last_downloaded_before_in_months = var.months
+  }
+}
+`)
+
+	assertContains(t, output,
+		"created_before_in_days           = 180",
+		"last_downloaded_before_in_months = var.months",
+	)
+
+	findings := u.report()
+	if len(findings) != 2 ||
+		!strings.Contains(findings[0].message, "converted") ||
+		!strings.Contains(findings[1].message, "manually") {
+		t.Fatalf("unexpected findings: %v", findings)
+	}
+}
+
+func TestUpgradeLeavesOtherResources(t *testing.T) {
+	config := `
+resource "artifactory_local_generic_repository" "foo" {
+  key = "foo"
+}
+`
+	output, u := runUpgrade(t, config)
+	if output != config || len(u.renames) != 0 {
+		t.Fatalf("expected config to be unchanged, got:\n%s", output)
+	}
+}
+
+func TestUpdateReferencesInOtherFiles(t *testing.T) {
+	dir := t.TempDir()
+	inputFileName := filepath.Join(dir, "replication.tf")
+	outputsFileName := filepath.Join(dir, "outputs.tf")
+	unrelatedFileName := filepath.Join(dir, "unrelated.tf")
+
+	files := map[string]string{
+		inputFileName:     "resource \"artifactory_push_replication\" \"foo\" {\n  repo_key = \"foo\"\n}\n",
+		outputsFileName:   "output \"repo\" {\n  value = artifactory_push_replication.foo.repo_key\n}\n",
+		unrelatedFileName: "output \"other\" {\n  value = artifactory_local_generic_repository.bar.key\n}\n",
+	}
+	for fileName, content := range files {
+		if err := os.WriteFile(fileName, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %s", fileName, err)
+		}
+	}
+
+	_, u := runUpgrade(t, files[inputFileName])
+	if err := updateReferences(dir, u, inputFileName); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	outputs, _ := os.ReadFile(outputsFileName)
+	assertContains(t, string(outputs), "value = artifactory_local_repository_multi_replication.foo.repo_key")
+
+	for _, fileName := range []string{inputFileName, unrelatedFileName} {
+		content, _ := os.ReadFile(fileName)
+		if string(content) != files[fileName] {
+			t.Fatalf("expected %s to be unchanged, got:\n%s", fileName, content)
+		}
+	}
+}
+
+func TestUpgradeNotesExternalReferences(t *testing.T) {
+	_, u := runUpgrade(t, `
+resource "artifactory_ldap_setting" "foo" {
+  key = "foo"
+}
+`)
+	u.noteExternalReferences()
+
+	findings := u.report()
+	if len(findings) != 1 ||
+		findings[0].address != "artifactory_ldap_setting_v2.foo" ||
+		!strings.Contains(findings[0].message, "references to artifactory_ldap_setting.foo in other files are not updated") {
+		t.Fatalf("unexpected findings: %v", findings)
+	}
+}